@@ -0,0 +1,54 @@
+// Comando importar_cep carrega a base de CEPs de um arquivo CSV com as
+// colunas cep, logradouro, complemento, bairro, cidade e estado, e usa o
+// config.toml do diretório atual.
+//
+//	go run ./cmd/importar_cep <arquivo.csv>
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/config"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/cep"
+	cepService "tsukuyomi/services/cep"
+)
+
+func main() {
+	config.SetupLog()
+
+	if len(os.Args) != 2 {
+		log.Fatal("informe o arquivo CSV com a base de CEPs")
+	}
+
+	arquivo, err := os.Open(os.Args[1])
+	if err != nil {
+		log.Fatalf("can't open CEP file: %v", err)
+	}
+
+	defer arquivo.Close()
+
+	config := config.Load()
+	repository := repositories.NewRepository(config)
+
+	service := cepService.NewService(cep.NewRepository(repository))
+
+	importacao, err := service.Importar(context.Background(), arquivo)
+	for _, erro := range importacao.Erros {
+		log.Warn(erro)
+	}
+
+	if err != nil {
+		log.Fatalf("can't import CEP data: %v", err)
+	}
+
+	log.Info(
+		"Importação concluída",
+		"linhas", importacao.Linhas,
+		"importados", importacao.Importados,
+		"ignorados", importacao.Ignorados,
+	)
+}
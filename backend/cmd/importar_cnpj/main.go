@@ -0,0 +1,56 @@
+// Comando importar_cnpj carrega os dados abertos do CNPJ publicados pela
+// Receita Federal nas tabelas de consulta. Recebe o diretório com os arquivos
+// de empresas, estabelecimentos e municípios, compactados ou não, e usa o
+// config.toml do diretório atual.
+//
+//	go run ./cmd/importar_cnpj <diretório>
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/config"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/cnpj"
+	"tsukuyomi/repositories/empresa"
+	"tsukuyomi/repositories/endereco"
+	cnpjService "tsukuyomi/services/cnpj"
+)
+
+func main() {
+	config.SetupLog()
+
+	if len(os.Args) != 2 {
+		log.Fatal("informe o diretório com os arquivos de dados abertos do CNPJ")
+	}
+
+	config := config.Load()
+	repository := repositories.NewRepository(config)
+
+	service := cnpjService.NewService(
+		cnpj.NewRepository(repository),
+		empresa.NewRepository(repository),
+		endereco.NewRepository(repository),
+	)
+
+	importacao, err := service.Importar(context.Background(), os.Args[1])
+	for _, erro := range importacao.Erros {
+		log.Warn(erro)
+	}
+
+	if err != nil {
+		log.Fatalf("can't import CNPJ data: %v", err)
+	}
+
+	log.Info(
+		"Importação concluída",
+		"arquivos", len(importacao.Arquivos),
+		"empresas", importacao.Empresas,
+		"estabelecimentos", importacao.Estabelecimentos,
+		"municipios", importacao.Municipios,
+		"ignorados", importacao.Ignorados,
+	)
+}
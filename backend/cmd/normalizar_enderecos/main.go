@@ -0,0 +1,48 @@
+// Comando normalizar_enderecos normaliza os endereços cadastrados antes da
+// chave de endereço e grava a chave de cada um, e usa o config.toml do
+// diretório atual. Endereços que repetem a chave de outro ficam sem chave e
+// aparecem no relatório de duplicados.
+//
+//	go run ./cmd/normalizar_enderecos
+package main
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/config"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/cep"
+	"tsukuyomi/repositories/endereco"
+	cepService "tsukuyomi/services/cep"
+	enderecoService "tsukuyomi/services/endereco"
+)
+
+func main() {
+	config.SetupLog()
+
+	config := config.Load()
+	repository := repositories.NewRepository(config)
+
+	service := enderecoService.NewService(
+		endereco.NewRepository(repository),
+		cepService.NewService(cep.NewRepository(repository)),
+	)
+
+	normalizacao, err := service.NormalizarCadastrados(context.Background())
+	if err != nil {
+		log.Fatalf("can't normalize addresses: %v", err)
+	}
+
+	for _, colisao := range normalizacao.Colisoes {
+		log.Warn(colisao)
+	}
+
+	log.Info(
+		"Normalização concluída",
+		"enderecos", normalizacao.Enderecos,
+		"chaves", normalizacao.Chaves,
+		"colisoes", len(normalizacao.Colisoes),
+	)
+}
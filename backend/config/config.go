@@ -4,12 +4,18 @@ import (
 	"github.com/charmbracelet/log"
 	"github.com/invopop/validation"
 	"github.com/spf13/viper"
+
+	"tsukuyomi/models"
 )
 
 type App struct {
 	Name        string
 	Port        int
 	Environment string
+
+	// DinheiroJSON é o formato dos valores monetários no JSON: "numero" ou
+	// "texto". O padrão é "numero".
+	DinheiroJSON string
 }
 
 type Database struct {
@@ -41,9 +47,10 @@ func Load() *Config {
 	}
 
 	app := App{
-		Name:        viper.GetString("app.name"),
-		Port:        viper.GetInt("app.port"),
-		Environment: viper.GetString("app.env"),
+		Name:         viper.GetString("app.name"),
+		Port:         viper.GetInt("app.port"),
+		Environment:  viper.GetString("app.env"),
+		DinheiroJSON: viper.GetString("app.dinheiro_json"),
 	}
 
 	if err := app.Validate(); err != nil {
@@ -78,6 +85,7 @@ func (a App) Validate() error {
 		validation.Field(&a.Name, validation.Required),
 		validation.Field(&a.Port, validation.Required),
 		validation.Field(&a.Environment, validation.Required),
+		validation.Field(&a.DinheiroJSON, validation.In(models.DINHEIRO_JSON_NUMERO, models.DINHEIRO_JSON_TEXTO)),
 	)
 }
 
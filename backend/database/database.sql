@@ -13,13 +13,14 @@ CREATE TABLE empresas (
 
 CREATE TABLE enderecos (
 	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
-	logradouro VARCHAR(255) NOT NULL UNIQUE,
+	logradouro VARCHAR(255) NOT NULL,
 	numero VARCHAR(10) NOT NULL,
 	complemento VARCHAR(100),
 	bairro VARCHAR(100) NOT NULL,
 	cidade VARCHAR(100) NOT NULL,
 	cep VARCHAR(9) NOT NULL,
 	estado VARCHAR(20),
+	chave VARCHAR(500) UNIQUE COMMENT "endereço completo normalizado; nulo nos endereços apagados",
 	criado DATETIME NOT NULL,
 	atualizado DATETIME,
 	apagado DATETIME,
@@ -36,11 +37,25 @@ CREATE TABLE endereco_empresa (
 	PRIMARY KEY(id)
 );
 
+CREATE TABLE pessoas (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_empresa INTEGER NOT NULL,
+	nome VARCHAR(255) NOT NULL,
+	cargo VARCHAR(100),
+	departamento VARCHAR(100),
+	linkedin VARCHAR(255),
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
 CREATE TABLE contato_empresa (
 	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
 	id_empresa INTEGER NOT NULL,
-	tipo ENUM("telefone", "whatsapp", "email") NOT NULL,
-	contato VARCHAR(255) UNIQUE,
+	id_pessoa INTEGER COMMENT "pessoa da empresa a quem o contato pertence; nulo nos contatos gerais",
+	tipo ENUM("telefone", "whatsapp", "email", "site", "linkedin") NOT NULL,
+	contato VARCHAR(255) NOT NULL,
 	criado DATETIME NOT NULL,
 	atualizado DATETIME,
 	apagado DATETIME,
@@ -52,6 +67,7 @@ CREATE TABLE empregos (
 	id_empresa INTEGER NOT NULL,
 	ocupacao VARCHAR(255) NOT NULL,
 	remuneracao_inicial DECIMAL(15,2) NOT NULL,
+	moeda CHAR(3) NOT NULL DEFAULT "BRL" COMMENT "código ISO 4217 da moeda da remuneração",
 	tipo_contrato VARCHAR(255) NOT NULL,
 	data_inicio DATETIME NOT NULL,
 	data_fim DATETIME,
@@ -67,6 +83,7 @@ CREATE TABLE remuneracoes (
 	id_emprego INTEGER NOT NULL,
 	id_ocupacao INTEGER NOT NULL,
 	remuneracao DECIMAL(15,2) NOT NULL,
+	moeda CHAR(3) NOT NULL DEFAULT "BRL" COMMENT "código ISO 4217 da moeda da remuneração",
 	data DATETIME NOT NULL,
 	criado DATETIME NOT NULL,
 	atualizado DATETIME,
@@ -102,21 +119,320 @@ CREATE TABLE holerites (
 	id_emprego INTEGER NOT NULL,
 	id_remuneracao INTEGER NOT NULL,
 	referencia DATETIME NOT NULL,
+	moeda CHAR(3) NOT NULL DEFAULT "BRL" COMMENT "código ISO 4217 da moeda do holerite",
+	data_pagamento DATE,
 	PRIMARY KEY(id)
 );
 
 CREATE TABLE detalhamento_holerite (
 	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
 	id_holerite INTEGER NOT NULL,
+	id_rubrica INTEGER COMMENT "rubrica do catálogo; nulo enquanto a linha não for classificada",
 	tipo ENUM("credito", "debito") NOT NULL,
 	valor DECIMAL(15,2) NOT NULL,
 	descricao TEXT(65535) NOT NULL,
 	PRIMARY KEY(id)
 );
 
+CREATE TABLE feriados (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	data DATE NOT NULL,
+	nome VARCHAR(255) NOT NULL,
+	abrangencia ENUM("estadual", "municipal") NOT NULL,
+	estado VARCHAR(20) NOT NULL,
+	cidade VARCHAR(100),
+	recorrente BOOLEAN NOT NULL DEFAULT FALSE COMMENT "repete todo ano no mesmo dia e mês",
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE escalas (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_emprego INTEGER NOT NULL,
+	tipo ENUM("semanal", "revezamento") NOT NULL,
+	descricao VARCHAR(100),
+	data_inicio DATE NOT NULL COMMENT "início da vigência e do ciclo de revezamento",
+	intervalo INTEGER NOT NULL COMMENT "duração do intervalo definida em minutos",
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE escala_dias (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_escala INTEGER NOT NULL,
+	posicao INTEGER NOT NULL COMMENT "dia da semana (0 = domingo) ou posição no ciclo de revezamento",
+	minutos INTEGER NOT NULL COMMENT "carga horária prevista do dia definida em minutos",
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE ausencias (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_emprego INTEGER NOT NULL,
+	tipo ENUM("falta_injustificada", "atestado_medico", "licenca_maternidade", "licenca_paternidade", "luto", "casamento", "doacao_sangue", "ferias") NOT NULL,
+	data_inicio DATE NOT NULL,
+	data_fim DATE NOT NULL,
+	observacao TEXT(65535),
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE ausencia_anexos (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_ausencia INTEGER NOT NULL,
+	nome VARCHAR(255) NOT NULL,
+	tipo_conteudo VARCHAR(100) NOT NULL,
+	conteudo MEDIUMBLOB NOT NULL,
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE correcoes_ponto (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_emprego INTEGER NOT NULL,
+	id_cartao_ponto INTEGER COMMENT "marcação original, ausente ao incluir uma marcação",
+	acao ENUM("incluir", "alterar", "remover") NOT NULL,
+	horario DATETIME COMMENT "novo horário, ao incluir ou alterar uma marcação",
+	tipo ENUM("entrada", "saida"),
+	justificativa TEXT(65535) NOT NULL,
+	situacao ENUM("pendente", "aprovada", "rejeitada") NOT NULL DEFAULT "pendente",
+	motivo_decisao TEXT(65535),
+	decidido DATETIME,
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE ocupacoes (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	nome VARCHAR(255) NOT NULL,
+	cbo CHAR(6) UNIQUE COMMENT "código da Classificação Brasileira de Ocupações, sem formatação",
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE emprego_ocupacoes (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_emprego INTEGER NOT NULL,
+	id_ocupacao INTEGER NOT NULL,
+	data_inicio DATE NOT NULL COMMENT "data a partir da qual a ocupação vigora no emprego",
+	observacao TEXT(65535),
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE rubricas (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	codigo VARCHAR(30) NOT NULL UNIQUE,
+	nome VARCHAR(255) NOT NULL,
+	tipo ENUM("credito", "debito") NOT NULL,
+	categoria ENUM("salario", "hora_extra", "adicional", "comissao", "ferias", "decimo_terceiro", "participacao_lucros", "beneficio", "reembolso", "adiantamento", "falta", "inss", "inss_decimo_terceiro", "irrf", "irrf_decimo_terceiro", "irrf_participacao_lucros", "vale_transporte", "vale_refeicao", "plano_saude", "pensao_alimenticia", "outros") NOT NULL,
+	incide_inss BOOLEAN NOT NULL DEFAULT FALSE,
+	incide_irrf BOOLEAN NOT NULL DEFAULT FALSE,
+	incide_fgts BOOLEAN NOT NULL DEFAULT FALSE,
+	termos TEXT(1000) COMMENT "palavras-chave para a classificação pela descrição, separadas por ponto e vírgula",
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE beneficios (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_emprego INTEGER NOT NULL,
+	tipo ENUM("vale_refeicao", "vale_alimentacao", "vale_transporte", "plano_saude", "plano_odontologico", "auxilio_home_office", "academia", "plr", "outro") NOT NULL,
+	descricao VARCHAR(255),
+	valor DECIMAL(15,2) NOT NULL DEFAULT 0 COMMENT "valor concedido pela empresa em cada período",
+	multiplo_salario DECIMAL(7,2) COMMENT "valor em quantidade de salários, como no alvo da PLR",
+	coparticipacao DECIMAL(15,2) NOT NULL DEFAULT 0 COMMENT "valor descontado do empregado em cada período",
+	periodicidade ENUM("diaria", "mensal", "trimestral", "semestral", "anual") NOT NULL,
+	data_inicio DATE NOT NULL,
+	data_fim DATE,
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE sindicatos (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	nome VARCHAR(255) NOT NULL,
+	cnpj CHAR(14) UNIQUE,
+	mes_data_base TINYINT NOT NULL COMMENT "mês da data-base da categoria",
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE convencoes_coletivas (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_empresa INTEGER NOT NULL,
+	id_sindicato INTEGER NOT NULL,
+	ano SMALLINT NOT NULL,
+	percentual DECIMAL(7,4) NOT NULL COMMENT "percentual de reajuste na data-base",
+	observacao VARCHAR(255),
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id),
+	UNIQUE(id_empresa, id_sindicato, ano)
+);
+
+CREATE TABLE indices_economicos (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	indice ENUM("ipca", "inpc", "igpm") NOT NULL,
+	referencia DATE NOT NULL COMMENT "primeiro dia do mês de referência",
+	variacao DECIMAL(9,4) NOT NULL COMMENT "variação percentual no mês",
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id),
+	UNIQUE(indice, referencia)
+);
+
+CREATE TABLE cotacoes (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	moeda CHAR(3) NOT NULL COMMENT "código ISO 4217 da moeda",
+	data DATE NOT NULL,
+	compra DECIMAL(15,6) NOT NULL COMMENT "taxa PTAX de compra em reais por unidade da moeda",
+	venda DECIMAL(15,6) NOT NULL COMMENT "taxa PTAX de venda em reais por unidade da moeda",
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id),
+	UNIQUE(moeda, data)
+);
+
+CREATE TABLE notas_fiscais (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_emprego INTEGER NOT NULL,
+	numero VARCHAR(50) NOT NULL,
+	data_emissao DATE NOT NULL,
+	valor DECIMAL(15,2) NOT NULL COMMENT "valor do serviço",
+	situacao ENUM("pendente", "paga") NOT NULL DEFAULT "pendente",
+	data_pagamento DATE,
+	descricao VARCHAR(255),
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE candidaturas (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_empresa INTEGER NOT NULL,
+	cargo VARCHAR(255) NOT NULL,
+	origem VARCHAR(100) COMMENT "canal da vaga, como indicação ou site de vagas",
+	pretensao_salarial DECIMAL(15,2),
+	salario_oferecido DECIMAL(15,2),
+	moeda CHAR(3) NOT NULL DEFAULT "BRL",
+	tipo_contrato VARCHAR(255),
+	situacao ENUM("aplicado", "triagem", "entrevista", "proposta", "aceito", "recusado") NOT NULL DEFAULT "aplicado",
+	id_emprego INTEGER COMMENT "emprego criado a partir da candidatura aceita",
+	observacao TEXT(65535),
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE candidatura_transicoes (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_candidatura INTEGER NOT NULL,
+	situacao_anterior ENUM("aplicado", "triagem", "entrevista", "proposta", "aceito", "recusado"),
+	situacao ENUM("aplicado", "triagem", "entrevista", "proposta", "aceito", "recusado") NOT NULL,
+	data DATETIME NOT NULL,
+	observacao VARCHAR(255),
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE entrevistas (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_candidatura INTEGER NOT NULL,
+	data_hora DATETIME NOT NULL,
+	duracao INTEGER NOT NULL COMMENT "duração definida em minutos",
+	formato ENUM("remota", "presencial") NOT NULL,
+	id_endereco INTEGER COMMENT "local das entrevistas presenciais",
+	link VARCHAR(255) COMMENT "link da chamada das entrevistas remotas",
+	anotacoes TEXT(65535),
+	resultado ENUM("aprovado", "reprovado"),
+	criado DATETIME NOT NULL,
+	atualizado DATETIME,
+	apagado DATETIME,
+	PRIMARY KEY(id)
+);
+
+CREATE TABLE entrevista_entrevistadores (
+	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
+	id_entrevista INTEGER NOT NULL,
+	id_contato INTEGER NOT NULL,
+	PRIMARY KEY(id),
+	UNIQUE(id_entrevista, id_contato)
+);
+
+CREATE TABLE cnpj_empresas (
+	cnpj_basico CHAR(8) NOT NULL,
+	razao_social VARCHAR(255) NOT NULL,
+	natureza_juridica CHAR(4),
+	capital_social DECIMAL(18,2),
+	porte CHAR(2),
+	PRIMARY KEY(cnpj_basico)
+);
+
+CREATE TABLE cnpj_estabelecimentos (
+	cnpj CHAR(14) NOT NULL,
+	cnpj_basico CHAR(8) NOT NULL,
+	matriz BOOLEAN NOT NULL DEFAULT FALSE,
+	nome_fantasia VARCHAR(255),
+	situacao_cadastral CHAR(2),
+	data_inicio_atividade DATE,
+	cnae_principal CHAR(7),
+	tipo_logradouro VARCHAR(50),
+	logradouro VARCHAR(255),
+	numero VARCHAR(20),
+	complemento VARCHAR(255),
+	bairro VARCHAR(100),
+	cep CHAR(8),
+	uf CHAR(2),
+	codigo_municipio CHAR(4),
+	telefone VARCHAR(20),
+	email VARCHAR(255),
+	PRIMARY KEY(cnpj),
+	INDEX(cnpj_basico)
+);
+
+CREATE TABLE cnpj_municipios (
+	codigo CHAR(4) NOT NULL,
+	nome VARCHAR(100) NOT NULL,
+	PRIMARY KEY(codigo)
+);
+
+CREATE TABLE ceps (
+	cep CHAR(8) NOT NULL,
+	logradouro VARCHAR(255) NOT NULL DEFAULT '',
+	complemento VARCHAR(255),
+	bairro VARCHAR(100) NOT NULL DEFAULT '',
+	cidade VARCHAR(100) NOT NULL,
+	estado CHAR(2) NOT NULL,
+	PRIMARY KEY(cep)
+);
+
 CREATE TABLE historico (
 	id INTEGER NOT NULL AUTO_INCREMENT UNIQUE,
-	tabela ENUM("banco_horas", "cartao_ponto", "contato_empresa", "detalhamento_holerite", "empregos", "empresas", "enderecos", "endereco_empresa", "holerites", "remuneracoes") NOT NULL,
+	tabela ENUM("ausencia_anexos", "ausencias", "banco_horas", "beneficios", "candidatura_transicoes", "candidaturas", "cartao_ponto", "contato_empresa", "convencoes_coletivas", "correcoes_ponto", "cotacoes", "detalhamento_holerite", "emprego_ocupacoes", "empregos", "empresas", "enderecos", "endereco_empresa", "entrevista_entrevistadores", "entrevistas", "escalas", "escala_dias", "feriados", "holerites", "indices_economicos", "notas_fiscais", "ocupacoes", "pessoas", "remuneracoes", "rubricas", "sindicatos") NOT NULL,
 	acao ENUM("INSERT", "UPDATE", "DELETE") NOT NULL,
 	descricao TEXT(65535) NOT NULL,
 	dados_antigos JSON NOT NULL,
@@ -133,6 +449,12 @@ ON UPDATE CASCADE ON DELETE CASCADE;
 ALTER TABLE contato_empresa
 ADD FOREIGN KEY(id_empresa) REFERENCES empresas(id)
 ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE contato_empresa
+ADD FOREIGN KEY(id_pessoa) REFERENCES pessoas(id)
+ON UPDATE CASCADE ON DELETE SET NULL;
+ALTER TABLE pessoas
+ADD FOREIGN KEY(id_empresa) REFERENCES empresas(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
 ALTER TABLE empregos
 ADD FOREIGN KEY(id_empresa) REFERENCES empresas(id)
 ON UPDATE CASCADE ON DELETE CASCADE;
@@ -150,4 +472,67 @@ ADD FOREIGN KEY(id_remuneracao) REFERENCES remuneracoes(id)
 ON UPDATE CASCADE ON DELETE CASCADE;
 ALTER TABLE detalhamento_holerite
 ADD FOREIGN KEY(id_holerite) REFERENCES holerites(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE escalas
+ADD FOREIGN KEY(id_emprego) REFERENCES empregos(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE escala_dias
+ADD FOREIGN KEY(id_escala) REFERENCES escalas(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE ausencias
+ADD FOREIGN KEY(id_emprego) REFERENCES empregos(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE ausencia_anexos
+ADD FOREIGN KEY(id_ausencia) REFERENCES ausencias(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE correcoes_ponto
+ADD FOREIGN KEY(id_emprego) REFERENCES empregos(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE correcoes_ponto
+ADD FOREIGN KEY(id_cartao_ponto) REFERENCES cartao_ponto(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE remuneracoes
+ADD FOREIGN KEY(id_ocupacao) REFERENCES ocupacoes(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE emprego_ocupacoes
+ADD FOREIGN KEY(id_emprego) REFERENCES empregos(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE emprego_ocupacoes
+ADD FOREIGN KEY(id_ocupacao) REFERENCES ocupacoes(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE detalhamento_holerite
+ADD FOREIGN KEY(id_rubrica) REFERENCES rubricas(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE beneficios
+ADD FOREIGN KEY(id_emprego) REFERENCES empregos(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE convencoes_coletivas
+ADD FOREIGN KEY(id_empresa) REFERENCES empresas(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE convencoes_coletivas
+ADD FOREIGN KEY(id_sindicato) REFERENCES sindicatos(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE notas_fiscais
+ADD FOREIGN KEY(id_emprego) REFERENCES empregos(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE candidaturas
+ADD FOREIGN KEY(id_empresa) REFERENCES empresas(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE candidaturas
+ADD FOREIGN KEY(id_emprego) REFERENCES empregos(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE candidatura_transicoes
+ADD FOREIGN KEY(id_candidatura) REFERENCES candidaturas(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE entrevistas
+ADD FOREIGN KEY(id_candidatura) REFERENCES candidaturas(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE entrevistas
+ADD FOREIGN KEY(id_endereco) REFERENCES enderecos(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE entrevista_entrevistadores
+ADD FOREIGN KEY(id_entrevista) REFERENCES entrevistas(id)
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE entrevista_entrevistadores
+ADD FOREIGN KEY(id_contato) REFERENCES contato_empresa(id)
 ON UPDATE CASCADE ON DELETE CASCADE;
\ No newline at end of file
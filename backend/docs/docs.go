@@ -15,9 +15,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/contato-empresa": {
+        "/busca": {
             "get": {
-                "description": "Retorna todos os contatos que atendam aos critérios informados",
+                "description": "Procura o termo nas empresas, endereços, contatos e empregos, sem diferenciar maiúsculas e acentos e tolerando erros de digitação. Os resultados vêm agrupados pelo tipo de registro, cada um com a pontuação de relevância, de 0 a 1, e o campo que mais correspondeu à busca. CNPJ e CEP são encontrados pelos números, com ou sem formatação.",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,37 +25,6040 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ContatoEmpresa"
+                    "Busca"
                 ],
-                "summary": "Retorna todos os contatos",
+                "summary": "Busca global",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Campo aberto para pesquisa",
+                        "description": "Termo da busca, com ao menos dois caracteres",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de resultados de cada tipo, padrão 10",
+                        "name": "limite",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calendario.ics": {
+            "get": {
+                "description": "Gera um calendário (.ics) com as entrevistas agendadas, os feriados da localidade e as férias dos empregos, para assinatura em aplicativos de calendário.\nSem datas informadas, considera de um ano antes a um ano depois da data atual.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Calendário"
+                ],
+                "summary": "Exporta o calendário no formato iCalendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Data inicial no formato AAAA-MM-DD",
+                        "name": "inicio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final no formato AAAA-MM-DD",
+                        "name": "fim",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Estado para considerar os feriados estaduais",
+                        "name": "uf",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cidade para considerar os feriados municipais. Requer o estado",
+                        "name": "cidade",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/candidaturas": {
+            "get": {
+                "description": "Retorna as candidaturas que atendam aos critérios informados, das mais recentes para as mais antigas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Candidatura"
+                ],
+                "summary": "Retorna as candidaturas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Campo aberto para pesquisa no cargo, na origem e no nome da empresa",
                         "name": "search",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Nome da empresa",
+                        "description": "ID ou nome da empresa",
                         "name": "empresa",
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "telefone",
-                            "whatsapp",
-                            "email"
-                        ],
+                        "enum": [
+                            "aplicado",
+                            "triagem",
+                            "entrevista",
+                            "proposta",
+                            "aceito",
+                            "recusado"
+                        ],
+                        "type": "string",
+                        "description": "Situação da candidatura",
+                        "name": "situacao",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Registra uma candidatura a uma vaga na empresa, na situação aplicado. A situação muda apenas pelas transições.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Candidatura"
+                ],
+                "summary": "Registra uma candidatura",
+                "parameters": [
+                    {
+                        "description": "ID da empresa",
+                        "name": "id_empresa",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Cargo da vaga",
+                        "name": "cargo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Origem da vaga, como indicação ou site de vagas",
+                        "name": "origem",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Pretensão salarial",
+                        "name": "pretensao_salarial",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Salário oferecido",
+                        "name": "salario_oferecido",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Moeda dos salários (ISO 4217), BRL por padrão",
+                        "name": "moeda",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Tipo de contratação",
+                        "name": "tipo_contrato",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Observação",
+                        "name": "observacao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/candidaturas/{id}": {
+            "get": {
+                "description": "Retorna as informações de uma candidatura com o histórico de transições de situação",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Candidatura"
+                ],
+                "summary": "Consulta uma candidatura por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID da candidatura para retornar",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Atualiza os dados de uma candidatura. A situação não é alterada; use a transição de situação.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Candidatura"
+                ],
+                "summary": "Atualiza uma candidatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID da candidatura a ser atualizada",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ID da empresa",
+                        "name": "id_empresa",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Cargo da vaga",
+                        "name": "cargo",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Origem da vaga",
+                        "name": "origem",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Pretensão salarial",
+                        "name": "pretensao_salarial",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Salário oferecido",
+                        "name": "salario_oferecido",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Moeda dos salários (ISO 4217)",
+                        "name": "moeda",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Tipo de contratação",
+                        "name": "tipo_contrato",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Observação",
+                        "name": "observacao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de uma candidatura",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Candidatura"
+                ],
+                "summary": "Apaga uma candidatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID da candidatura a ser apagada",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/candidaturas/{id}/converter": {
+            "post": {
+                "description": "Cria o emprego na empresa e no cargo da candidatura aceita e o vincula a ela. A remuneração inicial e o tipo de contratação, quando não informados, são os da candidatura.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Candidatura"
+                ],
+                "summary": "Converte uma candidatura aceita em emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da candidatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data de início do emprego",
+                        "name": "data_inicio",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Tipo de contratação, por padrão o da candidatura",
+                        "name": "tipo_contrato",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Carga horária",
+                        "name": "carga_horaria",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Remuneração inicial, por padrão o salário oferecido",
+                        "name": "remuneracao_inicial",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/candidaturas/{id}/entrevistas": {
+            "get": {
+                "description": "Retorna as entrevistas da candidatura ordenadas pela data e hora",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Candidatura"
+                ],
+                "summary": "Retorna as entrevistas de uma candidatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da candidatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Agenda uma entrevista do processo seletivo. Entrevistas presenciais exigem o endereço; os entrevistadores devem ser contatos da empresa da candidatura.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Candidatura"
+                ],
+                "summary": "Agenda uma entrevista da candidatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da candidatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data e hora da entrevista",
+                        "name": "data_hora",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Duração em minutos",
+                        "name": "duracao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Formato da entrevista",
+                        "name": "formato",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "remota",
+                                "presencial"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "ID do endereço, obrigatório nas entrevistas presenciais",
+                        "name": "id_endereco",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Link da chamada nas entrevistas remotas",
+                        "name": "link",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "IDs dos contatos da empresa que conduzem a entrevista",
+                        "name": "id_entrevistadores",
+                        "in": "body",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
+                    },
+                    {
+                        "description": "Anotações sobre a entrevista",
+                        "name": "anotacoes",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Resultado da entrevista",
+                        "name": "resultado",
+                        "in": "body",
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "aprovado",
+                                "reprovado"
+                            ]
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/candidaturas/{id}/entrevistas/{id_entrevista}": {
+            "get": {
+                "description": "Retorna a entrevista da candidatura com o endereço e os entrevistadores",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Candidatura"
+                ],
+                "summary": "Consulta uma entrevista por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da candidatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O ID da entrevista para retornar",
+                        "name": "id_entrevista",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Atualiza uma entrevista da candidatura, como o horário, as anotações ou o resultado. Os entrevistadores informados substituem os anteriores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Candidatura"
+                ],
+                "summary": "Atualiza uma entrevista",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da candidatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O ID da entrevista a ser atualizada",
+                        "name": "id_entrevista",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data e hora da entrevista",
+                        "name": "data_hora",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Duração em minutos",
+                        "name": "duracao",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Formato da entrevista",
+                        "name": "formato",
+                        "in": "body",
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "remota",
+                                "presencial"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "ID do endereço, obrigatório nas entrevistas presenciais",
+                        "name": "id_endereco",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Link da chamada nas entrevistas remotas",
+                        "name": "link",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "IDs dos contatos da empresa que conduzem a entrevista",
+                        "name": "id_entrevistadores",
+                        "in": "body",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
+                    },
+                    {
+                        "description": "Anotações sobre a entrevista",
+                        "name": "anotacoes",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Resultado da entrevista",
+                        "name": "resultado",
+                        "in": "body",
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "aprovado",
+                                "reprovado"
+                            ]
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de uma entrevista da candidatura",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Candidatura"
+                ],
+                "summary": "Apaga uma entrevista",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da candidatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O ID da entrevista a ser apagada",
+                        "name": "id_entrevista",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/candidaturas/{id}/situacao": {
+            "post": {
+                "description": "Move a candidatura para a situação informada e registra a transição no histórico. O processo só avança (aplicado, triagem, entrevista, proposta), podendo pular etapas.\nA candidatura pode ser recusada em qualquer etapa, mas só é aceita após a proposta. Aceito e recusado são situações finais.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Candidatura"
+                ],
+                "summary": "Altera a situação de uma candidatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da candidatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Nova situação",
+                        "name": "situacao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "triagem",
+                                "entrevista",
+                                "proposta",
+                                "aceito",
+                                "recusado"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Data da transição, por padrão agora",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Observação sobre a transição",
+                        "name": "observacao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/contato-empresa": {
+            "get": {
+                "description": "Retorna todos os contatos que atendam aos critérios informados",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ContatoEmpresa"
+                ],
+                "summary": "Retorna todos os contatos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Campo aberto para pesquisa",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID ou nome da empresa",
+                        "name": "empresa",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID ou nome da pessoa",
+                        "name": "pessoa",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "telefone",
+                            "whatsapp",
+                            "email",
+                            "site",
+                            "linkedin"
+                        ],
+                        "type": "string",
+                        "description": "Tipo do contato. Aceita apenas os valores 'telefone', 'whatsapp', 'email', 'site' e 'linkedin'",
+                        "name": "tipo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "O contato em si",
+                        "name": "contato",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cadastra um novo contato de empresa de acordo com as informações fornecidas. O contato deve ser único na empresa de acordo com seu tipo, mas pode se repetir em empresas diferentes.\nO contato pode pertencer a uma pessoa da empresa. Ao cadastrar um contato, os dados da empresa não são retornados.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ContatoEmpresa"
+                ],
+                "summary": "Cadastra um novo contato de empresa",
+                "parameters": [
+                    {
+                        "description": "ID da empresa",
+                        "name": "id_empresa",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "ID da pessoa da empresa a quem o contato pertence",
+                        "name": "id_pessoa",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "O tipo de contato. Aceita apenas os valores 'telefone', 'whatsapp', 'email', 'site' e 'linkedin'",
+                        "name": "tipo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "telefone",
+                                "whatsapp",
+                                "email",
+                                "site",
+                                "linkedin"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "O contato em si",
+                        "name": "contato",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/contato-empresa/{id}": {
+            "get": {
+                "description": "Retorna as informações de um contato de uma empresa de acordo com seu ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ContatoEmpresa"
+                ],
+                "summary": "Consulta um contato de empresa por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID do contato da empresa para retornar",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Atualiza um registro de contato de uma empresa de acordo com o ID e as informações informadas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ContatoEmpresa"
+                ],
+                "summary": "Atualiza um contato de empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID do contato de empresa a ser atualizado",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID da empresa para atualizar",
+                        "name": "id_empresa",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID da pessoa da empresa a quem o contato pertence",
+                        "name": "id_pessoa",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "telefone",
+                            "whatsapp",
+                            "email",
+                            "site",
+                            "linkedin"
+                        ],
+                        "type": "string",
+                        "description": "Tipo do contato. Aceita apenas os valores 'telefone', 'whatsapp', 'email', 'site' e 'linkedin'",
+                        "name": "tipo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "O contato em si",
+                        "name": "contato",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de um contato de empresa com base no ID informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ContatoEmpresa"
+                ],
+                "summary": "Apaga um contato de empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID do contato a ser apagada",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cotacoes/importar": {
+            "post": {
+                "description": "Importa as cotações de fechamento da PTAX a partir dos arquivos CSV publicados pelo Banco Central, o diário com todas as moedas ou o histórico de uma moeda.\nAs colunas são a data, o código, o tipo e a sigla da moeda, as taxas de compra e de venda e as paridades. Dias já importados têm as taxas atualizadas.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cotação"
+                ],
+                "summary": "Importa as cotações PTAX",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Arquivo CSV de fechamento da PTAX",
+                        "name": "arquivo",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Moedas a importar, separadas por vírgula, como USD,EUR. Por padrão, todas",
+                        "name": "moedas",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cotacoes/{moeda}": {
+            "get": {
+                "description": "Retorna as cotações PTAX de fechamento importadas para a moeda, em reais por unidade da moeda, ordenadas por data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cotação"
+                ],
+                "summary": "Retorna as cotações de uma moeda",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Código da moeda, como USD ou EUR",
+                        "name": "moeda",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial (AAAA-MM-DD)",
+                        "name": "inicio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final (AAAA-MM-DD)",
+                        "name": "fim",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego": {
+            "get": {
+                "description": "Retorna todos os empregos que atendam aos critérios informados",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Retorna todos os empregos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Campo aberto para pesquisa",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome do empresa",
+                        "name": "empresa",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome da ocupação",
+                        "name": "ocupacao",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Valor da remuneração inicial",
+                        "name": "remuneracao_inicial",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tipo de contratação",
+                        "name": "tipo_contrato",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data de admissão",
+                        "name": "data_inicio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data de demissão",
+                        "name": "data_fim",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Carga horária em minutos",
+                        "name": "carga_horaria",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cadastra um novo emprego de acordo com as informações fornecidas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Cadastra um novo emprego",
+                "parameters": [
+                    {
+                        "description": "ID da empresa",
+                        "name": "id_empresa",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Nome da ocupação",
+                        "name": "ocupacao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Valor da remuneração inicial",
+                        "name": "remuneracao_inicial",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Moeda da remuneração (ISO 4217), BRL por padrão",
+                        "name": "moeda",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Tipo de contratação",
+                        "name": "tipo_contrato",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Data de admissão",
+                        "name": "data_inicio",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Data de demissão",
+                        "name": "data_fim",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Carga horária em minutos",
+                        "name": "carga_horaria",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}": {
+            "get": {
+                "description": "Retorna as informações de um emprego de acordo com seu ID, com a compensação total vigente: a remuneração atual, anualizada com o 13º salário e o terço de férias no regime CLT,\nsomada aos benefícios vigentes em valores mensais e anuais, já descontada a coparticipação.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Consulta um emprego por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID do emprego para retornar",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Atualiza um registro de emprego de acordo com o ID e as informações informadas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Atualiza um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID do emprego a ser atualizada",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ID da empresa",
+                        "name": "id_empresa",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Nome da ocupação",
+                        "name": "ocupacao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Valor da remuneração inicial",
+                        "name": "remuneracao_inicial",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Moeda da remuneração (ISO 4217)",
+                        "name": "moeda",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Tipo de contratação",
+                        "name": "tipo_contrato",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Data de admissão",
+                        "name": "data_inicio",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Data de demissão",
+                        "name": "data_fim",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Carga horária em minutos",
+                        "name": "carga_horaria",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de umo emprego com base no ID informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Apaga umo emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID do emprego a ser apagada",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/ausencias": {
+            "get": {
+                "description": "Retorna as ausências registradas no emprego, ordenadas pela data de início, com os dados dos anexos sem o conteúdo",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ausência"
+                ],
+                "summary": "Retorna as ausências de um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Registra uma ausência entre as datas de início e fim, inclusive. Ausências justificadas abonam a jornada prevista e não afetam o saldo de horas.\nFaltas injustificadas mantêm a jornada prevista, gerando débito, e fazem perder o descanso semanal remunerado do domingo seguinte.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ausência"
+                ],
+                "summary": "Registra uma ausência no emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tipo da ausência",
+                        "name": "tipo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "falta_injustificada",
+                                "atestado_medico",
+                                "licenca_maternidade",
+                                "licenca_paternidade",
+                                "luto",
+                                "casamento",
+                                "doacao_sangue",
+                                "ferias"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Primeiro dia da ausência",
+                        "name": "data_inicio",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Último dia da ausência",
+                        "name": "data_fim",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Observações sobre a ausência",
+                        "name": "observacao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/ausencias/{id_ausencia}": {
+            "get": {
+                "description": "Retorna uma ausência do emprego com base no ID informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ausência"
+                ],
+                "summary": "Retorna uma ausência",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da ausência",
+                        "name": "id_ausencia",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de uma ausência do emprego com base no ID informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ausência"
+                ],
+                "summary": "Apaga uma ausência",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O ID da ausência a ser apagada",
+                        "name": "id_ausencia",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/ausencias/{id_ausencia}/anexos": {
+            "post": {
+                "description": "Anexa um documento à ausência, como um atestado médico ou uma certidão",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ausência"
+                ],
+                "summary": "Anexa um arquivo a uma ausência",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da ausência",
+                        "name": "id_ausencia",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Arquivo a ser anexado",
+                        "name": "arquivo",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/ausencias/{id_ausencia}/anexos/{id_anexo}": {
+            "get": {
+                "description": "Retorna o conteúdo do arquivo anexado à ausência, com o tipo de conteúdo e o nome informados no envio",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Ausência"
+                ],
+                "summary": "Baixa o anexo de uma ausência",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da ausência",
+                        "name": "id_ausencia",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do anexo",
+                        "name": "id_anexo",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/beneficios": {
+            "get": {
+                "description": "Retorna os benefícios do emprego ordenados pelo início da vigência, inclusive os que já não estão vigentes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Retorna os benefícios de um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Registra um benefício concedido no emprego, com o valor pago pela empresa em cada período, a coparticipação descontada do empregado e a vigência.\nBenefícios diários são pagos por dia útil. O alvo da PLR pode ser informado em quantidade de salários, acompanhando a remuneração vigente.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Registra um benefício do emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tipo do benefício",
+                        "name": "tipo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "vale_refeicao",
+                                "vale_alimentacao",
+                                "vale_transporte",
+                                "plano_saude",
+                                "plano_odontologico",
+                                "auxilio_home_office",
+                                "academia",
+                                "plr",
+                                "outro"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Descrição do benefício",
+                        "name": "descricao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Valor concedido em cada período, obrigatório sem o múltiplo do salário",
+                        "name": "valor",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Valor em quantidade de salários, como no alvo da PLR",
+                        "name": "multiplo_salario",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Valor descontado do empregado em cada período",
+                        "name": "coparticipacao",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Periodicidade do benefício",
+                        "name": "periodicidade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "diaria",
+                                "mensal",
+                                "trimestral",
+                                "semestral",
+                                "anual"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Início da vigência",
+                        "name": "data_inicio",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Fim da vigência",
+                        "name": "data_fim",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/beneficios/{id_beneficio}": {
+            "get": {
+                "description": "Retorna um benefício do emprego com base no ID informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Retorna um benefício",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do benefício",
+                        "name": "id_beneficio",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Atualiza um benefício do emprego de acordo com o ID e as informações informadas. Para encerrar um benefício, informe o fim da vigência.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Atualiza um benefício",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do benefício",
+                        "name": "id_beneficio",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tipo do benefício",
+                        "name": "tipo",
+                        "in": "body",
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "vale_refeicao",
+                                "vale_alimentacao",
+                                "vale_transporte",
+                                "plano_saude",
+                                "plano_odontologico",
+                                "auxilio_home_office",
+                                "academia",
+                                "plr",
+                                "outro"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Descrição do benefício",
+                        "name": "descricao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Valor concedido em cada período",
+                        "name": "valor",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Valor em quantidade de salários",
+                        "name": "multiplo_salario",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Valor descontado do empregado em cada período",
+                        "name": "coparticipacao",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Periodicidade do benefício",
+                        "name": "periodicidade",
+                        "in": "body",
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "diaria",
+                                "mensal",
+                                "trimestral",
+                                "semestral",
+                                "anual"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Início da vigência",
+                        "name": "data_inicio",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Fim da vigência",
+                        "name": "data_fim",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de um benefício do emprego",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Apaga um benefício",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O ID do benefício a ser apagado",
+                        "name": "id_beneficio",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/conformidade": {
+            "get": {
+                "description": "Retorna, por dia, as violações das regras de jornada encontradas nas marcações do emprego: descanso inferior a 11h entre jornadas (interjornada),\nintervalo inferior a 1h em jornadas acima de 6h, mais de 2h extras no dia e mais de 6 dias consecutivos de trabalho.\nSe o período não for informado, considera o mês atual.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ponto"
+                ],
+                "summary": "Verifica a conformidade da jornada com a CLT",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial no formato AAAA-MM-DD",
+                        "name": "inicio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final no formato AAAA-MM-DD",
+                        "name": "fim",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/escalas": {
+            "get": {
+                "description": "Retorna o histórico de escalas de um emprego, ordenado pela data de início de vigência",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Escala"
+                ],
+                "summary": "Retorna as escalas de um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cadastra uma escala de trabalho que passa a valer a partir da data de início informada.\nEm escalas semanais, a posição de cada dia é o dia da semana (0 = domingo, 6 = sábado) e dias não informados são folgas. Ex.: 5x2 com 8h diárias informa as posições 1 a 5 com 480 minutos.\nEm escalas de revezamento, a posição é o dia do ciclo contado a partir da data de início. Ex.: 12x36 informa a posição 0 com 720 minutos e a posição 1 com 0 minutos.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Escala"
+                ],
+                "summary": "Cadastra uma nova escala para um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tipo da escala. Aceita apenas os valores 'semanal' e 'revezamento'",
+                        "name": "tipo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "semanal",
+                                "revezamento"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Descrição da escala, como 5x2, 6x1 ou 12x36",
+                        "name": "descricao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Data a partir da qual a escala vigora",
+                        "name": "data_inicio",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Duração do intervalo em minutos",
+                        "name": "intervalo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Lista de dias com a posição e os minutos previstos",
+                        "name": "dias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/escalas/previsto": {
+            "get": {
+                "description": "Retorna os minutos de trabalho e de intervalo previstos para cada dia do período, de acordo com a escala vigente em cada dia e os feriados da localidade da empresa",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Escala"
+                ],
+                "summary": "Calcula a jornada prevista de um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial no formato AAAA-MM-DD",
+                        "name": "inicio",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final no formato AAAA-MM-DD",
+                        "name": "fim",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/escalas/{id_escala}": {
+            "delete": {
+                "description": "Realiza um soft-delete de uma escala do emprego com base no ID informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Escala"
+                ],
+                "summary": "Apaga uma escala",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O ID da escala a ser apagada",
+                        "name": "id_escala",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/espelho-ponto": {
+            "get": {
+                "description": "Retorna o espelho de ponto do mês com as marcações de cada dia, horas previstas e trabalhadas, saldo diário, feriados, totais e saldo do banco de horas.\nO espelho pode ser exportado em CSV ou PDF, gerados localmente, ou retornado em JSON. Se o mês não for informado, considera o mês atual.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Ponto"
+                ],
+                "summary": "Gera o espelho de ponto mensal de um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mês de referência no formato AAAA-MM",
+                        "name": "mes",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "pdf"
+                        ],
+                        "type": "string",
+                        "description": "Formato do espelho. Aceita apenas os valores 'json', 'csv' e 'pdf'",
+                        "name": "formato",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/evolucao-real": {
+            "get": {
+                "description": "Deflaciona cada salário do histórico do emprego pelo índice de preços para valores do mês-base e informa, em percentual, a variação nominal, a inflação e o ganho ou a perda real em cada reajuste e acumulados desde o salário inicial.\nTambém informa a inflação desde o último reajuste e o ganho real acumulado descontando essa inflação.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Retorna a evolução real da remuneração de um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "ipca",
+                            "inpc",
+                            "igpm"
+                        ],
+                        "type": "string",
+                        "description": "Índice de preços, IPCA por padrão",
+                        "name": "indice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Mês-base (AAAA-MM), por padrão o último mês com índice publicado",
+                        "name": "base",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Converte os salários em moeda estrangeira para reais pela PTAX da data de cada um",
+                        "name": "brl",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/holerites": {
+            "get": {
+                "description": "Retorna os holerites do emprego ordenados pelo mês de referência, com as linhas e a rubrica de cada uma",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holerite"
+                ],
+                "summary": "Retorna os holerites de um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Registra o holerite do mês de referência com as suas linhas, vinculado à remuneração vigente no emprego no mês.\nLinhas sem rubrica são classificadas automaticamente pela descrição com o catálogo de rubricas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holerite"
+                ],
+                "summary": "Registra um holerite do emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Mês de referência",
+                        "name": "referencia",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Moeda do holerite (ISO 4217), por padrão a da remuneração vigente",
+                        "name": "moeda",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Data de pagamento, usada na conversão para reais",
+                        "name": "data_pagamento",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Linhas do holerite, com tipo (credito ou debito), valor, descricao e, opcionalmente, id_rubrica",
+                        "name": "detalhamento",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/holerites/{id_holerite}": {
+            "get": {
+                "description": "Retorna um holerite do emprego com as linhas e a rubrica de cada uma",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holerite"
+                ],
+                "summary": "Retorna um holerite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do holerite",
+                        "name": "id_holerite",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/holerites/{id_holerite}/classificar": {
+            "post": {
+                "description": "Atribui rubricas às linhas do holerite pela descrição, usando o nome e os termos das rubricas do catálogo.\nPor padrão, apenas as linhas sem rubrica são classificadas. Com o parâmetro todas, as linhas já classificadas também são revistas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holerite"
+                ],
+                "summary": "Classifica as linhas de um holerite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do holerite",
+                        "name": "id_holerite",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Reclassifica também as linhas que já têm rubrica",
+                        "name": "todas",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/holerites/{id_holerite}/conferencia": {
+            "get": {
+                "description": "Recalcula o INSS e o IRRF do holerite a partir das incidências das rubricas e das tabelas vigentes no mês de referência, inclusive sobre o 13º salário,\ne aponta as diferenças em relação aos valores retidos pela empresa. O IRRF considera a dedução mais vantajosa entre as legais e o desconto simplificado.\nDiferenças de até R$ 0,05 são atribuídas a arredondamentos. Linhas sem rubrica são listadas e impedem a conferência de ser considerada consistente.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holerite"
+                ],
+                "summary": "Confere o INSS e o IRRF de um holerite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do holerite",
+                        "name": "id_holerite",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade de dependentes para a dedução do IRRF",
+                        "name": "dependentes",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/notas-fiscais": {
+            "get": {
+                "description": "Retorna as notas fiscais do emprego ordenadas pela data de emissão",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Retorna as notas fiscais de um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pendente",
+                            "paga"
+                        ],
+                        "type": "string",
+                        "description": "Situação da nota fiscal",
+                        "name": "situacao",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Registra uma nota fiscal de serviço emitida em um emprego PJ. O número não pode se repetir no emprego.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Registra uma nota fiscal do emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Número da nota fiscal",
+                        "name": "numero",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Data de emissão",
+                        "name": "data_emissao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Valor do serviço",
+                        "name": "valor",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Situação da nota fiscal",
+                        "name": "situacao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "pendente",
+                                "paga"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Data de pagamento",
+                        "name": "data_pagamento",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Descrição do serviço",
+                        "name": "descricao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/notas-fiscais/das": {
+            "get": {
+                "description": "Estima o DAS da competência pelos anexos III ou V do Simples Nacional, com a receita das notas fiscais emitidas no mês e a receita bruta dos doze meses anteriores (RBT12).\nSem anexo informado, ele é definido pelo Fator R: a folha de salários, calculada pelo pró-labore mensal, dividida pela RBT12, com o anexo III a partir de 28%.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Estima o DAS do Simples Nacional",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Competência (AAAA-MM), por padrão o mês atual",
+                        "name": "competencia",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Pró-labore mensal, usado na folha de salários do Fator R",
+                        "name": "pro_labore",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "iii",
+                            "v"
+                        ],
+                        "type": "string",
+                        "description": "Anexo do Simples Nacional, por padrão definido pelo Fator R",
+                        "name": "anexo",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/notas-fiscais/{id_nota_fiscal}": {
+            "get": {
+                "description": "Retorna uma nota fiscal do emprego com base no ID informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Retorna uma nota fiscal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da nota fiscal",
+                        "name": "id_nota_fiscal",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Atualiza uma nota fiscal do emprego de acordo com o ID e as informações informadas. Para registrar o pagamento, informe a situação paga e a data de pagamento.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Atualiza uma nota fiscal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da nota fiscal",
+                        "name": "id_nota_fiscal",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Número da nota fiscal",
+                        "name": "numero",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Data de emissão",
+                        "name": "data_emissao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Valor do serviço",
+                        "name": "valor",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Situação da nota fiscal",
+                        "name": "situacao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "pendente",
+                                "paga"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Data de pagamento",
+                        "name": "data_pagamento",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Descrição do serviço",
+                        "name": "descricao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de uma nota fiscal do emprego",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Apaga uma nota fiscal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O ID da nota fiscal a ser apagada",
+                        "name": "id_nota_fiscal",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/ocupacoes": {
+            "get": {
+                "description": "Retorna as ocupações exercidas no emprego ordenadas pela data de início, com o fim de cada período e as remunerações recebidas nele",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Retorna o histórico de ocupações de um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Registra a ocupação exercida no emprego a partir da data informada, como em uma promoção. A ocupação anterior termina na véspera.\nQuando informada, a nova remuneração é registrada no histórico salarial na mesma data e vinculada à ocupação.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Registra uma mudança de ocupação no emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ID da ocupação do catálogo",
+                        "name": "id_ocupacao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Data a partir da qual a ocupação vigora",
+                        "name": "data_inicio",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Observações sobre a mudança",
+                        "name": "observacao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Nova remuneração a partir da data de início",
+                        "name": "remuneracao",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Moeda da nova remuneração (ISO 4217), por padrão a do emprego",
+                        "name": "moeda",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/ocupacoes/{id_historico}": {
+            "delete": {
+                "description": "Realiza um soft-delete de um período do histórico de ocupações. As remunerações registradas não são apagadas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Apaga uma ocupação do histórico do emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O ID do período a ser apagado",
+                        "name": "id_historico",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/ponto/correcoes": {
+            "get": {
+                "description": "Retorna as correções de ponto solicitadas no emprego, com a marcação original de cada uma, opcionalmente filtradas pela situação",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ponto"
+                ],
+                "summary": "Retorna as correções de ponto de um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pendente",
+                            "aprovada",
+                            "rejeitada"
+                        ],
+                        "type": "string",
+                        "description": "Situação das correções",
+                        "name": "situacao",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Registra uma solicitação pendente para incluir, alterar ou remover uma marcação de ponto. A justificativa é obrigatória.\nA marcação original é preservada: a correção só passa a valer na apuração e no espelho de ponto depois de aprovada.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ponto"
+                ],
+                "summary": "Solicita uma correção no cartão de ponto",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ação da correção",
+                        "name": "acao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "incluir",
+                                "alterar",
+                                "remover"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "ID da marcação a ser alterada ou removida",
+                        "name": "id_cartao_ponto",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Novo horário, obrigatório ao incluir ou alterar uma marcação",
+                        "name": "horario",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Tipo da marcação, obrigatório ao incluir",
+                        "name": "tipo",
+                        "in": "body",
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "entrada",
+                                "saida"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Justificativa da correção",
+                        "name": "justificativa",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/ponto/correcoes/{id_correcao}": {
+            "get": {
+                "description": "Retorna uma correção de ponto do emprego com base no ID informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ponto"
+                ],
+                "summary": "Retorna uma correção de ponto",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da correção",
+                        "name": "id_correcao",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de uma correção de ponto ainda pendente. Correções aprovadas ou rejeitadas não podem ser apagadas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ponto"
+                ],
+                "summary": "Apaga uma correção de ponto pendente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O ID da correção a ser apagada",
+                        "name": "id_correcao",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/ponto/correcoes/{id_correcao}/decisao": {
+            "post": {
+                "description": "Registra a decisão sobre uma correção pendente. O motivo é obrigatório ao rejeitar. Correções já decididas não podem ser alteradas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ponto"
+                ],
+                "summary": "Aprova ou rejeita uma correção de ponto",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da correção",
+                        "name": "id_correcao",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decisão sobre a correção",
+                        "name": "situacao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "aprovada",
+                                "rejeitada"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Motivo da decisão, obrigatório ao rejeitar",
+                        "name": "motivo",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/ponto/importar": {
+            "post": {
+                "description": "Importa as marcações de um Arquivo Fonte de Dados (AFD) gerado por relógios de ponto nos leiautes das Portarias 671/2021 e 1510/2009.\nO CRC-16 dos registros e a cadeia de hash SHA-256 das marcações do REP-P são validados quando presentes, e marcações já registradas no cartão de ponto do emprego são ignoradas.\nComo o AFD não distingue entradas de saídas, as marcações de cada dia são alternadas a partir de uma entrada.\nRetorna a situação de cada linha do arquivo que não foi importada silenciosamente: importada, ignorada ou com erro.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ponto"
+                ],
+                "summary": "Importa marcações de ponto de um arquivo AFD",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Arquivo AFD",
+                        "name": "arquivo",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CPF do empregado, ou PIS no leiaute da Portaria 1510, para importar apenas as suas marcações",
+                        "name": "cpf",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/remuneracoes": {
+            "get": {
+                "description": "Retorna as remunerações do emprego ordenadas por data, com a ocupação de cada uma",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Retorna o histórico salarial de um emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Registra a remuneração recebida a partir da data informada. Sem a ocupação informada, a remuneração é vinculada à ocupação vigente no emprego na data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Registra uma remuneração no histórico salarial do emprego",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Valor da remuneração",
+                        "name": "remuneracao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Moeda da remuneração (ISO 4217), por padrão a do emprego",
+                        "name": "moeda",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Data a partir da qual a remuneração vigora",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "ID da ocupação do catálogo",
+                        "name": "id_ocupacao",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/emprego/{id}/remuneracoes/{id_remuneracao}": {
+            "delete": {
+                "description": "Realiza um soft-delete de uma remuneração do histórico salarial do emprego",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Emprego"
+                ],
+                "summary": "Apaga uma remuneração",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do emprego",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O ID da remuneração a ser apagada",
+                        "name": "id_remuneracao",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/empresa": {
+            "get": {
+                "description": "Retorna todos as empresas que atendam aos critérios informados",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Retorna todos as empresas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Campo aberto para pesquisa",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome da empresa",
+                        "name": "nome",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CNPJ da empresa",
+                        "name": "cnpj",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cadastra um nova empresa de acordo com as informações fornecidas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Cadastra um nova empresa",
+                "parameters": [
+                    {
+                        "description": "Nome da empresa",
+                        "name": "nome",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "CNPJ da empresa",
+                        "name": "cnpj",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/empresa/consulta-cnpj/{cnpj}": {
+            "get": {
+                "description": "Retorna a razão social, o nome fantasia, a situação cadastral e o endereço do CNPJ nos dados abertos importados da Receita Federal. Quando a empresa já estiver cadastrada, ela é retornada junto.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Consulta um CNPJ nos dados da Receita Federal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CNPJ, com ou sem formatação",
+                        "name": "cnpj",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cadastra a empresa, o endereço e o vínculo entre eles em uma única operação, com os dados abertos importados da Receita Federal. O nome da empresa é a razão social, acrescida do CNPJ se já houver outra empresa com ela. O endereço é normalizado e, se já estiver cadastrado, reaproveitado.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Cadastra a empresa de um CNPJ",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CNPJ, com ou sem formatação",
+                        "name": "cnpj",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/empresa/{id}": {
+            "get": {
+                "description": "Retorna as informações de uma empresa de acordo com seu ID. Quando o CNPJ é válido, informa se a empresa é matriz ou filial e lista as outras empresas cadastradas com a mesma raiz de CNPJ, os oito primeiros dígitos.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Consulta uma empresa por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID da empresa para retornar",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Atualiza um registro de empresa de acordo com o ID e as informações informadas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Atualiza uma empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID da empresa a ser atualizada",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Nome da empresa",
+                        "name": "nome",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "CNPJ da empresa",
+                        "name": "cnpj",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de uma empresa com base no ID informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Apaga uma empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID da empresa a ser apagada",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/empresa/{id}/convencoes": {
+            "get": {
+                "description": "Retorna as convenções coletivas da empresa com o sindicato de cada uma, da mais recente para a mais antiga",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Retorna as convenções coletivas de uma empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Registra a convenção ou o acordo coletivo de um ano, com o sindicato da categoria e o percentual de reajuste na data-base do sindicato",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Registra uma convenção coletiva da empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ID do sindicato",
+                        "name": "id_sindicato",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Ano da convenção",
+                        "name": "ano",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Percentual de reajuste, como 4.5 para 4,5%",
+                        "name": "percentual",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Observação sobre a convenção",
+                        "name": "observacao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/empresa/{id}/convencoes/{id_convencao}": {
+            "get": {
+                "description": "Retorna uma convenção coletiva da empresa com base no ID informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Retorna uma convenção coletiva",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da convenção coletiva",
+                        "name": "id_convencao",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Atualiza uma convenção coletiva da empresa de acordo com o ID e as informações informadas. Os reajustes já aplicados não são alterados.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Atualiza uma convenção coletiva",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da convenção coletiva",
+                        "name": "id_convencao",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ID do sindicato",
+                        "name": "id_sindicato",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Ano da convenção",
+                        "name": "ano",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Percentual de reajuste",
+                        "name": "percentual",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Observação sobre a convenção",
+                        "name": "observacao",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de uma convenção coletiva da empresa. As remunerações já registradas pelo reajuste são mantidas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Apaga uma convenção coletiva",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O ID da convenção coletiva a ser apagada",
+                        "name": "id_convencao",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/empresa/{id}/convencoes/{id_convencao}/reajuste": {
+            "post": {
+                "description": "Reajusta pelo percentual da convenção o salário de cada emprego da empresa ativo na data-base, registrando uma remuneração no primeiro dia do mês da data-base.\nEmpregos iniciados na data-base ou depois dela, encerrados antes dela ou já reajustados na data-base são ignorados.\nCom dry_run, retorna o salário anterior e o reajustado de cada emprego sem registrar nenhuma remuneração.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Aplica o reajuste da convenção coletiva",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da convenção coletiva",
+                        "name": "id_convencao",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Apenas simula o reajuste",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/empresa/{id}/mesclar": {
+            "post": {
+                "description": "Transfere para a empresa os vínculos com endereços, as pessoas, os contatos, os empregos, as candidaturas e as convenções coletivas das empresas duplicadas e apaga as duplicadas, em uma única operação",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empresa"
+                ],
+                "summary": "Mescla empresas duplicadas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID da empresa que permanece",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "IDs das empresas duplicadas",
+                        "name": "duplicadas",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/empresa/{id}/pessoas": {
+            "get": {
+                "description": "Retorna as pessoas da empresa, com os contatos de cada uma, ordenadas pelo nome",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pessoa"
+                ],
+                "summary": "Retorna as pessoas de contato de uma empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo aberto para pesquisa no nome, cargo, departamento e LinkedIn",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/endereco": {
+            "get": {
+                "description": "Retorna todos os endereços que atendam aos critérios informados",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Endereco"
+                ],
+                "summary": "Retorna todos os endereços",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Campo aberto para pesquisa",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Logradouro do endereço",
+                        "name": "logradouro",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Número do endereço",
+                        "name": "numero",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Complemento do endereço, caso exista",
+                        "name": "complemento",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome do bairro",
+                        "name": "bairro",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome da cidade",
+                        "name": "cidade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CEP",
+                        "name": "cep",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Estado",
+                        "name": "estado",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cadastra um novo endereço de acordo com as informações fornecidas. O endereço é normalizado, com o tipo de logradouro por extenso, o CEP formatado e o estado pela sigla, e não pode repetir um endereço já cadastrado. Com preencher, o logradouro, o bairro, a cidade e o estado não informados são completados pela base de CEPs, e os informados que divergem do CEP retornam nos avisos do endereço.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Endereco"
+                ],
+                "summary": "Cadastra um novo endereço",
+                "parameters": [
+                    {
+                        "description": "Logradouro do endereço",
+                        "name": "logradouro",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Número do endereço",
+                        "name": "numero",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Complemento do endereço, caso exista",
+                        "name": "complemento",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Nome do bairro",
+                        "name": "bairro",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Nome da cidade",
+                        "name": "cidade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "CEP",
+                        "name": "cep",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Estado",
+                        "name": "estado",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Completa os campos vazios pelo CEP",
+                        "name": "preencher",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/endereco-empresa/assign": {
+            "post": {
+                "description": "Faz a associação de um endereço com uma empresa através dos IDs de ambas as entidades.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EnderecoEmpresa"
+                ],
+                "summary": "Associa um endereço a uma empresa.",
+                "parameters": [
+                    {
+                        "description": "ID da empresa",
+                        "name": "id_empresa",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "ID do endereço",
+                        "name": "id_endereco",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/endereco-empresa/empresas-por-endereco/{id}": {
+            "get": {
+                "description": "Consulta todas as empresas que estão associadas com um endereço, pelo ID do endereço.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EnderecoEmpresa"
+                ],
+                "summary": "Retorna as empresas associadas à um endereço.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do endereço",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/endereco-empresa/enderecos-por-empresa/{id}": {
+            "get": {
+                "description": "Consulta todos os endereços que estão associadas com uma empresa, pelo ID da empresa.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EnderecoEmpresa"
+                ],
+                "summary": "Retorna os endereços associados à uma empresa",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/endereco/cep/{cep}": {
+            "get": {
+                "description": "Retorna o logradouro, o bairro, a cidade e o estado do CEP na base de CEPs importada. Nas cidades com CEP único, o logradouro e o bairro são vazios.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Endereco"
+                ],
+                "summary": "Consulta um CEP",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CEP, com ou sem hífen",
+                        "name": "cep",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/endereco/duplicados": {
+            "get": {
+                "description": "Retorna os grupos de endereços com o mesmo logradouro, número, cidade e estado depois de normalizados, ainda que o complemento, o bairro ou o CEP sejam diferentes, com as empresas vinculadas a cada um",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Endereco"
+                ],
+                "summary": "Relatório de endereços duplicados",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/endereco/{id}": {
+            "get": {
+                "description": "Retorna as informações de um endereço de acordo com seu ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Endereco"
+                ],
+                "summary": "Consulta um endereço por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID do endereço para retornar",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Atualiza um registro de endereço de acordo com o ID e as informações informadas. O endereço é normalizado e não pode ficar igual a outro endereço cadastrado.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Endereco"
+                ],
+                "summary": "Atualiza um endereço",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID do endereço a ser atualizado",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Logradouro do endereço",
+                        "name": "logradouro",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Número do endereço",
+                        "name": "numero",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Complemento do endereço, caso exista",
+                        "name": "complemento",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Nome do bairro",
+                        "name": "bairro",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Nome da cidade",
+                        "name": "cidade",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "CEP",
+                        "name": "cep",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Estado",
+                        "name": "estado",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de um endereço com base no ID informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Endereco"
+                ],
+                "summary": "Apaga um endereço",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID do endereço a ser apagado",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/endereco/{id}/mesclar": {
+            "post": {
+                "description": "Transfere para o endereço os vínculos com empresas e as entrevistas dos endereços duplicados e apaga os duplicados, em uma única operação",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Endereco"
+                ],
+                "summary": "Mescla endereços duplicados",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID do endereço que permanece",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "IDs dos endereços duplicados",
+                        "name": "duplicados",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/feriados": {
+            "get": {
+                "description": "Retorna os feriados nacionais do ano, incluindo os móveis (Carnaval, Sexta-feira Santa e Corpus Christi), somados aos feriados estaduais e municipais cadastrados para a localidade informada",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feriado"
+                ],
+                "summary": "Retorna os feriados de um ano",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Ano de referência. Se não informado, utiliza o ano atual",
+                        "name": "ano",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Estado para incluir os feriados estaduais",
+                        "name": "uf",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cidade para incluir os feriados municipais. Requer o estado",
+                        "name": "cidade",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cadastra um novo feriado local de acordo com as informações fornecidas. Feriados nacionais são gerados automaticamente e não precisam ser cadastrados.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feriado"
+                ],
+                "summary": "Cadastra um novo feriado estadual ou municipal",
+                "parameters": [
+                    {
+                        "description": "Data do feriado",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Nome do feriado",
+                        "name": "nome",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Abrangência do feriado. Aceita apenas os valores 'estadual' e 'municipal'",
+                        "name": "abrangencia",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "estadual",
+                                "municipal"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Estado onde o feriado é observado, conforme cadastrado nos endereços",
+                        "name": "estado",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Cidade onde o feriado é observado. Obrigatório para feriados municipais",
+                        "name": "cidade",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Indica se o feriado se repete todo ano no mesmo dia e mês",
+                        "name": "recorrente",
+                        "in": "body",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/feriados/dias-uteis": {
+            "get": {
+                "description": "Conta os dias de segunda a sexta entre as datas informadas, inclusive, desconsiderando os feriados da localidade",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feriado"
+                ],
+                "summary": "Calcula os dias úteis entre duas datas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Data inicial no formato AAAA-MM-DD",
+                        "name": "inicio",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final no formato AAAA-MM-DD",
+                        "name": "fim",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
                         "type": "string",
-                        "description": "Tipo do contato. Aceita apenas os valores 'telefone', 'whatsapp' e 'email'",
-                        "name": "tipo",
+                        "description": "Estado para considerar os feriados estaduais",
+                        "name": "uf",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "O contato em si",
-                        "name": "contato",
+                        "description": "Cidade para considerar os feriados municipais. Requer o estado",
+                        "name": "cidade",
                         "in": "query"
                     }
                 ],
@@ -79,9 +6082,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Cadastra um novo contato de empresa de acordo com as informações fornecidas. O contato deve ser único de acordo com seu tipo.\nAo cadastrar um contato, os dados da empresa não são retornados.",
+            }
+        },
+        "/feriados/{id}": {
+            "get": {
+                "description": "Retorna as informações de um feriado estadual ou municipal de acordo com seu ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -89,41 +6094,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ContatoEmpresa"
+                    "Feriado"
                 ],
-                "summary": "Cadastra um novo contato de empresa",
+                "summary": "Consulta um feriado cadastrado por ID",
                 "parameters": [
                     {
-                        "description": "ID da empresa",
-                        "name": "id_empresa",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
-                    },
-                    {
-                        "description": "O tipo de contato. Aceita apenas os valores 'telefone', 'whatsapp' e 'email'",
-                        "name": "tipo",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string",
-                            "enum": [
-                                "telefone",
-                                "whatsapp",
-                                "email"
-                            ]
-                        }
-                    },
-                    {
-                        "description": "O contato em si",
-                        "name": "contato",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
+                        "type": "string",
+                        "description": "O ID do feriado para retornar",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -146,11 +6126,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/contato-empresa/{id}": {
-            "get": {
-                "description": "Retorna as informações de um contato de uma empresa de acordo com seu ID",
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de um feriado cadastrado com base no ID informado",
                 "consumes": [
                     "application/json"
                 ],
@@ -158,13 +6136,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ContatoEmpresa"
+                    "Feriado"
                 ],
-                "summary": "Consulta um contato de empresa por ID",
+                "summary": "Apaga um feriado",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "O ID do contato da empresa para retornar",
+                        "description": "O ID do feriado a ser apagado",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -190,9 +6168,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Atualiza um registro de contato de uma empresa de acordo com o ID e as informações informadas",
+            }
+        },
+        "/indices/{indice}": {
+            "get": {
+                "description": "Retorna as variações mensais importadas do índice de preços, ordenadas pelo mês de referência",
                 "consumes": [
                     "application/json"
                 ],
@@ -200,38 +6180,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ContatoEmpresa"
+                    "Índice"
                 ],
-                "summary": "Atualiza um contato de empresa",
+                "summary": "Retorna as variações mensais de um índice",
                 "parameters": [
                     {
+                        "enum": [
+                            "ipca",
+                            "inpc",
+                            "igpm"
+                        ],
                         "type": "string",
-                        "description": "O ID do contato de empresa a ser atualizado",
-                        "name": "id",
+                        "description": "Índice de preços",
+                        "name": "indice",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "ID da empresa para atualizar",
-                        "name": "id_empresa",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "telefone",
-                            "whatsapp",
-                            "email"
-                        ],
                         "type": "string",
-                        "description": "Tipo do contato. Aceita apenas os valores 'telefone', 'whatsapp' e 'email'",
-                        "name": "tipo",
+                        "description": "Primeiro mês de referência (AAAA-MM-DD)",
+                        "name": "inicio",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "O contato em si",
-                        "name": "contato",
+                        "description": "Último mês de referência (AAAA-MM-DD)",
+                        "name": "fim",
                         "in": "query"
                     }
                 ],
@@ -255,26 +6229,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Realiza um soft-delete de um contato de empresa com base no ID informado",
+            }
+        },
+        "/indices/{indice}/importar": {
+            "post": {
+                "description": "Importa as variações mensais do IPCA, do INPC ou do IGP-M a partir do arquivo CSV exportado do Sistema Gerenciador de Séries Temporais do Banco Central (séries 433, 188 e 189) ou de uma tabela do SIDRA do IBGE.\nAceita arquivos separados por ponto e vírgula ou vírgula, em ISO-8859-1 ou UTF-8, com o mês em DD/MM/AAAA, MM/AAAA, AAAA-MM, AAAAMM ou por extenso. Meses já importados têm a variação atualizada.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ContatoEmpresa"
+                    "Índice"
                 ],
-                "summary": "Apaga um contato de empresa",
+                "summary": "Importa as variações mensais de um índice",
                 "parameters": [
                     {
+                        "enum": [
+                            "ipca",
+                            "inpc",
+                            "igpm"
+                        ],
                         "type": "string",
-                        "description": "O ID do contato a ser apagada",
-                        "name": "id",
+                        "description": "Índice de preços",
+                        "name": "indice",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Arquivo CSV com as variações mensais",
+                        "name": "arquivo",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -299,9 +6287,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/emprego": {
+        "/ocupacoes": {
             "get": {
-                "description": "Retorna todos os empregos que atendam aos critérios informados",
+                "description": "Retorna as ocupações do catálogo que atendam aos critérios informados, ordenadas por nome",
                 "consumes": [
                     "application/json"
                 ],
@@ -309,56 +6297,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Emprego"
+                    "Ocupação"
                 ],
-                "summary": "Retorna todos os empregos",
+                "summary": "Retorna as ocupações",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Campo aberto para pesquisa",
+                        "description": "Campo aberto para pesquisa no nome e no código CBO",
                         "name": "search",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Nome do empresa",
-                        "name": "empresa",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Nome da ocupação",
-                        "name": "ocupacao",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Valor da remuneração inicial",
-                        "name": "remuneracao_inicial",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Tipo de contratação",
-                        "name": "tipo_contrato",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data de admissão",
-                        "name": "data_inicio",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data de demissão",
-                        "name": "data_fim",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Carga horária em minutos",
-                        "name": "carga_horaria",
+                        "description": "Código CBO",
+                        "name": "cbo",
                         "in": "query"
                     }
                 ],
@@ -384,7 +6336,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Cadastra um novo emprego de acordo com as informações fornecidas",
+                "description": "Cadastra uma nova ocupação no catálogo, opcionalmente com o código da Classificação Brasileira de Ocupações (CBO)",
                 "consumes": [
                     "application/json"
                 ],
@@ -392,22 +6344,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Emprego"
+                    "Ocupação"
                 ],
-                "summary": "Cadastra um novo emprego",
+                "summary": "Cadastra uma nova ocupação",
                 "parameters": [
-                    {
-                        "description": "ID da empresa",
-                        "name": "id_empresa",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
-                    },
                     {
                         "description": "Nome da ocupação",
-                        "name": "ocupacao",
+                        "name": "nome",
                         "in": "body",
                         "required": true,
                         "schema": {
@@ -415,50 +6358,57 @@ const docTemplate = `{
                         }
                     },
                     {
-                        "description": "Valor da remuneração inicial",
-                        "name": "remuneracao_inicial",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "number"
-                        }
-                    },
-                    {
-                        "description": "Tipo de contratação",
-                        "name": "tipo_contrato",
+                        "description": "Código CBO, com ou sem hífen. Ex.: 2124-05",
+                        "name": "cbo",
                         "in": "body",
-                        "required": true,
                         "schema": {
                             "type": "string"
                         }
-                    },
-                    {
-                        "description": "Data de admissão",
-                        "name": "data_inicio",
-                        "in": "body",
-                        "required": true,
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.Response"
                         }
                     },
-                    {
-                        "description": "Data de demissão",
-                        "name": "data_fim",
-                        "in": "body",
-                        "required": true,
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.Response"
                         }
                     },
-                    {
-                        "description": "Carga horária em minutos",
-                        "name": "carga_horaria",
-                        "in": "body",
-                        "required": true,
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "integer"
+                            "$ref": "#/definitions/models.Response"
                         }
                     }
+                }
+            }
+        },
+        "/ocupacoes/importar-cbo": {
+            "post": {
+                "description": "Importa a lista oficial de ocupações da Classificação Brasileira de Ocupações a partir do arquivo CSV publicado pelo Ministério do Trabalho (CBO2002 - Ocupacao.csv), com as colunas CODIGO e TITULO.\nAceita arquivos separados por ponto e vírgula ou vírgula, em ISO-8859-1 ou UTF-8. Ocupações com código já cadastrado têm o nome atualizado.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ocupação"
+                ],
+                "summary": "Importa as ocupações da CBO",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Arquivo CSV de ocupações da CBO",
+                        "name": "arquivo",
+                        "in": "formData",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -482,9 +6432,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/emprego/{id}": {
+        "/ocupacoes/{id}": {
             "get": {
-                "description": "Retorna as informações de um emprego de acordo com seu ID",
+                "description": "Retorna as informações de uma ocupação de acordo com seu ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -492,13 +6442,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Emprego"
+                    "Ocupação"
                 ],
-                "summary": "Consulta um emprego por ID",
+                "summary": "Consulta uma ocupação por ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "O ID do emprego para retornar",
+                        "description": "O ID da ocupação para retornar",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -526,7 +6476,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Atualiza um registro de emprego de acordo com o ID e as informações informadas",
+                "description": "Atualiza um registro de ocupação de acordo com o ID e as informações informadas",
                 "consumes": [
                     "application/json"
                 ],
@@ -534,72 +6484,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Emprego"
+                    "Ocupação"
                 ],
-                "summary": "Atualiza um emprego",
+                "summary": "Atualiza uma ocupação",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "O ID do emprego a ser atualizada",
+                        "description": "O ID da ocupação a ser atualizada",
                         "name": "id",
                         "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "ID da empresa",
-                        "name": "id_empresa",
-                        "in": "body",
-                        "schema": {
-                            "type": "integer"
-                        }
-                    },
-                    {
-                        "description": "Nome da ocupação",
-                        "name": "ocupacao",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "Valor da remuneração inicial",
-                        "name": "remuneracao_inicial",
-                        "in": "body",
-                        "schema": {
-                            "type": "number"
-                        }
-                    },
-                    {
-                        "description": "Tipo de contratação",
-                        "name": "tipo_contrato",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "required": true
                     },
                     {
-                        "description": "Data de admissão",
-                        "name": "data_inicio",
+                        "description": "Nome da ocupação",
+                        "name": "nome",
                         "in": "body",
                         "schema": {
                             "type": "string"
                         }
                     },
                     {
-                        "description": "Data de demissão",
-                        "name": "data_fim",
+                        "description": "Código CBO, com ou sem hífen",
+                        "name": "cbo",
                         "in": "body",
                         "schema": {
                             "type": "string"
                         }
-                    },
-                    {
-                        "description": "Carga horária em minutos",
-                        "name": "carga_horaria",
-                        "in": "body",
-                        "schema": {
-                            "type": "integer"
-                        }
                     }
                 ],
                 "responses": {
@@ -624,7 +6534,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Realiza um soft-delete de umo emprego com base no ID informado",
+                "description": "Realiza um soft-delete de uma ocupação com base no ID informado",
                 "consumes": [
                     "application/json"
                 ],
@@ -632,13 +6542,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Emprego"
+                    "Ocupação"
                 ],
-                "summary": "Apaga umo emprego",
+                "summary": "Apaga uma ocupação",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "O ID do emprego a ser apagada",
+                        "description": "O ID da ocupação a ser apagada",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -666,9 +6576,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/empresa": {
+        "/pessoas": {
             "get": {
-                "description": "Retorna todos as empresas que atendam aos critérios informados",
+                "description": "Retorna as pessoas de todas as empresas que atendam aos critérios informados, ordenadas pela empresa e pelo nome",
                 "consumes": [
                     "application/json"
                 ],
@@ -676,26 +6586,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Empresa"
+                    "Pessoa"
                 ],
-                "summary": "Retorna todos as empresas",
+                "summary": "Pesquisa as pessoas de contato",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Campo aberto para pesquisa",
+                        "description": "Campo aberto para pesquisa no nome, cargo, departamento, LinkedIn e nome da empresa",
                         "name": "search",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Nome da empresa",
-                        "name": "nome",
+                        "description": "ID ou nome da empresa",
+                        "name": "empresa",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "CNPJ da empresa",
-                        "name": "cnpj",
+                        "description": "Departamento da pessoa",
+                        "name": "departamento",
                         "in": "query"
                     }
                 ],
@@ -721,7 +6631,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Cadastra um nova empresa de acordo com as informações fornecidas",
+                "description": "Cadastra uma pessoa da empresa, como um recrutador ou alguém do RH. Os contatos da pessoa são cadastrados nos contatos da empresa, informando o ID dela.",
                 "consumes": [
                     "application/json"
                 ],
@@ -729,12 +6639,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Empresa"
+                    "Pessoa"
                 ],
-                "summary": "Cadastra um nova empresa",
+                "summary": "Cadastra uma pessoa de contato da empresa",
                 "parameters": [
                     {
-                        "description": "Nome da empresa",
+                        "description": "ID da empresa",
+                        "name": "id_empresa",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Nome da pessoa",
                         "name": "nome",
                         "in": "body",
                         "required": true,
@@ -743,10 +6662,25 @@ const docTemplate = `{
                         }
                     },
                     {
-                        "description": "CNPJ da empresa",
-                        "name": "cnpj",
+                        "description": "Cargo da pessoa na empresa",
+                        "name": "cargo",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Departamento da pessoa na empresa",
+                        "name": "departamento",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Perfil da pessoa no LinkedIn",
+                        "name": "linkedin",
                         "in": "body",
-                        "required": true,
                         "schema": {
                             "type": "string"
                         }
@@ -774,9 +6708,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/empresa/{id}": {
+        "/pessoas/{id}": {
             "get": {
-                "description": "Retorna as informações de uma empresa de acordo com seu ID",
+                "description": "Retorna as informações de uma pessoa com os contatos dela",
                 "consumes": [
                     "application/json"
                 ],
@@ -784,13 +6718,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Empresa"
+                    "Pessoa"
                 ],
-                "summary": "Consulta uma empresa por ID",
+                "summary": "Consulta uma pessoa de contato por ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "O ID da empresa para retornar",
+                        "description": "O ID da pessoa para retornar",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -818,7 +6752,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Atualiza um registro de empresa de acordo com o ID e as informações informadas",
+                "description": "Atualiza os dados de uma pessoa. A empresa da pessoa não é alterada.",
                 "consumes": [
                     "application/json"
                 ],
@@ -826,19 +6760,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Empresa"
+                    "Pessoa"
                 ],
-                "summary": "Atualiza uma empresa",
+                "summary": "Atualiza uma pessoa de contato",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "O ID da empresa a ser atualizada",
+                        "description": "O ID da pessoa a ser atualizada",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Nome da empresa",
+                        "description": "Nome da pessoa",
                         "name": "nome",
                         "in": "body",
                         "schema": {
@@ -846,8 +6780,24 @@ const docTemplate = `{
                         }
                     },
                     {
-                        "description": "CNPJ da empresa",
-                        "name": "cnpj",
+                        "description": "Cargo da pessoa na empresa",
+                        "name": "cargo",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Departamento da pessoa na empresa",
+                        "name": "departamento",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Perfil da pessoa no LinkedIn",
+                        "name": "linkedin",
                         "in": "body",
                         "schema": {
                             "type": "string"
@@ -876,7 +6826,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Realiza um soft-delete de uma empresa com base no ID informado",
+                "description": "Realiza um soft-delete de uma pessoa. Os contatos dela continuam como contatos gerais da empresa.",
                 "consumes": [
                     "application/json"
                 ],
@@ -884,13 +6834,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Empresa"
+                    "Pessoa"
                 ],
-                "summary": "Apaga uma empresa",
+                "summary": "Apaga uma pessoa de contato",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "O ID da empresa a ser apagada",
+                        "description": "O ID da pessoa a ser apagada",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -918,67 +6868,304 @@ const docTemplate = `{
                 }
             }
         },
-        "/endereco": {
+        "/relatorios/rendimentos": {
             "get": {
-                "description": "Retorna todos os endereços que atendam aos critérios informados",
+                "description": "Agrupa as linhas dos holerites pagos no ano (regime de caixa: data de pagamento ou, sem ela, mês de referência) por CNPJ da empresa, com os campos da ficha \"Rendimentos tributáveis recebidos de pessoa jurídica\" da DIRPF:\nrendimentos tributáveis, contribuição previdenciária oficial, imposto retido na fonte, 13º salário (líquido do INSS sobre ele) e IRRF sobre o 13º.\nTambém informa a participação nos lucros, de tributação exclusiva, e os rendimentos isentos. As linhas são classificadas pela rubrica ou, quando não houver, pela descrição.\nHolerites em moeda estrangeira são convertidos para reais pela PTAX de compra da data de pagamento ou, sem ela, do último dia do mês de referência.\nSe o ano não for informado, considera o ano anterior, que é o ano-calendário da declaração em curso.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv"
                 ],
                 "tags": [
-                    "Endereco"
+                    "Relatórios"
                 ],
-                "summary": "Retorna todos os endereços",
+                "summary": "Gera o informe de rendimentos do ano",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Campo aberto para pesquisa",
-                        "name": "search",
+                        "description": "Ano-calendário",
+                        "name": "ano",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
                         "type": "string",
-                        "description": "Logradouro do endereço",
-                        "name": "logradouro",
+                        "description": "Formato do informe. Aceita apenas os valores 'json' e 'csv'",
+                        "name": "formato",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/rubricas": {
+            "get": {
+                "description": "Retorna as rubricas do catálogo que atendam aos critérios informados, ordenadas por tipo e nome",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rubrica"
+                ],
+                "summary": "Retorna as rubricas",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Número do endereço",
-                        "name": "numero",
+                        "description": "Campo aberto para pesquisa no código, no nome e nos termos",
+                        "name": "search",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "credito",
+                            "debito"
+                        ],
                         "type": "string",
-                        "description": "Complemento do endereço, caso exista",
-                        "name": "complemento",
+                        "description": "Tipo da rubrica",
+                        "name": "tipo",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Nome do bairro",
-                        "name": "bairro",
+                        "description": "Categoria da rubrica",
+                        "name": "categoria",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cadastra uma rubrica no catálogo de verbas do holerite, com as incidências de INSS, IRRF e FGTS.\nEm rubricas de débito, as incidências indicam que o valor é deduzido da base, como em faltas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rubrica"
+                ],
+                "summary": "Cadastra uma nova rubrica",
+                "parameters": [
+                    {
+                        "description": "Código único da rubrica",
+                        "name": "codigo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     {
-                        "type": "string",
-                        "description": "Nome da cidade",
-                        "name": "cidade",
-                        "in": "query"
+                        "description": "Nome da rubrica",
+                        "name": "nome",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Tipo das linhas classificadas na rubrica",
+                        "name": "tipo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "credito",
+                                "debito"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Categoria da rubrica",
+                        "name": "categoria",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "salario",
+                                "hora_extra",
+                                "adicional",
+                                "comissao",
+                                "ferias",
+                                "decimo_terceiro",
+                                "participacao_lucros",
+                                "beneficio",
+                                "reembolso",
+                                "adiantamento",
+                                "falta",
+                                "inss",
+                                "inss_decimo_terceiro",
+                                "irrf",
+                                "irrf_decimo_terceiro",
+                                "irrf_participacao_lucros",
+                                "vale_transporte",
+                                "vale_refeicao",
+                                "plano_saude",
+                                "pensao_alimenticia",
+                                "outros"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "Compõe a base de cálculo do INSS",
+                        "name": "incide_inss",
+                        "in": "body",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    },
+                    {
+                        "description": "Compõe a base de cálculo do IRRF",
+                        "name": "incide_irrf",
+                        "in": "body",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    },
+                    {
+                        "description": "Compõe a base de cálculo do FGTS",
+                        "name": "incide_fgts",
+                        "in": "body",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    },
+                    {
+                        "description": "Palavras-chave para a classificação pela descrição, separadas por ponto e vírgula",
+                        "name": "termos",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/rubricas/padrao": {
+            "post": {
+                "description": "Cadastra as rubricas usuais (salário base, horas extras, férias, 13º salário, INSS, IRRF, vale-transporte, vale-refeição, plano de saúde, adiantamento, entre outras) com as incidências e os termos de classificação.\nRubricas com código já cadastrado não são alteradas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rubrica"
+                ],
+                "summary": "Importa o catálogo padrão de rubricas",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "CEP",
-                        "name": "cep",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/rubricas/{id}": {
+            "get": {
+                "description": "Retorna as informações de uma rubrica de acordo com seu ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rubrica"
+                ],
+                "summary": "Consulta uma rubrica por ID",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Estado",
-                        "name": "estado",
-                        "in": "query"
+                        "description": "O ID da rubrica para retornar",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1002,8 +7189,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Cadastra um novo endereço de acordo com as informações fornecidas",
+            "put": {
+                "description": "Atualiza um registro de rubrica de acordo com o ID e as informações informadas. As linhas já classificadas mantêm a rubrica.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1011,68 +7198,81 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Endereco"
+                    "Rubrica"
                 ],
-                "summary": "Cadastra um novo endereço",
+                "summary": "Atualiza uma rubrica",
                 "parameters": [
                     {
-                        "description": "Logradouro do endereço",
-                        "name": "logradouro",
+                        "type": "string",
+                        "description": "O ID da rubrica a ser atualizada",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Código único da rubrica",
+                        "name": "codigo",
                         "in": "body",
-                        "required": true,
                         "schema": {
                             "type": "string"
                         }
                     },
                     {
-                        "description": "Número do endereço",
-                        "name": "numero",
+                        "description": "Nome da rubrica",
+                        "name": "nome",
                         "in": "body",
-                        "required": true,
                         "schema": {
                             "type": "string"
                         }
                     },
                     {
-                        "description": "Complemento do endereço, caso exista",
-                        "name": "complemento",
+                        "description": "Tipo das linhas classificadas na rubrica",
+                        "name": "tipo",
                         "in": "body",
                         "schema": {
-                            "type": "string"
+                            "type": "string",
+                            "enum": [
+                                "credito",
+                                "debito"
+                            ]
                         }
                     },
                     {
-                        "description": "Nome do bairro",
-                        "name": "bairro",
+                        "description": "Categoria da rubrica",
+                        "name": "categoria",
                         "in": "body",
-                        "required": true,
                         "schema": {
                             "type": "string"
                         }
                     },
                     {
-                        "description": "Nome da cidade",
-                        "name": "cidade",
+                        "description": "Compõe a base de cálculo do INSS",
+                        "name": "incide_inss",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "type": "string"
+                            "type": "boolean"
                         }
                     },
                     {
-                        "description": "CEP",
-                        "name": "cep",
+                        "description": "Compõe a base de cálculo do IRRF",
+                        "name": "incide_irrf",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "type": "string"
+                            "type": "boolean"
                         }
                     },
                     {
-                        "description": "Estado",
-                        "name": "estado",
+                        "description": "Compõe a base de cálculo do FGTS",
+                        "name": "incide_fgts",
+                        "in": "body",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    },
+                    {
+                        "description": "Palavras-chave para a classificação pela descrição, separadas por ponto e vírgula",
+                        "name": "termos",
                         "in": "body",
-                        "required": true,
                         "schema": {
                             "type": "string"
                         }
@@ -1098,11 +7298,53 @@ const docTemplate = `{
                         }
                     }
                 }
+            },
+            "delete": {
+                "description": "Realiza um soft-delete de uma rubrica com base no ID informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rubrica"
+                ],
+                "summary": "Apaga uma rubrica",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "O ID da rubrica a ser apagada",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    }
+                }
             }
         },
-        "/endereco-empresa/assign": {
+        "/simulacoes/comparar-ofertas": {
             "post": {
-                "description": "Faz a associação de um endereço com uma empresa através dos IDs de ambas as entidades.",
+                "description": "Calcula a renda líquida anual de cada oferta com as tabelas de tributos vigentes. No regime CLT, considera o 13º salário, as férias com o terço constitucional, o FGTS depositado, o INSS e o IRRF.\nNo regime PJ, o salário é o faturamento mensal, tributado pelo Simples Nacional no anexo definido pelo Fator R, com o INSS e o IRRF sobre o pró-labore e os custos da empresa. Os benefícios mensais entram nas duas modalidades.\nCom o emprego atual informado, cada oferta traz a diferença em relação ao líquido anual da compensação vigente dele. Salários em moeda estrangeira são convertidos pela PTAX do dia; sem cotação importada, a comparação é recusada.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1110,27 +7352,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "EnderecoEmpresa"
+                    "Simulação"
                 ],
-                "summary": "Associa um endereço a uma empresa.",
+                "summary": "Compara ofertas de trabalho CLT e PJ",
                 "parameters": [
                     {
-                        "description": "ID da empresa",
-                        "name": "id_empresa",
+                        "description": "ID do emprego atual",
+                        "name": "id_emprego",
                         "in": "body",
-                        "required": true,
                         "schema": {
                             "type": "integer"
                         }
                     },
                     {
-                        "description": "ID do endereço",
-                        "name": "id_endereco",
+                        "description": "Quantidade de dependentes para o IRRF",
+                        "name": "dependentes",
                         "in": "body",
-                        "required": true,
                         "schema": {
                             "type": "integer"
                         }
+                    },
+                    {
+                        "description": "Ofertas a comparar",
+                        "name": "ofertas",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Oferta"
+                            }
+                        }
                     }
                 ],
                 "responses": {
@@ -1155,9 +7407,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/endereco-empresa/empresas-por-endereco/{id}": {
+        "/sindicatos": {
             "get": {
-                "description": "Consulta todas as empresas que estão associadas com um endereço, pelo ID do endereço.",
+                "description": "Retorna os sindicatos que atendam aos critérios informados, ordenados por nome",
                 "consumes": [
                     "application/json"
                 ],
@@ -1165,16 +7417,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "EnderecoEmpresa"
+                    "Sindicato"
                 ],
-                "summary": "Retorna as empresas associadas à um endereço.",
+                "summary": "Retorna os sindicatos",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID do endereço",
-                        "name": "id_endereco",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "Campo aberto para pesquisa no nome e no CNPJ",
+                        "name": "search",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1197,11 +7448,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/endereco-empresa/enderecos-por-empresa/{id}": {
-            "get": {
-                "description": "Consulta todos os endereços que estão associadas com uma empresa, pelo ID da empresa.",
+            },
+            "post": {
+                "description": "Cadastra o sindicato de uma categoria com o mês da data-base, em que os salários são reajustados pela convenção coletiva",
                 "consumes": [
                     "application/json"
                 ],
@@ -1209,16 +7458,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "EnderecoEmpresa"
+                    "Sindicato"
                 ],
-                "summary": "Retorna os endereços associados à uma empresa",
+                "summary": "Cadastra um novo sindicato",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID da empresa",
-                        "name": "id_empresa",
-                        "in": "path",
-                        "required": true
+                        "description": "Nome do sindicato",
+                        "name": "nome",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "CNPJ do sindicato",
+                        "name": "cnpj",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Mês da data-base, de 1 a 12",
+                        "name": "mes_data_base",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
                     }
                 ],
                 "responses": {
@@ -1243,9 +7511,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/endereco/{id}": {
+        "/sindicatos/{id}": {
             "get": {
-                "description": "Retorna as informações de um endereço de acordo com seu ID",
+                "description": "Retorna as informações de um sindicato de acordo com seu ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1253,13 +7521,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Endereco"
+                    "Sindicato"
                 ],
-                "summary": "Consulta um endereço por ID",
+                "summary": "Consulta um sindicato por ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "O ID do endereço para retornar",
+                        "description": "O ID do sindicato para retornar",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1287,7 +7555,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Atualiza um registro de endereço de acordo com o ID e as informações informadas",
+                "description": "Atualiza um registro de sindicato de acordo com o ID e as informações informadas",
                 "consumes": [
                     "application/json"
                 ],
@@ -1295,71 +7563,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Endereco"
+                    "Sindicato"
                 ],
-                "summary": "Atualiza um endereço",
+                "summary": "Atualiza um sindicato",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "O ID do endereço a ser atualizado",
+                        "description": "O ID do sindicato a ser atualizado",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Logradouro do endereço",
-                        "name": "logradouro",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "Número do endereço",
-                        "name": "numero",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "Complemento do endereço, caso exista",
-                        "name": "complemento",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "Nome do bairro",
-                        "name": "bairro",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "Nome da cidade",
-                        "name": "cidade",
+                        "description": "Nome do sindicato",
+                        "name": "nome",
                         "in": "body",
                         "schema": {
                             "type": "string"
                         }
                     },
                     {
-                        "description": "CEP",
-                        "name": "cep",
+                        "description": "CNPJ do sindicato",
+                        "name": "cnpj",
                         "in": "body",
                         "schema": {
                             "type": "string"
                         }
                     },
                     {
-                        "description": "Estado",
-                        "name": "estado",
+                        "description": "Mês da data-base, de 1 a 12",
+                        "name": "mes_data_base",
                         "in": "body",
                         "schema": {
-                            "type": "string"
+                            "type": "integer"
                         }
                     }
                 ],
@@ -1385,7 +7621,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Realiza um soft-delete de um endereço com base no ID informado",
+                "description": "Realiza um soft-delete de um sindicato com base no ID informado",
                 "consumes": [
                     "application/json"
                 ],
@@ -1393,13 +7629,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Endereco"
+                    "Sindicato"
                 ],
-                "summary": "Apaga um endereço",
+                "summary": "Apaga um sindicato",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "O ID do endereço a ser apagado",
+                        "description": "O ID do sindicato a ser apagado",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1429,6 +7665,32 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "models.Oferta": {
+            "type": "object",
+            "properties": {
+                "anexo": {
+                    "type": "string"
+                },
+                "beneficios": {
+                    "type": "number"
+                },
+                "custos_pj": {
+                    "type": "number"
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "pro_labore": {
+                    "type": "number"
+                },
+                "salario": {
+                    "type": "number"
+                },
+                "tipo_contrato": {
+                    "type": "string"
+                }
+            }
+        },
         "models.Response": {
             "type": "object",
             "properties": {
@@ -0,0 +1,378 @@
+package ausencia
+
+import (
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/ausencia"
+)
+
+type AusenciaHandler interface {
+	Create(c *fiber.Ctx) error
+	FindByEmprego(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	CreateAnexo(c *fiber.Ctx) error
+	FindAnexo(c *fiber.Ctx) error
+}
+
+type ausenciaHandler struct {
+	Service ausencia.Service
+}
+
+var (
+	ERROR_CREATE       = "Falha ao registrar a ausência informada."
+	ERROR_FIND_ALL     = "Falha ao consultar ausências."
+	ERROR_FIND_BY_ID   = "Falha ao consultar a ausência informada."
+	ERROR_DELETE       = "Falha ao apagar a ausência informada."
+	ERROR_CREATE_ANEXO = "Falha ao anexar o arquivo à ausência."
+	ERROR_FIND_ANEXO   = "Falha ao consultar o anexo informado."
+	ERROR_ID_EMPREGO   = "ID do emprego inválido ou não informado."
+	ERROR_ID_AUSENCIA  = "ID da ausência inválido ou não informado."
+	ERROR_ARQUIVO      = "Arquivo não informado."
+	ERROR_ANEXO_VAZIO  = "Anexo não encontrado."
+
+	CREATE_SUCCESS       = "Ausência registrada com sucesso."
+	FIND_ALL_SUCCESS     = "Consulta realizada com sucesso."
+	FIND_BY_ID_SUCCESS   = "Consulta realizada com sucesso."
+	DELETE_SUCCESS       = "Ausência apagada com sucesso."
+	CREATE_ANEXO_SUCCESS = "Arquivo anexado com sucesso."
+
+	FIND_ALL_RESULT_EMPTY   = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_BY_ID_RESULT_EMPTY = "Nenhuma ausência encontrada com o ID informado."
+)
+
+func NewHandler(service ausencia.Service) AusenciaHandler {
+	return &ausenciaHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Registra uma ausência no emprego
+// @Description Registra uma ausência entre as datas de início e fim, inclusive. Ausências justificadas abonam a jornada prevista e não afetam o saldo de horas.
+// @Description Faltas injustificadas mantêm a jornada prevista, gerando débito, e fazem perder o descanso semanal remunerado do domingo seguinte.
+//
+// @Tags    Ausência
+// @Accept  json
+// @Produce json
+//
+// @Param id          path string true  "ID do emprego"
+// @Param tipo        body string true  "Tipo da ausência" Enums(falta_injustificada, atestado_medico, licenca_maternidade, licenca_paternidade, luto, casamento, doacao_sangue, ferias)
+// @Param data_inicio body string true  "Primeiro dia da ausência"
+// @Param data_fim    body string true  "Último dia da ausência"
+// @Param observacao  body string false "Observações sobre a ausência"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ausencias [post]
+func (h *ausenciaHandler) Create(c *fiber.Ctx) error {
+	idEmprego, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	ausencia := models.Ausencia{}
+
+	c.BodyParser(&ausencia)
+
+	ausencia.IDEmprego = idEmprego
+
+	if err := ausencia.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	ausencia.Criado = time.Now()
+
+	ausencia, err = h.Service.Create(c.UserContext(), ausencia)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    ausencia,
+	})
+}
+
+// FindByEmprego godoc
+// @Summary     Retorna as ausências de um emprego
+// @Description Retorna as ausências registradas no emprego, ordenadas pela data de início, com os dados dos anexos sem o conteúdo
+//
+// @Tags    Ausência
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "ID do emprego"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ausencias [get]
+func (h *ausenciaHandler) FindByEmprego(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	result, err := h.Service.FindByEmprego(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Retorna uma ausência
+// @Description Retorna uma ausência do emprego com base no ID informado
+//
+// @Tags    Ausência
+// @Accept  json
+// @Produce json
+//
+// @Param id          path string true "ID do emprego"
+// @Param id_ausencia path string true "ID da ausência"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ausencias/{id_ausencia} [get]
+func (h *ausenciaHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idAusencia := c.Params("id_ausencia", "")
+	if id == "" || idAusencia == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id, idAusencia)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_ID_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_ID_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga uma ausência
+// @Description Realiza um soft-delete de uma ausência do emprego com base no ID informado
+//
+// @Tags    Ausência
+// @Accept  json
+// @Produce json
+//
+// @Param id          path string true "ID do emprego"
+// @Param id_ausencia path string true "O ID da ausência a ser apagada"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ausencias/{id_ausencia} [delete]
+func (h *ausenciaHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idAusencia := c.Params("id_ausencia", "")
+	if id == "" || idAusencia == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id, idAusencia)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
+
+// CreateAnexo godoc
+// @Summary     Anexa um arquivo a uma ausência
+// @Description Anexa um documento à ausência, como um atestado médico ou uma certidão
+//
+// @Tags    Ausência
+// @Accept  multipart/form-data
+// @Produce json
+//
+// @Param id          path     string true "ID do emprego"
+// @Param id_ausencia path     string true "ID da ausência"
+// @Param arquivo     formData file   true "Arquivo a ser anexado"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ausencias/{id_ausencia}/anexos [post]
+func (h *ausenciaHandler) CreateAnexo(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE_ANEXO,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	idAusencia, err := strconv.ParseInt(c.Params("id_ausencia", ""), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE_ANEXO,
+			Errors:  []string{ERROR_ID_AUSENCIA},
+		})
+	}
+
+	cabecalho, err := c.FormFile("arquivo")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE_ANEXO,
+			Errors:  []string{ERROR_ARQUIVO},
+		})
+	}
+
+	arquivo, err := cabecalho.Open()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE_ANEXO,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	defer arquivo.Close()
+
+	conteudo, err := io.ReadAll(arquivo)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE_ANEXO,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	tipoConteudo := cabecalho.Header.Get(fiber.HeaderContentType)
+	if tipoConteudo == "" {
+		tipoConteudo = fiber.MIMEOctetStream
+	}
+
+	anexo, err := h.Service.CreateAnexo(c.UserContext(), id, models.AusenciaAnexo{
+		IDAusencia:   idAusencia,
+		Nome:         cabecalho.Filename,
+		TipoConteudo: tipoConteudo,
+		Conteudo:     conteudo,
+		Criado:       time.Now(),
+	})
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE_ANEXO,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_ANEXO_SUCCESS,
+		Data:    anexo,
+	})
+}
+
+// FindAnexo godoc
+// @Summary     Baixa o anexo de uma ausência
+// @Description Retorna o conteúdo do arquivo anexado à ausência, com o tipo de conteúdo e o nome informados no envio
+//
+// @Tags    Ausência
+// @Accept  json
+// @Produce octet-stream
+//
+// @Param id          path string true "ID do emprego"
+// @Param id_ausencia path string true "ID da ausência"
+// @Param id_anexo    path string true "ID do anexo"
+//
+// @Success 200 {file}   file
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ausencias/{id_ausencia}/anexos/{id_anexo} [get]
+func (h *ausenciaHandler) FindAnexo(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idAusencia := c.Params("id_ausencia", "")
+	idAnexo := c.Params("id_anexo", "")
+	if id == "" || idAusencia == "" || idAnexo == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ANEXO,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	anexo, err := h.Service.FindAnexo(c.UserContext(), id, idAusencia, idAnexo)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ANEXO,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if anexo.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: ERROR_ANEXO_VAZIO,
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, anexo.TipoConteudo)
+	c.Attachment(anexo.Nome)
+
+	return c.Status(fiber.StatusOK).Send(anexo.Conteudo)
+}
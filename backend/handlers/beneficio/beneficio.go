@@ -0,0 +1,316 @@
+package beneficio
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/beneficio"
+)
+
+type BeneficioHandler interface {
+	Create(c *fiber.Ctx) error
+	FindByEmprego(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+}
+
+type beneficioHandler struct {
+	Service beneficio.Service
+}
+
+var (
+	ERROR_CREATE     = "Falha ao registrar o benefício informado."
+	ERROR_FIND_ALL   = "Falha ao consultar os benefícios do emprego."
+	ERROR_FIND_BY_ID = "Falha ao consultar o benefício informado."
+	ERROR_UPDATE     = "Falha ao atualizar o benefício."
+	ERROR_DELETE     = "Falha ao apagar o benefício informado."
+	ERROR_ID_EMPREGO = "ID do emprego inválido ou não informado."
+
+	CREATE_SUCCESS     = "Benefício registrado com sucesso."
+	FIND_ALL_SUCCESS   = "Consulta realizada com sucesso."
+	FIND_BY_ID_SUCCESS = "Consulta realizada com sucesso."
+	UPDATE_SUCCESS     = "Benefício atualizado com sucesso."
+	DELETE_SUCCESS     = "Benefício apagado com sucesso."
+
+	FIND_ALL_RESULT_EMPTY   = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_BY_ID_RESULT_EMPTY = "Nenhum benefício encontrado com o ID informado."
+)
+
+func NewHandler(service beneficio.Service) BeneficioHandler {
+	return &beneficioHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Registra um benefício do emprego
+// @Description Registra um benefício concedido no emprego, com o valor pago pela empresa em cada período, a coparticipação descontada do empregado e a vigência.
+// @Description Benefícios diários são pagos por dia útil. O alvo da PLR pode ser informado em quantidade de salários, acompanhando a remuneração vigente.
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id               path string true  "ID do emprego"
+// @Param tipo             body string true  "Tipo do benefício" Enums(vale_refeicao, vale_alimentacao, vale_transporte, plano_saude, plano_odontologico, auxilio_home_office, academia, plr, outro)
+// @Param descricao        body string false "Descrição do benefício"
+// @Param valor            body number false "Valor concedido em cada período, obrigatório sem o múltiplo do salário"
+// @Param multiplo_salario body number false "Valor em quantidade de salários, como no alvo da PLR"
+// @Param coparticipacao   body number false "Valor descontado do empregado em cada período"
+// @Param periodicidade    body string true  "Periodicidade do benefício" Enums(diaria, mensal, trimestral, semestral, anual)
+// @Param data_inicio      body string true  "Início da vigência"
+// @Param data_fim         body string false "Fim da vigência"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/beneficios [post]
+func (h *beneficioHandler) Create(c *fiber.Ctx) error {
+	idEmprego, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	beneficio := models.Beneficio{}
+
+	c.BodyParser(&beneficio)
+
+	beneficio.IDEmprego = idEmprego
+
+	if err := beneficio.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	beneficio.Criado = time.Now()
+
+	beneficio, err = h.Service.Create(c.UserContext(), beneficio)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    beneficio,
+	})
+}
+
+// FindByEmprego godoc
+// @Summary     Retorna os benefícios de um emprego
+// @Description Retorna os benefícios do emprego ordenados pelo início da vigência, inclusive os que já não estão vigentes
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "ID do emprego"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/beneficios [get]
+func (h *beneficioHandler) FindByEmprego(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	result, err := h.Service.FindByEmprego(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Retorna um benefício
+// @Description Retorna um benefício do emprego com base no ID informado
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id           path string true "ID do emprego"
+// @Param id_beneficio path string true "ID do benefício"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/beneficios/{id_beneficio} [get]
+func (h *beneficioHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idBeneficio := c.Params("id_beneficio", "")
+	if id == "" || idBeneficio == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id, idBeneficio)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_ID_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_ID_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Update godoc
+// @Summary     Atualiza um benefício
+// @Description Atualiza um benefício do emprego de acordo com o ID e as informações informadas. Para encerrar um benefício, informe o fim da vigência.
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id               path string true  "ID do emprego"
+// @Param id_beneficio     path string true  "ID do benefício"
+// @Param tipo             body string false "Tipo do benefício" Enums(vale_refeicao, vale_alimentacao, vale_transporte, plano_saude, plano_odontologico, auxilio_home_office, academia, plr, outro)
+// @Param descricao        body string false "Descrição do benefício"
+// @Param valor            body number false "Valor concedido em cada período"
+// @Param multiplo_salario body number false "Valor em quantidade de salários"
+// @Param coparticipacao   body number false "Valor descontado do empregado em cada período"
+// @Param periodicidade    body string false "Periodicidade do benefício" Enums(diaria, mensal, trimestral, semestral, anual)
+// @Param data_inicio      body string false "Início da vigência"
+// @Param data_fim         body string false "Fim da vigência"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/beneficios/{id_beneficio} [put]
+func (h *beneficioHandler) Update(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idBeneficio := c.Params("id_beneficio", "")
+	if id == "" || idBeneficio == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	beneficio, err := h.Service.FindByID(c.UserContext(), id, idBeneficio)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if beneficio.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_ID_RESULT_EMPTY,
+		})
+	}
+
+	c.BodyParser(&beneficio)
+
+	if err := beneficio.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	now := time.Now()
+	beneficio.Atualizado = &now
+
+	err = h.Service.Update(c.UserContext(), beneficio)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: UPDATE_SUCCESS,
+		Data:    beneficio,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga um benefício
+// @Description Realiza um soft-delete de um benefício do emprego
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id           path string true "ID do emprego"
+// @Param id_beneficio path string true "O ID do benefício a ser apagado"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/beneficios/{id_beneficio} [delete]
+func (h *beneficioHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idBeneficio := c.Params("id_beneficio", "")
+	if id == "" || idBeneficio == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id, idBeneficio)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
@@ -0,0 +1,68 @@
+package busca
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/busca"
+)
+
+type BuscaHandler interface {
+	Buscar(c *fiber.Ctx) error
+}
+
+type buscaHandler struct {
+	Service busca.Service
+}
+
+var (
+	ERROR_BUSCAR = "Falha ao realizar a busca."
+
+	BUSCAR_SUCCESS = "Busca realizada com sucesso."
+
+	BUSCAR_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
+)
+
+func NewHandler(service busca.Service) BuscaHandler {
+	return &buscaHandler{
+		Service: service,
+	}
+}
+
+// Buscar godoc
+// @Summary     Busca global
+// @Description Procura o termo nas empresas, endereços, contatos e empregos, sem diferenciar maiúsculas e acentos e tolerando erros de digitação. Os resultados vêm agrupados pelo tipo de registro, cada um com a pontuação de relevância, de 0 a 1, e o campo que mais correspondeu à busca. CNPJ e CEP são encontrados pelos números, com ou sem formatação.
+//
+// @Tags    Busca
+// @Accept  json
+// @Produce json
+//
+// @Param q      query string true  "Termo da busca, com ao menos dois caracteres"
+// @Param limite query int    false "Quantidade máxima de resultados de cada tipo, padrão 10"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /busca [get]
+func (h *buscaHandler) Buscar(c *fiber.Ctx) error {
+	result, err := h.Service.Buscar(c.UserContext(), c.Query("q", ""), c.QueryInt("limite", 0))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_BUSCAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.Total == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: BUSCAR_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   result.Total,
+		Message: BUSCAR_SUCCESS,
+		Data:    result,
+	})
+}
@@ -0,0 +1,96 @@
+package calendario
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/calendario"
+)
+
+type CalendarioHandler interface {
+	ICS(c *fiber.Ctx) error
+}
+
+type calendarioHandler struct {
+	Service calendario.Service
+}
+
+var (
+	ERROR_ICS = "Falha ao gerar o calendário."
+
+	CALENDARIO_NOME = "Tsukuyomi"
+)
+
+func NewHandler(service calendario.Service) CalendarioHandler {
+	return &calendarioHandler{
+		Service: service,
+	}
+}
+
+// ICS godoc
+// @Summary     Exporta o calendário no formato iCalendar
+// @Description Gera um calendário (.ics) com as entrevistas agendadas, os feriados da localidade e as férias dos empregos, para assinatura em aplicativos de calendário.
+// @Description Sem datas informadas, considera de um ano antes a um ano depois da data atual.
+//
+// @Tags    Calendário
+// @Produce text/calendar
+//
+// @Param inicio query string false "Data inicial no formato AAAA-MM-DD"
+// @Param fim    query string false "Data final no formato AAAA-MM-DD"
+// @Param uf     query string false "Estado para considerar os feriados estaduais"
+// @Param cidade query string false "Cidade para considerar os feriados municipais. Requer o estado"
+//
+// @Success 200 {string} string
+// @Failure 500 {object} models.Response
+//
+// @Router /calendario.ics [get]
+func (h *calendarioHandler) ICS(c *fiber.Ctx) error {
+	hoje := time.Now()
+	hoje = time.Date(hoje.Year(), hoje.Month(), hoje.Day(), 0, 0, 0, 0, time.Local)
+
+	inicio := hoje.AddDate(-1, 0, 0)
+	fim := hoje.AddDate(1, 0, 0)
+
+	if valor := c.Query("inicio", ""); valor != "" {
+		var err error
+
+		if inicio, err = time.ParseInLocation(time.DateOnly, valor, time.Local); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+				Message: ERROR_ICS,
+				Errors:  []string{"Data inicial inválida."},
+			})
+		}
+	}
+
+	if valor := c.Query("fim", ""); valor != "" {
+		var err error
+
+		if fim, err = time.ParseInLocation(time.DateOnly, valor, time.Local); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+				Message: ERROR_ICS,
+				Errors:  []string{"Data final inválida."},
+			})
+		}
+	}
+
+	if fim.Before(inicio) {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_ICS,
+			Errors:  []string{"A data final deve ser posterior à data inicial."},
+		})
+	}
+
+	eventos, err := h.Service.Eventos(c.UserContext(), inicio, fim, c.Query("uf", ""), c.Query("cidade", ""))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_ICS,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, "text/calendar; charset=utf-8")
+
+	return c.Status(fiber.StatusOK).Send(calendario.ICS(CALENDARIO_NOME, eventos, time.Now()))
+}
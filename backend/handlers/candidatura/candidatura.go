@@ -0,0 +1,409 @@
+package candidatura
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/candidatura"
+)
+
+type CandidaturaHandler interface {
+	Create(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	Transitar(c *fiber.Ctx) error
+	Converter(c *fiber.Ctx) error
+}
+
+type candidaturaHandler struct {
+	Service candidatura.Service
+}
+
+var (
+	ERROR_CREATE    = "Falha ao registrar a candidatura informada."
+	ERROR_FIND_ALL  = "Falha ao consultar candidaturas."
+	ERROR_FIND_BY   = "Falha ao consultar candidatura por ID."
+	ERROR_UPDATE    = "Falha ao atualizar candidatura."
+	ERROR_DELETE    = "Falha ao apagar a candidatura informada."
+	ERROR_TRANSITAR = "Falha ao alterar a situação da candidatura."
+	ERROR_CONVERTER = "Falha ao converter a candidatura em emprego."
+
+	CREATE_SUCCESS    = "Candidatura registrada com sucesso."
+	FIND_ALL_SUCCESS  = "Consulta realizada com sucesso."
+	FIND_BY_SUCCESS   = "Consulta realizada com sucesso."
+	UPDATE_SUCCESS    = "Candidatura atualizada com sucesso."
+	DELETE_SUCCESS    = "Candidatura apagada com sucesso."
+	TRANSITAR_SUCCESS = "Situação da candidatura alterada com sucesso."
+	CONVERTER_SUCCESS = "Emprego criado a partir da candidatura com sucesso."
+
+	FIND_BY_RESULT_EMPTY  = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
+)
+
+func NewHandler(service candidatura.Service) CandidaturaHandler {
+	return &candidaturaHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Registra uma candidatura
+// @Description Registra uma candidatura a uma vaga na empresa, na situação aplicado. A situação muda apenas pelas transições.
+//
+// @Tags    Candidatura
+// @Accept  json
+// @Produce json
+//
+// @Param id_empresa         body integer true  "ID da empresa"
+// @Param cargo              body string  true  "Cargo da vaga"
+// @Param origem             body string  false "Origem da vaga, como indicação ou site de vagas"
+// @Param pretensao_salarial body number  false "Pretensão salarial"
+// @Param salario_oferecido  body number  false "Salário oferecido"
+// @Param moeda              body string  false "Moeda dos salários (ISO 4217), BRL por padrão"
+// @Param tipo_contrato      body string  false "Tipo de contratação"
+// @Param observacao         body string  false "Observação"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /candidaturas [post]
+func (h *candidaturaHandler) Create(c *fiber.Ctx) error {
+	candidatura := models.Candidatura{}
+
+	c.BodyParser(&candidatura)
+
+	if err := candidatura.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	candidatura.Criado = time.Now()
+
+	candidatura, err := h.Service.Create(c.UserContext(), candidatura)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    candidatura,
+	})
+}
+
+// FindAll godoc
+// @Summary     Retorna as candidaturas
+// @Description Retorna as candidaturas que atendam aos critérios informados, das mais recentes para as mais antigas
+//
+// @Tags    Candidatura
+// @Accept  json
+// @Produce json
+//
+// @Param search   query string false "Campo aberto para pesquisa no cargo, na origem e no nome da empresa"
+// @Param empresa  query string false "ID ou nome da empresa"
+// @Param situacao query string false "Situação da candidatura" Enums(aplicado, triagem, entrevista, proposta, aceito, recusado)
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /candidaturas [get]
+func (h *candidaturaHandler) FindAll(c *fiber.Ctx) error {
+	search := c.Query("search", "")
+	empresa := c.Query("empresa", "")
+	situacao := c.Query("situacao", "")
+
+	result, err := h.Service.FindAll(c.UserContext(), search, empresa, situacao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Consulta uma candidatura por ID
+// @Description Retorna as informações de uma candidatura com o histórico de transições de situação
+//
+// @Tags    Candidatura
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "O ID da candidatura para retornar"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /candidaturas/{id} [get]
+func (h *candidaturaHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Update godoc
+// @Summary     Atualiza uma candidatura
+// @Description Atualiza os dados de uma candidatura. A situação não é alterada; use a transição de situação.
+//
+// @Tags    Candidatura
+// @Accept  json
+// @Produce json
+//
+// @Param id                 path string  true  "O ID da candidatura a ser atualizada"
+// @Param id_empresa         body integer false "ID da empresa"
+// @Param cargo              body string  false "Cargo da vaga"
+// @Param origem             body string  false "Origem da vaga"
+// @Param pretensao_salarial body number  false "Pretensão salarial"
+// @Param salario_oferecido  body number  false "Salário oferecido"
+// @Param moeda              body string  false "Moeda dos salários (ISO 4217)"
+// @Param tipo_contrato      body string  false "Tipo de contratação"
+// @Param observacao         body string  false "Observação"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /candidaturas/{id} [put]
+func (h *candidaturaHandler) Update(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	candidatura, err := h.Service.FindByID(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if candidatura.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_RESULT_EMPTY,
+		})
+	}
+
+	situacao := candidatura.Situacao
+
+	c.BodyParser(&candidatura)
+
+	candidatura.Situacao = situacao
+
+	if err := candidatura.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	now := time.Now()
+	candidatura.Atualizado = &now
+
+	err = h.Service.Update(c.UserContext(), candidatura)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: UPDATE_SUCCESS,
+		Data:    candidatura,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga uma candidatura
+// @Description Realiza um soft-delete de uma candidatura
+//
+// @Tags    Candidatura
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "O ID da candidatura a ser apagada"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /candidaturas/{id} [delete]
+func (h *candidaturaHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
+
+// Transitar godoc
+// @Summary     Altera a situação de uma candidatura
+// @Description Move a candidatura para a situação informada e registra a transição no histórico. O processo só avança (aplicado, triagem, entrevista, proposta), podendo pular etapas.
+// @Description A candidatura pode ser recusada em qualquer etapa, mas só é aceita após a proposta. Aceito e recusado são situações finais.
+//
+// @Tags    Candidatura
+// @Accept  json
+// @Produce json
+//
+// @Param id         path string true  "ID da candidatura"
+// @Param situacao   body string true  "Nova situação" Enums(triagem, entrevista, proposta, aceito, recusado)
+// @Param data       body string false "Data da transição, por padrão agora"
+// @Param observacao body string false "Observação sobre a transição"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /candidaturas/{id}/situacao [post]
+func (h *candidaturaHandler) Transitar(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_TRANSITAR,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	transicao := models.TransicaoCandidatura{}
+
+	c.BodyParser(&transicao)
+
+	if err := transicao.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_TRANSITAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if transicao.Data.IsZero() {
+		transicao.Data = time.Now()
+	}
+
+	result, err := h.Service.Transitar(c.UserContext(), id, transicao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_TRANSITAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: TRANSITAR_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Converter godoc
+// @Summary     Converte uma candidatura aceita em emprego
+// @Description Cria o emprego na empresa e no cargo da candidatura aceita e o vincula a ela. A remuneração inicial e o tipo de contratação, quando não informados, são os da candidatura.
+//
+// @Tags    Candidatura
+// @Accept  json
+// @Produce json
+//
+// @Param id                  path string  true  "ID da candidatura"
+// @Param data_inicio         body string  true  "Data de início do emprego"
+// @Param tipo_contrato       body string  false "Tipo de contratação, por padrão o da candidatura"
+// @Param carga_horaria       body integer false "Carga horária"
+// @Param remuneracao_inicial body number  false "Remuneração inicial, por padrão o salário oferecido"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /candidaturas/{id}/converter [post]
+func (h *candidaturaHandler) Converter(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CONVERTER,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	conversao := models.ConversaoCandidatura{}
+
+	c.BodyParser(&conversao)
+
+	result, err := h.Service.Converter(c.UserContext(), id, conversao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CONVERTER,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CONVERTER_SUCCESS,
+		Data:    result,
+	})
+}
@@ -0,0 +1,59 @@
+package cep
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/cep"
+)
+
+type CEPHandler interface {
+	FindByCEP(c *fiber.Ctx) error
+}
+
+type cepHandler struct {
+	Service cep.Service
+}
+
+var (
+	ERROR_FIND_BY = "Falha ao consultar o CEP informado."
+
+	FIND_BY_SUCCESS = "Consulta realizada com sucesso."
+)
+
+func NewHandler(service cep.Service) CEPHandler {
+	return &cepHandler{
+		Service: service,
+	}
+}
+
+// FindByCEP godoc
+// @Summary     Consulta um CEP
+// @Description Retorna o logradouro, o bairro, a cidade e o estado do CEP na base de CEPs importada. Nas cidades com CEP único, o logradouro e o bairro são vazios.
+//
+// @Tags    Endereco
+// @Accept  json
+// @Produce json
+//
+// @Param cep path string true "CEP, com ou sem hífen"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /endereco/cep/{cep} [get]
+func (h *cepHandler) FindByCEP(c *fiber.Ctx) error {
+	result, err := h.Service.FindByCEP(c.UserContext(), c.Params("cep", ""))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_SUCCESS,
+		Data:    result,
+	})
+}
@@ -0,0 +1,93 @@
+package cnpj
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/cnpj"
+)
+
+type CNPJHandler interface {
+	Consultar(c *fiber.Ctx) error
+	Cadastrar(c *fiber.Ctx) error
+}
+
+type cnpjHandler struct {
+	Service cnpj.Service
+}
+
+var (
+	ERROR_CONSULTAR = "Falha ao consultar o CNPJ informado."
+	ERROR_CADASTRAR = "Falha ao cadastrar a empresa do CNPJ informado."
+
+	CONSULTAR_SUCCESS = "Consulta realizada com sucesso."
+	CADASTRAR_SUCCESS = "Empresa cadastrada com sucesso."
+)
+
+func NewHandler(service cnpj.Service) CNPJHandler {
+	return &cnpjHandler{
+		Service: service,
+	}
+}
+
+// Consultar godoc
+// @Summary     Consulta um CNPJ nos dados da Receita Federal
+// @Description Retorna a razão social, o nome fantasia, a situação cadastral e o endereço do CNPJ nos dados abertos importados da Receita Federal. Quando a empresa já estiver cadastrada, ela é retornada junto.
+//
+// @Tags    Empresa
+// @Accept  json
+// @Produce json
+//
+// @Param cnpj path string true "CNPJ, com ou sem formatação"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /empresa/consulta-cnpj/{cnpj} [get]
+func (h *cnpjHandler) Consultar(c *fiber.Ctx) error {
+	result, err := h.Service.Consultar(c.UserContext(), c.Params("cnpj", ""))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CONSULTAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CONSULTAR_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Cadastrar godoc
+// @Summary     Cadastra a empresa de um CNPJ
+// @Description Cadastra a empresa, o endereço e o vínculo entre eles em uma única operação, com os dados abertos importados da Receita Federal. O nome da empresa é a razão social, acrescida do CNPJ se já houver outra empresa com ela. O endereço é normalizado e, se já estiver cadastrado, reaproveitado.
+//
+// @Tags    Empresa
+// @Accept  json
+// @Produce json
+//
+// @Param cnpj path string true "CNPJ, com ou sem formatação"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /empresa/consulta-cnpj/{cnpj} [post]
+func (h *cnpjHandler) Cadastrar(c *fiber.Ctx) error {
+	result, err := h.Service.Cadastrar(c.UserContext(), c.Params("cnpj", ""))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CADASTRAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CADASTRAR_SUCCESS,
+		Data:    result,
+	})
+}
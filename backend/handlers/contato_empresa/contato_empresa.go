@@ -46,16 +46,17 @@ func NewHandler(service contatoempresa.Service) ContatoEmpresaHandler {
 
 // Create godoc
 // @Summary     Cadastra um novo contato de empresa
-// @Description Cadastra um novo contato de empresa de acordo com as informações fornecidas. O contato deve ser único de acordo com seu tipo.
-// @Description Ao cadastrar um contato, os dados da empresa não são retornados.
+// @Description Cadastra um novo contato de empresa de acordo com as informações fornecidas. O contato deve ser único na empresa de acordo com seu tipo, mas pode se repetir em empresas diferentes.
+// @Description O contato pode pertencer a uma pessoa da empresa. Ao cadastrar um contato, os dados da empresa não são retornados.
 //
 // @Tags    ContatoEmpresa
 // @Accept  json
 // @Produce json
 //
-// @Param id_empresa body int    true "ID da empresa"
-// @Param tipo       body string true "O tipo de contato. Aceita apenas os valores 'telefone', 'whatsapp' e 'email'" Enums(telefone, whatsapp, email)
-// @Param contato    body string true "O contato em si"
+// @Param id_empresa body int    true  "ID da empresa"
+// @Param id_pessoa  body int    false "ID da pessoa da empresa a quem o contato pertence"
+// @Param tipo       body string true  "O tipo de contato. Aceita apenas os valores 'telefone', 'whatsapp', 'email', 'site' e 'linkedin'" Enums(telefone, whatsapp, email, site, linkedin)
+// @Param contato    body string true  "O contato em si"
 //
 // @Success 200 {object} models.Response
 // @Failure 400 {object} models.Response
@@ -67,6 +68,13 @@ func (h *contatoEmpresaHandler) Create(c *fiber.Ctx) error {
 
 	c.BodyParser(&contatoEmpresa)
 
+	if err := contatoEmpresa.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
 	contatoEmpresa.Criado = time.Now()
 
 	contatoEmpresa, err := h.Service.Create(c.UserContext(), contatoEmpresa)
@@ -93,8 +101,9 @@ func (h *contatoEmpresaHandler) Create(c *fiber.Ctx) error {
 // @Produce json
 //
 // @Param search  query string false "Campo aberto para pesquisa"
-// @Param empresa query string false "Nome da empresa"
-// @Param tipo    query string false "Tipo do contato. Aceita apenas os valores 'telefone', 'whatsapp' e 'email'" Enums(telefone, whatsapp, email)
+// @Param empresa query string false "ID ou nome da empresa"
+// @Param pessoa  query string false "ID ou nome da pessoa"
+// @Param tipo    query string false "Tipo do contato. Aceita apenas os valores 'telefone', 'whatsapp', 'email', 'site' e 'linkedin'" Enums(telefone, whatsapp, email, site, linkedin)
 // @Param contato query string false "O contato em si"
 //
 // @Success 200 {object} models.Response
@@ -105,10 +114,11 @@ func (h *contatoEmpresaHandler) Create(c *fiber.Ctx) error {
 func (h *contatoEmpresaHandler) FindAll(c *fiber.Ctx) error {
 	search := c.Query("search", "")
 	empresa := c.Query("empresa", "")
+	pessoa := c.Query("pessoa", "")
 	tipo := c.Query("tipo", "")
 	contato := c.Query("contato", "")
 
-	result, err := h.Service.FindAll(c.UserContext(), search, empresa, tipo, contato)
+	result, err := h.Service.FindAll(c.UserContext(), search, empresa, pessoa, tipo, contato)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
 			Message: ERROR_FIND_ALL,
@@ -184,7 +194,8 @@ func (h *contatoEmpresaHandler) FindByID(c *fiber.Ctx) error {
 //
 // @Param id         path  string true  "O ID do contato de empresa a ser atualizado"
 // @Param id_empresa query int    false "ID da empresa para atualizar"
-// @Param tipo       query string false "Tipo do contato. Aceita apenas os valores 'telefone', 'whatsapp' e 'email'" Enums(telefone, whatsapp, email)
+// @Param id_pessoa  query int    false "ID da pessoa da empresa a quem o contato pertence"
+// @Param tipo       query string false "Tipo do contato. Aceita apenas os valores 'telefone', 'whatsapp', 'email', 'site' e 'linkedin'" Enums(telefone, whatsapp, email, site, linkedin)
 // @Param contato    query string false "O contato em si"
 //
 // @Success 200 {object} models.Response
@@ -209,8 +220,21 @@ func (h *contatoEmpresaHandler) Update(c *fiber.Ctx) error {
 		})
 	}
 
+	if contato.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_RESULT_EMPTY,
+		})
+	}
+
 	c.BodyParser(&contato)
 
+	if err := contato.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
 	now := time.Now()
 	contato.Atualizado = &now
 
@@ -0,0 +1,362 @@
+package convencaocoletiva
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	convencaoColetiva "tsukuyomi/services/convencao_coletiva"
+)
+
+type ConvencaoColetivaHandler interface {
+	Create(c *fiber.Ctx) error
+	FindByEmpresa(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	AplicarReajuste(c *fiber.Ctx) error
+}
+
+type convencaoColetivaHandler struct {
+	Service convencaoColetiva.Service
+}
+
+var (
+	ERROR_CREATE           = "Falha ao registrar a convenção coletiva informada."
+	ERROR_FIND_ALL         = "Falha ao consultar as convenções coletivas da empresa."
+	ERROR_FIND_BY_ID       = "Falha ao consultar a convenção coletiva informada."
+	ERROR_UPDATE           = "Falha ao atualizar a convenção coletiva."
+	ERROR_DELETE           = "Falha ao apagar a convenção coletiva informada."
+	ERROR_APLICAR_REAJUSTE = "Falha ao aplicar o reajuste da convenção coletiva."
+	ERROR_ID_EMPRESA       = "ID da empresa inválido ou não informado."
+
+	CREATE_SUCCESS           = "Convenção coletiva registrada com sucesso."
+	FIND_ALL_SUCCESS         = "Consulta realizada com sucesso."
+	FIND_BY_ID_SUCCESS       = "Consulta realizada com sucesso."
+	UPDATE_SUCCESS           = "Convenção coletiva atualizada com sucesso."
+	DELETE_SUCCESS           = "Convenção coletiva apagada com sucesso."
+	APLICAR_REAJUSTE_SUCCESS = "Reajuste aplicado com sucesso."
+	SIMULAR_REAJUSTE_SUCCESS = "Simulação do reajuste realizada com sucesso. Nenhuma remuneração foi registrada."
+
+	FIND_ALL_RESULT_EMPTY   = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_BY_ID_RESULT_EMPTY = "Nenhuma convenção coletiva encontrada com o ID informado."
+)
+
+func NewHandler(service convencaoColetiva.Service) ConvencaoColetivaHandler {
+	return &convencaoColetivaHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Registra uma convenção coletiva da empresa
+// @Description Registra a convenção ou o acordo coletivo de um ano, com o sindicato da categoria e o percentual de reajuste na data-base do sindicato
+//
+// @Tags    Empresa
+// @Accept  json
+// @Produce json
+//
+// @Param id           path string  true  "ID da empresa"
+// @Param id_sindicato body integer true  "ID do sindicato"
+// @Param ano          body integer true  "Ano da convenção"
+// @Param percentual   body number  true  "Percentual de reajuste, como 4.5 para 4,5%"
+// @Param observacao   body string  false "Observação sobre a convenção"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /empresa/{id}/convencoes [post]
+func (h *convencaoColetivaHandler) Create(c *fiber.Ctx) error {
+	idEmpresa, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{ERROR_ID_EMPRESA},
+		})
+	}
+
+	convencao := models.ConvencaoColetiva{}
+
+	c.BodyParser(&convencao)
+
+	convencao.IDEmpresa = idEmpresa
+
+	if err := convencao.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	convencao.Criado = time.Now()
+
+	convencao, err = h.Service.Create(c.UserContext(), convencao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    convencao,
+	})
+}
+
+// FindByEmpresa godoc
+// @Summary     Retorna as convenções coletivas de uma empresa
+// @Description Retorna as convenções coletivas da empresa com o sindicato de cada uma, da mais recente para a mais antiga
+//
+// @Tags    Empresa
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "ID da empresa"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /empresa/{id}/convencoes [get]
+func (h *convencaoColetivaHandler) FindByEmpresa(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{ERROR_ID_EMPRESA},
+		})
+	}
+
+	result, err := h.Service.FindByEmpresa(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Retorna uma convenção coletiva
+// @Description Retorna uma convenção coletiva da empresa com base no ID informado
+//
+// @Tags    Empresa
+// @Accept  json
+// @Produce json
+//
+// @Param id           path string true "ID da empresa"
+// @Param id_convencao path string true "ID da convenção coletiva"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /empresa/{id}/convencoes/{id_convencao} [get]
+func (h *convencaoColetivaHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idConvencao := c.Params("id_convencao", "")
+	if id == "" || idConvencao == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id, idConvencao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_ID_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_ID_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Update godoc
+// @Summary     Atualiza uma convenção coletiva
+// @Description Atualiza uma convenção coletiva da empresa de acordo com o ID e as informações informadas. Os reajustes já aplicados não são alterados.
+//
+// @Tags    Empresa
+// @Accept  json
+// @Produce json
+//
+// @Param id           path string  true  "ID da empresa"
+// @Param id_convencao path string  true  "ID da convenção coletiva"
+// @Param id_sindicato body integer false "ID do sindicato"
+// @Param ano          body integer false "Ano da convenção"
+// @Param percentual   body number  false "Percentual de reajuste"
+// @Param observacao   body string  false "Observação sobre a convenção"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /empresa/{id}/convencoes/{id_convencao} [put]
+func (h *convencaoColetivaHandler) Update(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idConvencao := c.Params("id_convencao", "")
+	if id == "" || idConvencao == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	convencao, err := h.Service.FindByID(c.UserContext(), id, idConvencao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if convencao.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_ID_RESULT_EMPTY,
+		})
+	}
+
+	c.BodyParser(&convencao)
+
+	if err := convencao.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	now := time.Now()
+	convencao.Atualizado = &now
+
+	err = h.Service.Update(c.UserContext(), convencao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: UPDATE_SUCCESS,
+		Data:    convencao,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga uma convenção coletiva
+// @Description Realiza um soft-delete de uma convenção coletiva da empresa. As remunerações já registradas pelo reajuste são mantidas.
+//
+// @Tags    Empresa
+// @Accept  json
+// @Produce json
+//
+// @Param id           path string true "ID da empresa"
+// @Param id_convencao path string true "O ID da convenção coletiva a ser apagada"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /empresa/{id}/convencoes/{id_convencao} [delete]
+func (h *convencaoColetivaHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idConvencao := c.Params("id_convencao", "")
+	if id == "" || idConvencao == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id, idConvencao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
+
+// AplicarReajuste godoc
+// @Summary     Aplica o reajuste da convenção coletiva
+// @Description Reajusta pelo percentual da convenção o salário de cada emprego da empresa ativo na data-base, registrando uma remuneração no primeiro dia do mês da data-base.
+// @Description Empregos iniciados na data-base ou depois dela, encerrados antes dela ou já reajustados na data-base são ignorados.
+// @Description Com dry_run, retorna o salário anterior e o reajustado de cada emprego sem registrar nenhuma remuneração.
+//
+// @Tags    Empresa
+// @Accept  json
+// @Produce json
+//
+// @Param id           path  string true  "ID da empresa"
+// @Param id_convencao path  string true  "ID da convenção coletiva"
+// @Param dry_run      query bool   false "Apenas simula o reajuste"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /empresa/{id}/convencoes/{id_convencao}/reajuste [post]
+func (h *convencaoColetivaHandler) AplicarReajuste(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idConvencao := c.Params("id_convencao", "")
+	if id == "" || idConvencao == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_APLICAR_REAJUSTE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	simulacao := c.QueryBool("dry_run", false)
+
+	result, err := h.Service.AplicarReajuste(c.UserContext(), id, idConvencao, simulacao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_APLICAR_REAJUSTE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	message := APLICAR_REAJUSTE_SUCCESS
+	if simulacao {
+		message = SIMULAR_REAJUSTE_SUCCESS
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result.Reajustes),
+		Message: message,
+		Data:    result,
+	})
+}
@@ -0,0 +1,302 @@
+package correcaoponto
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	correcaoPonto "tsukuyomi/services/correcao_ponto"
+)
+
+type CorrecaoPontoHandler interface {
+	Create(c *fiber.Ctx) error
+	FindByEmprego(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Decidir(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+}
+
+type correcaoPontoHandler struct {
+	Service correcaoPonto.Service
+}
+
+var (
+	ERROR_CREATE     = "Falha ao registrar a correção de ponto."
+	ERROR_FIND_ALL   = "Falha ao consultar correções de ponto."
+	ERROR_FIND_BY_ID = "Falha ao consultar a correção de ponto informada."
+	ERROR_DECIDIR    = "Falha ao registrar a decisão sobre a correção de ponto."
+	ERROR_DELETE     = "Falha ao apagar a correção de ponto informada."
+	ERROR_ID_EMPREGO = "ID do emprego inválido ou não informado."
+	ERROR_SITUACAO   = "Situação inválida. Aceita apenas os valores 'pendente', 'aprovada' e 'rejeitada'."
+
+	CREATE_SUCCESS     = "Correção de ponto registrada com sucesso. A correção será aplicada após ser aprovada."
+	FIND_ALL_SUCCESS   = "Consulta realizada com sucesso."
+	FIND_BY_ID_SUCCESS = "Consulta realizada com sucesso."
+	DECIDIR_SUCCESS    = "Decisão registrada com sucesso."
+	DELETE_SUCCESS     = "Correção de ponto apagada com sucesso."
+
+	FIND_ALL_RESULT_EMPTY   = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_BY_ID_RESULT_EMPTY = "Nenhuma correção de ponto encontrada com o ID informado."
+)
+
+func NewHandler(service correcaoPonto.Service) CorrecaoPontoHandler {
+	return &correcaoPontoHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Solicita uma correção no cartão de ponto
+// @Description Registra uma solicitação pendente para incluir, alterar ou remover uma marcação de ponto. A justificativa é obrigatória.
+// @Description A marcação original é preservada: a correção só passa a valer na apuração e no espelho de ponto depois de aprovada.
+//
+// @Tags    Ponto
+// @Accept  json
+// @Produce json
+//
+// @Param id              path string true  "ID do emprego"
+// @Param acao            body string true  "Ação da correção" Enums(incluir, alterar, remover)
+// @Param id_cartao_ponto body int    false "ID da marcação a ser alterada ou removida"
+// @Param horario         body string false "Novo horário, obrigatório ao incluir ou alterar uma marcação"
+// @Param tipo            body string false "Tipo da marcação, obrigatório ao incluir" Enums(entrada, saida)
+// @Param justificativa   body string true  "Justificativa da correção"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ponto/correcoes [post]
+func (h *correcaoPontoHandler) Create(c *fiber.Ctx) error {
+	idEmprego, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	correcao := models.CorrecaoPonto{}
+
+	c.BodyParser(&correcao)
+
+	correcao.IDEmprego = idEmprego
+
+	if err := correcao.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	correcao.Criado = time.Now()
+
+	correcao, err = h.Service.Create(c.UserContext(), correcao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    correcao,
+	})
+}
+
+// FindByEmprego godoc
+// @Summary     Retorna as correções de ponto de um emprego
+// @Description Retorna as correções de ponto solicitadas no emprego, com a marcação original de cada uma, opcionalmente filtradas pela situação
+//
+// @Tags    Ponto
+// @Accept  json
+// @Produce json
+//
+// @Param id       path  string true  "ID do emprego"
+// @Param situacao query string false "Situação das correções" Enums(pendente, aprovada, rejeitada)
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ponto/correcoes [get]
+func (h *correcaoPontoHandler) FindByEmprego(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	situacao := c.Query("situacao", "")
+	if situacao != "" && situacao != models.CORRECAO_PENDENTE && situacao != models.CORRECAO_APROVADA && situacao != models.CORRECAO_REJEITADA {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{ERROR_SITUACAO},
+		})
+	}
+
+	result, err := h.Service.FindByEmprego(c.UserContext(), id, situacao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Retorna uma correção de ponto
+// @Description Retorna uma correção de ponto do emprego com base no ID informado
+//
+// @Tags    Ponto
+// @Accept  json
+// @Produce json
+//
+// @Param id          path string true "ID do emprego"
+// @Param id_correcao path string true "ID da correção"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ponto/correcoes/{id_correcao} [get]
+func (h *correcaoPontoHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idCorrecao := c.Params("id_correcao", "")
+	if id == "" || idCorrecao == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id, idCorrecao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_ID_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_ID_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Decidir godoc
+// @Summary     Aprova ou rejeita uma correção de ponto
+// @Description Registra a decisão sobre uma correção pendente. O motivo é obrigatório ao rejeitar. Correções já decididas não podem ser alteradas.
+//
+// @Tags    Ponto
+// @Accept  json
+// @Produce json
+//
+// @Param id          path string true  "ID do emprego"
+// @Param id_correcao path string true  "ID da correção"
+// @Param situacao    body string true  "Decisão sobre a correção" Enums(aprovada, rejeitada)
+// @Param motivo      body string false "Motivo da decisão, obrigatório ao rejeitar"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ponto/correcoes/{id_correcao}/decisao [post]
+func (h *correcaoPontoHandler) Decidir(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idCorrecao := c.Params("id_correcao", "")
+	if id == "" || idCorrecao == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DECIDIR,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	decisao := models.DecisaoCorrecao{}
+
+	c.BodyParser(&decisao)
+
+	if err := decisao.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DECIDIR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	result, err := h.Service.Decidir(c.UserContext(), id, idCorrecao, decisao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DECIDIR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: DECIDIR_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga uma correção de ponto pendente
+// @Description Realiza um soft-delete de uma correção de ponto ainda pendente. Correções aprovadas ou rejeitadas não podem ser apagadas.
+//
+// @Tags    Ponto
+// @Accept  json
+// @Produce json
+//
+// @Param id          path string true "ID do emprego"
+// @Param id_correcao path string true "O ID da correção a ser apagada"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ponto/correcoes/{id_correcao} [delete]
+func (h *correcaoPontoHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idCorrecao := c.Params("id_correcao", "")
+	if id == "" || idCorrecao == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id, idCorrecao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
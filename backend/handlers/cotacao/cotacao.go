@@ -0,0 +1,135 @@
+package cotacao
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/cotacao"
+)
+
+type CotacaoHandler interface {
+	FindAll(c *fiber.Ctx) error
+	Importar(c *fiber.Ctx) error
+}
+
+type cotacaoHandler struct {
+	Service cotacao.Service
+}
+
+var (
+	ERROR_FIND_ALL = "Falha ao consultar as cotações da moeda."
+	ERROR_IMPORTAR = "Falha ao importar as cotações PTAX."
+	ERROR_ARQUIVO  = "Arquivo CSV não informado no campo arquivo."
+
+	FIND_ALL_SUCCESS = "Consulta realizada com sucesso."
+	IMPORTAR_SUCCESS = "Cotações PTAX importadas com sucesso."
+
+	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
+)
+
+func NewHandler(service cotacao.Service) CotacaoHandler {
+	return &cotacaoHandler{
+		Service: service,
+	}
+}
+
+// FindAll godoc
+// @Summary     Retorna as cotações de uma moeda
+// @Description Retorna as cotações PTAX de fechamento importadas para a moeda, em reais por unidade da moeda, ordenadas por data
+//
+// @Tags    Cotação
+// @Accept  json
+// @Produce json
+//
+// @Param moeda  path  string true  "Código da moeda, como USD ou EUR"
+// @Param inicio query string false "Data inicial (AAAA-MM-DD)"
+// @Param fim    query string false "Data final (AAAA-MM-DD)"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /cotacoes/{moeda} [get]
+func (h *cotacaoHandler) FindAll(c *fiber.Ctx) error {
+	moeda := c.Params("moeda", "")
+	inicio := c.Query("inicio", "")
+	fim := c.Query("fim", "")
+
+	result, err := h.Service.FindAll(c.UserContext(), moeda, inicio, fim)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Importar godoc
+// @Summary     Importa as cotações PTAX
+// @Description Importa as cotações de fechamento da PTAX a partir dos arquivos CSV publicados pelo Banco Central, o diário com todas as moedas ou o histórico de uma moeda.
+// @Description As colunas são a data, o código, o tipo e a sigla da moeda, as taxas de compra e de venda e as paridades. Dias já importados têm as taxas atualizadas.
+//
+// @Tags    Cotação
+// @Accept  multipart/form-data
+// @Produce json
+//
+// @Param arquivo formData file   true  "Arquivo CSV de fechamento da PTAX"
+// @Param moedas  formData string false "Moedas a importar, separadas por vírgula, como USD,EUR. Por padrão, todas"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /cotacoes/importar [post]
+func (h *cotacaoHandler) Importar(c *fiber.Ctx) error {
+	cabecalho, err := c.FormFile("arquivo")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR,
+			Errors:  []string{ERROR_ARQUIVO},
+		})
+	}
+
+	arquivo, err := cabecalho.Open()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	defer arquivo.Close()
+
+	var moedas []string
+	if valor := strings.TrimSpace(c.FormValue("moedas")); valor != "" {
+		moedas = strings.Split(valor, ",")
+	}
+
+	result, err := h.Service.Importar(c.UserContext(), arquivo, moedas)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   result.Importadas + result.Atualizadas,
+		Message: IMPORTAR_SUCCESS,
+		Data:    result,
+	})
+}
@@ -55,6 +55,7 @@ func NewHandler(service emprego.Service) EmpregoHandler {
 // @Param id_empresa          body int    true "ID da empresa"
 // @Param ocupacao            body string true "Nome da ocupação"
 // @Param remuneracao_inicial body number true "Valor da remuneração inicial"
+// @Param moeda               body string false "Moeda da remuneração (ISO 4217), BRL por padrão"
 // @Param tipo_contrato       body string true "Tipo de contratação"
 // @Param data_inicio         body string true "Data de admissão"
 // @Param data_fim            body string true "Data de demissão"
@@ -149,7 +150,8 @@ func (h *empregoHandler) FindAll(c *fiber.Ctx) error {
 
 // FindByID godoc
 // @Summary     Consulta um emprego por ID
-// @Description Retorna as informações de um emprego de acordo com seu ID
+// @Description Retorna as informações de um emprego de acordo com seu ID, com a compensação total vigente: a remuneração atual, anualizada com o 13º salário e o terço de férias no regime CLT,
+// @Description somada aos benefícios vigentes em valores mensais e anuais, já descontada a coparticipação.
 //
 // @Tags    Emprego
 // @Accept  json
@@ -204,6 +206,7 @@ func (h *empregoHandler) FindByID(c *fiber.Ctx) error {
 // @Param id_empresa          body int    false "ID da empresa"
 // @Param ocupacao            body string false "Nome da ocupação"
 // @Param remuneracao_inicial body number false "Valor da remuneração inicial"
+// @Param moeda               body string false "Moeda da remuneração (ISO 4217)"
 // @Param tipo_contrato       body string false "Tipo de contratação"
 // @Param data_inicio         body string false "Data de admissão"
 // @Param data_fim            body string false "Data de demissão"
@@ -0,0 +1,184 @@
+package empregoocupacao
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	empregoOcupacao "tsukuyomi/services/emprego_ocupacao"
+)
+
+type EmpregoOcupacaoHandler interface {
+	Create(c *fiber.Ctx) error
+	FindByEmprego(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+}
+
+type empregoOcupacaoHandler struct {
+	Service empregoOcupacao.Service
+}
+
+var (
+	ERROR_CREATE     = "Falha ao registrar a ocupação no emprego."
+	ERROR_FIND_ALL   = "Falha ao consultar o histórico de ocupações."
+	ERROR_DELETE     = "Falha ao apagar a ocupação do histórico."
+	ERROR_ID_EMPREGO = "ID do emprego inválido ou não informado."
+
+	CREATE_SUCCESS   = "Ocupação registrada com sucesso."
+	FIND_ALL_SUCCESS = "Consulta realizada com sucesso."
+	DELETE_SUCCESS   = "Ocupação apagada do histórico com sucesso."
+
+	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
+)
+
+func NewHandler(service empregoOcupacao.Service) EmpregoOcupacaoHandler {
+	return &empregoOcupacaoHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Registra uma mudança de ocupação no emprego
+// @Description Registra a ocupação exercida no emprego a partir da data informada, como em uma promoção. A ocupação anterior termina na véspera.
+// @Description Quando informada, a nova remuneração é registrada no histórico salarial na mesma data e vinculada à ocupação.
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id          path string true  "ID do emprego"
+// @Param id_ocupacao body int    true  "ID da ocupação do catálogo"
+// @Param data_inicio body string true  "Data a partir da qual a ocupação vigora"
+// @Param observacao  body string false "Observações sobre a mudança"
+// @Param remuneracao body number false "Nova remuneração a partir da data de início"
+// @Param moeda       body string false "Moeda da nova remuneração (ISO 4217), por padrão a do emprego"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ocupacoes [post]
+func (h *empregoOcupacaoHandler) Create(c *fiber.Ctx) error {
+	idEmprego, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	historico := models.EmpregoOcupacao{}
+
+	c.BodyParser(&historico)
+
+	historico.IDEmprego = idEmprego
+
+	if err := historico.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	historico.Criado = time.Now()
+
+	historico, err = h.Service.Create(c.UserContext(), historico)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    historico,
+	})
+}
+
+// FindByEmprego godoc
+// @Summary     Retorna o histórico de ocupações de um emprego
+// @Description Retorna as ocupações exercidas no emprego ordenadas pela data de início, com o fim de cada período e as remunerações recebidas nele
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "ID do emprego"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ocupacoes [get]
+func (h *empregoOcupacaoHandler) FindByEmprego(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	result, err := h.Service.FindByEmprego(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga uma ocupação do histórico do emprego
+// @Description Realiza um soft-delete de um período do histórico de ocupações. As remunerações registradas não são apagadas.
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id           path string true "ID do emprego"
+// @Param id_historico path string true "O ID do período a ser apagado"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ocupacoes/{id_historico} [delete]
+func (h *empregoOcupacaoHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idHistorico := c.Params("id_historico", "")
+	if id == "" || idHistorico == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id, idHistorico)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
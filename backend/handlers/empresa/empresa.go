@@ -15,6 +15,7 @@ type EmpresaHandler interface {
 	FindByID(c *fiber.Ctx) error
 	Update(c *fiber.Ctx) error
 	Delete(c *fiber.Ctx) error
+	Mesclar(c *fiber.Ctx) error
 }
 
 type empresaHandler struct {
@@ -27,12 +28,14 @@ var (
 	ERROR_FIND_BY  = "Falha ao consultar empresa por ID."
 	ERROR_UPDATE   = "Falha ao atualizar empresa."
 	ERROR_DELETE   = "Falha ao apagar a empresa informado."
+	ERROR_MESCLAR  = "Falha ao mesclar as empresas informadas."
 
 	CREATE_SUCCESS   = "Empresa criada com sucesso."
 	FIND_ALL_SUCCESS = "Consulta realizada com sucesso."
 	FIND_BY_SUCCESS  = "Consulta realizada com sucesso."
 	UPDATE_SUCCESS   = "Empresa atualizada com sucesso."
 	DELETE_SUCCESS   = "Empresa apagada com sucesso."
+	MESCLAR_SUCCESS  = "Empresas mescladas com sucesso."
 
 	FIND_BY_RESULT_EMPTY  = "Nenhum resultado encontrado para os parâmetros informados."
 	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
@@ -127,7 +130,7 @@ func (h *empresaHandler) FindAll(c *fiber.Ctx) error {
 
 // FindByID godoc
 // @Summary     Consulta uma empresa por ID
-// @Description Retorna as informações de uma empresa de acordo com seu ID
+// @Description Retorna as informações de uma empresa de acordo com seu ID. Quando o CNPJ é válido, informa se a empresa é matriz ou filial e lista as outras empresas cadastradas com a mesma raiz de CNPJ, os oito primeiros dígitos.
 //
 // @Tags    Empresa
 // @Accept  json
@@ -260,3 +263,54 @@ func (h *empresaHandler) Delete(c *fiber.Ctx) error {
 		Message: DELETE_SUCCESS,
 	})
 }
+
+// Mesclar godoc
+// @Summary     Mescla empresas duplicadas
+// @Description Transfere para a empresa os vínculos com endereços, as pessoas, os contatos, os empregos, as candidaturas e as convenções coletivas das empresas duplicadas e apaga as duplicadas, em uma única operação
+//
+// @Tags    Empresa
+// @Accept  json
+// @Produce json
+//
+// @Param id         path string true "O ID da empresa que permanece"
+// @Param duplicadas body []int true "IDs das empresas duplicadas"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /empresa/{id}/mesclar [post]
+func (h *empresaHandler) Mesclar(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_MESCLAR,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	mesclagem := models.MesclagemEmpresa{}
+
+	c.BodyParser(&mesclagem)
+
+	if err := mesclagem.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_MESCLAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	result, err := h.Service.Mesclar(c.UserContext(), id, mesclagem)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_MESCLAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: MESCLAR_SUCCESS,
+		Data:    result,
+	})
+}
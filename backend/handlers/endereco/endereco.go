@@ -15,6 +15,8 @@ type EnderecoHandler interface {
 	FindByID(c *fiber.Ctx) error
 	Update(c *fiber.Ctx) error
 	Delete(c *fiber.Ctx) error
+	Duplicados(c *fiber.Ctx) error
+	Mesclar(c *fiber.Ctx) error
 }
 
 type enderecoHandler struct {
@@ -22,17 +24,20 @@ type enderecoHandler struct {
 }
 
 var (
-	ERROR_CREATE   = "Falha ao criar o endereço informado."
-	ERROR_FIND_ALL = "Falha ao consultar endereços."
-	ERROR_FIND_BY  = "Falha ao consultar endereço."
-	ERROR_UPDATE   = "Falha ao atualizar endereço."
-	ERROR_DELETE   = "Falha ao apagar o endereço informado."
+	ERROR_CREATE     = "Falha ao criar o endereço informado."
+	ERROR_FIND_ALL   = "Falha ao consultar endereços."
+	ERROR_FIND_BY    = "Falha ao consultar endereço."
+	ERROR_UPDATE     = "Falha ao atualizar endereço."
+	ERROR_DELETE     = "Falha ao apagar o endereço informado."
+	ERROR_DUPLICADOS = "Falha ao consultar endereços duplicados."
+	ERROR_MESCLAR    = "Falha ao mesclar os endereços informados."
 
 	CREATE_SUCCESS   = "Endereço criado com sucesso."
 	FIND_ALL_SUCCESS = "Consulta realizada com sucesso."
 	FIND_BY_SUCCESS  = "Consulta realizada com sucesso."
 	UPDATE_SUCCESS   = "Endereço atualizado com sucesso."
 	DELETE_SUCCESS   = "Endereço apagado com sucesso."
+	MESCLAR_SUCCESS  = "Endereços mesclados com sucesso."
 
 	FIND_BY_RESULT_EMPTY  = "Nenhum resultado encontrado para os parâmetros informados."
 	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
@@ -46,7 +51,7 @@ func NewHandler(service endereco.Service) EnderecoHandler {
 
 // Create godoc
 // @Summary     Cadastra um novo endereço
-// @Description Cadastra um novo endereço de acordo com as informações fornecidas
+// @Description Cadastra um novo endereço de acordo com as informações fornecidas. O endereço é normalizado, com o tipo de logradouro por extenso, o CEP formatado e o estado pela sigla, e não pode repetir um endereço já cadastrado. Com preencher, o logradouro, o bairro, a cidade e o estado não informados são completados pela base de CEPs, e os informados que divergem do CEP retornam nos avisos do endereço.
 //
 // @Tags    Endereco
 // @Accept  json
@@ -59,6 +64,7 @@ func NewHandler(service endereco.Service) EnderecoHandler {
 // @Param cidade      body string true  "Nome da cidade"
 // @Param cep         body string true  "CEP"
 // @Param estado      body string true  "Estado"
+// @Param preencher   query bool   false "Completa os campos vazios pelo CEP"
 //
 // @Success 200 {object} models.Response
 // @Failure 400 {object} models.Response
@@ -72,7 +78,7 @@ func (h *enderecoHandler) Create(c *fiber.Ctx) error {
 
 	endereco.Criado = time.Now()
 
-	endereco, err := h.Service.Create(c.UserContext(), endereco)
+	endereco, err := h.Service.Create(c.UserContext(), endereco, c.QueryBool("preencher", false))
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
 			Message: ERROR_CREATE,
@@ -188,7 +194,7 @@ func (h *enderecoHandler) FindByID(c *fiber.Ctx) error {
 
 // Update godoc
 // @Summary     Atualiza um endereço
-// @Description Atualiza um registro de endereço de acordo com o ID e as informações informadas
+// @Description Atualiza um registro de endereço de acordo com o ID e as informações informadas. O endereço é normalizado e não pode ficar igual a outro endereço cadastrado.
 //
 // @Tags    Endereco
 // @Accept  json
@@ -230,7 +236,7 @@ func (h *enderecoHandler) Update(c *fiber.Ctx) error {
 	now := time.Now()
 	endereco.Atualizado = &now
 
-	err = h.Service.Update(c.UserContext(), endereco)
+	endereco, err = h.Service.Update(c.UserContext(), endereco)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
 			Message: ERROR_UPDATE,
@@ -281,3 +287,89 @@ func (h *enderecoHandler) Delete(c *fiber.Ctx) error {
 		Message: DELETE_SUCCESS,
 	})
 }
+
+// Duplicados godoc
+// @Summary     Relatório de endereços duplicados
+// @Description Retorna os grupos de endereços com o mesmo logradouro, número, cidade e estado depois de normalizados, ainda que o complemento, o bairro ou o CEP sejam diferentes, com as empresas vinculadas a cada um
+//
+// @Tags    Endereco
+// @Accept  json
+// @Produce json
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /endereco/duplicados [get]
+func (h *enderecoHandler) Duplicados(c *fiber.Ctx) error {
+	result, err := h.Service.Duplicados(c.UserContext())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DUPLICADOS,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Mesclar godoc
+// @Summary     Mescla endereços duplicados
+// @Description Transfere para o endereço os vínculos com empresas e as entrevistas dos endereços duplicados e apaga os duplicados, em uma única operação
+//
+// @Tags    Endereco
+// @Accept  json
+// @Produce json
+//
+// @Param id         path string true "O ID do endereço que permanece"
+// @Param duplicados body []int true "IDs dos endereços duplicados"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /endereco/{id}/mesclar [post]
+func (h *enderecoHandler) Mesclar(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_MESCLAR,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	mesclagem := models.MesclagemEndereco{}
+
+	c.BodyParser(&mesclagem)
+
+	if err := mesclagem.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_MESCLAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	result, err := h.Service.Mesclar(c.UserContext(), id, mesclagem)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_MESCLAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: MESCLAR_SUCCESS,
+		Data:    result,
+	})
+}
@@ -0,0 +1,319 @@
+package entrevista
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/entrevista"
+)
+
+type EntrevistaHandler interface {
+	Create(c *fiber.Ctx) error
+	FindByCandidatura(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+}
+
+type entrevistaHandler struct {
+	Service entrevista.Service
+}
+
+var (
+	ERROR_CREATE         = "Falha ao agendar a entrevista informada."
+	ERROR_FIND_ALL       = "Falha ao consultar as entrevistas da candidatura."
+	ERROR_FIND_BY_ID     = "Falha ao consultar a entrevista informada."
+	ERROR_UPDATE         = "Falha ao atualizar a entrevista."
+	ERROR_DELETE         = "Falha ao apagar a entrevista informada."
+	ERROR_ID_CANDIDATURA = "ID da candidatura inválido ou não informado."
+
+	CREATE_SUCCESS     = "Entrevista agendada com sucesso."
+	FIND_ALL_SUCCESS   = "Consulta realizada com sucesso."
+	FIND_BY_ID_SUCCESS = "Consulta realizada com sucesso."
+	UPDATE_SUCCESS     = "Entrevista atualizada com sucesso."
+	DELETE_SUCCESS     = "Entrevista apagada com sucesso."
+
+	FIND_ALL_RESULT_EMPTY   = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_BY_ID_RESULT_EMPTY = "Nenhuma entrevista encontrada com o ID informado."
+)
+
+func NewHandler(service entrevista.Service) EntrevistaHandler {
+	return &entrevistaHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Agenda uma entrevista da candidatura
+// @Description Agenda uma entrevista do processo seletivo. Entrevistas presenciais exigem o endereço; os entrevistadores devem ser contatos da empresa da candidatura.
+//
+// @Tags    Candidatura
+// @Accept  json
+// @Produce json
+//
+// @Param id                 path string  true  "ID da candidatura"
+// @Param data_hora          body string  true  "Data e hora da entrevista"
+// @Param duracao            body integer true  "Duração em minutos"
+// @Param formato            body string  true  "Formato da entrevista" Enums(remota, presencial)
+// @Param id_endereco        body integer false "ID do endereço, obrigatório nas entrevistas presenciais"
+// @Param link               body string  false "Link da chamada nas entrevistas remotas"
+// @Param id_entrevistadores body []int   false "IDs dos contatos da empresa que conduzem a entrevista"
+// @Param anotacoes          body string  false "Anotações sobre a entrevista"
+// @Param resultado          body string  false "Resultado da entrevista" Enums(aprovado, reprovado)
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /candidaturas/{id}/entrevistas [post]
+func (h *entrevistaHandler) Create(c *fiber.Ctx) error {
+	idCandidatura, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{ERROR_ID_CANDIDATURA},
+		})
+	}
+
+	entrevista := models.Entrevista{}
+
+	c.BodyParser(&entrevista)
+
+	entrevista.IDCandidatura = idCandidatura
+
+	if err := entrevista.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	entrevista.Criado = time.Now()
+
+	entrevista, err = h.Service.Create(c.UserContext(), entrevista)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    entrevista,
+	})
+}
+
+// FindByCandidatura godoc
+// @Summary     Retorna as entrevistas de uma candidatura
+// @Description Retorna as entrevistas da candidatura ordenadas pela data e hora
+//
+// @Tags    Candidatura
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "ID da candidatura"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /candidaturas/{id}/entrevistas [get]
+func (h *entrevistaHandler) FindByCandidatura(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{ERROR_ID_CANDIDATURA},
+		})
+	}
+
+	result, err := h.Service.FindByCandidatura(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Consulta uma entrevista por ID
+// @Description Retorna a entrevista da candidatura com o endereço e os entrevistadores
+//
+// @Tags    Candidatura
+// @Accept  json
+// @Produce json
+//
+// @Param id            path string true "ID da candidatura"
+// @Param id_entrevista path string true "O ID da entrevista para retornar"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /candidaturas/{id}/entrevistas/{id_entrevista} [get]
+func (h *entrevistaHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idEntrevista := c.Params("id_entrevista", "")
+	if id == "" || idEntrevista == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id, idEntrevista)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_ID_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_ID_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Update godoc
+// @Summary     Atualiza uma entrevista
+// @Description Atualiza uma entrevista da candidatura, como o horário, as anotações ou o resultado. Os entrevistadores informados substituem os anteriores.
+//
+// @Tags    Candidatura
+// @Accept  json
+// @Produce json
+//
+// @Param id                 path string  true  "ID da candidatura"
+// @Param id_entrevista      path string  true  "O ID da entrevista a ser atualizada"
+// @Param data_hora          body string  false "Data e hora da entrevista"
+// @Param duracao            body integer false "Duração em minutos"
+// @Param formato            body string  false "Formato da entrevista" Enums(remota, presencial)
+// @Param id_endereco        body integer false "ID do endereço, obrigatório nas entrevistas presenciais"
+// @Param link               body string  false "Link da chamada nas entrevistas remotas"
+// @Param id_entrevistadores body []int   false "IDs dos contatos da empresa que conduzem a entrevista"
+// @Param anotacoes          body string  false "Anotações sobre a entrevista"
+// @Param resultado          body string  false "Resultado da entrevista" Enums(aprovado, reprovado)
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /candidaturas/{id}/entrevistas/{id_entrevista} [put]
+func (h *entrevistaHandler) Update(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idEntrevista := c.Params("id_entrevista", "")
+	if id == "" || idEntrevista == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	entrevista, err := h.Service.FindByID(c.UserContext(), id, idEntrevista)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if entrevista.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_ID_RESULT_EMPTY,
+		})
+	}
+
+	idCandidatura := entrevista.IDCandidatura
+
+	c.BodyParser(&entrevista)
+
+	entrevista.IDCandidatura = idCandidatura
+
+	if err := entrevista.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	now := time.Now()
+	entrevista.Atualizado = &now
+
+	err = h.Service.Update(c.UserContext(), entrevista)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: UPDATE_SUCCESS,
+		Data:    entrevista,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga uma entrevista
+// @Description Realiza um soft-delete de uma entrevista da candidatura
+//
+// @Tags    Candidatura
+// @Accept  json
+// @Produce json
+//
+// @Param id            path string true "ID da candidatura"
+// @Param id_entrevista path string true "O ID da entrevista a ser apagada"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /candidaturas/{id}/entrevistas/{id_entrevista} [delete]
+func (h *entrevistaHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idEntrevista := c.Params("id_entrevista", "")
+	if id == "" || idEntrevista == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id, idEntrevista)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
@@ -0,0 +1,245 @@
+package escala
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/escala"
+)
+
+type EscalaHandler interface {
+	Create(c *fiber.Ctx) error
+	FindByEmprego(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	Previsto(c *fiber.Ctx) error
+}
+
+type escalaHandler struct {
+	Service escala.Service
+}
+
+var (
+	ERROR_CREATE     = "Falha ao criar a escala informada."
+	ERROR_FIND_ALL   = "Falha ao consultar escalas."
+	ERROR_DELETE     = "Falha ao apagar a escala informada."
+	ERROR_PREVISTO   = "Falha ao calcular a jornada prevista."
+	ERROR_ID_EMPREGO = "ID do emprego inválido ou não informado."
+
+	CREATE_SUCCESS   = "Escala criada com sucesso."
+	FIND_ALL_SUCCESS = "Consulta realizada com sucesso."
+	DELETE_SUCCESS   = "Escala apagada com sucesso."
+	PREVISTO_SUCCESS = "Cálculo realizado com sucesso."
+
+	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
+)
+
+func NewHandler(service escala.Service) EscalaHandler {
+	return &escalaHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Cadastra uma nova escala para um emprego
+// @Description Cadastra uma escala de trabalho que passa a valer a partir da data de início informada.
+// @Description Em escalas semanais, a posição de cada dia é o dia da semana (0 = domingo, 6 = sábado) e dias não informados são folgas. Ex.: 5x2 com 8h diárias informa as posições 1 a 5 com 480 minutos.
+// @Description Em escalas de revezamento, a posição é o dia do ciclo contado a partir da data de início. Ex.: 12x36 informa a posição 0 com 720 minutos e a posição 1 com 0 minutos.
+//
+// @Tags    Escala
+// @Accept  json
+// @Produce json
+//
+// @Param id          path string true  "ID do emprego"
+// @Param tipo        body string true  "Tipo da escala. Aceita apenas os valores 'semanal' e 'revezamento'" Enums(semanal, revezamento)
+// @Param descricao   body string false "Descrição da escala, como 5x2, 6x1 ou 12x36"
+// @Param data_inicio body string true  "Data a partir da qual a escala vigora"
+// @Param intervalo   body int    true  "Duração do intervalo em minutos"
+// @Param dias        body array  true  "Lista de dias com a posição e os minutos previstos"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/escalas [post]
+func (h *escalaHandler) Create(c *fiber.Ctx) error {
+	idEmprego, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	escala := models.Escala{}
+
+	c.BodyParser(&escala)
+
+	escala.IDEmprego = idEmprego
+
+	if err := escala.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	escala.Criado = time.Now()
+
+	escala, err = h.Service.Create(c.UserContext(), escala)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    escala,
+	})
+}
+
+// FindByEmprego godoc
+// @Summary     Retorna as escalas de um emprego
+// @Description Retorna o histórico de escalas de um emprego, ordenado pela data de início de vigência
+//
+// @Tags    Escala
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "ID do emprego"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/escalas [get]
+func (h *escalaHandler) FindByEmprego(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	result, err := h.Service.FindByEmprego(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga uma escala
+// @Description Realiza um soft-delete de uma escala do emprego com base no ID informado
+//
+// @Tags    Escala
+// @Accept  json
+// @Produce json
+//
+// @Param id        path string true "ID do emprego"
+// @Param id_escala path string true "O ID da escala a ser apagada"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/escalas/{id_escala} [delete]
+func (h *escalaHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idEscala := c.Params("id_escala", "")
+	if id == "" || idEscala == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id, idEscala)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
+
+// Previsto godoc
+// @Summary     Calcula a jornada prevista de um emprego
+// @Description Retorna os minutos de trabalho e de intervalo previstos para cada dia do período, de acordo com a escala vigente em cada dia e os feriados da localidade da empresa
+//
+// @Tags    Escala
+// @Accept  json
+// @Produce json
+//
+// @Param id     path  string true "ID do emprego"
+// @Param inicio query string true "Data inicial no formato AAAA-MM-DD"
+// @Param fim    query string true "Data final no formato AAAA-MM-DD"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/escalas/previsto [get]
+func (h *escalaHandler) Previsto(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_PREVISTO,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	inicio, err := time.ParseInLocation(time.DateOnly, c.Query("inicio", ""), time.Local)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_PREVISTO,
+			Errors:  []string{"Data inicial inválida ou não informada."},
+		})
+	}
+
+	fim, err := time.ParseInLocation(time.DateOnly, c.Query("fim", ""), time.Local)
+	if err != nil || fim.Before(inicio) {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_PREVISTO,
+			Errors:  []string{"Data final inválida, não informada ou anterior à data inicial."},
+		})
+	}
+
+	result, err := h.Service.Previsto(c.UserContext(), id, inicio, fim)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_PREVISTO,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: PREVISTO_SUCCESS,
+		Data:    result,
+	})
+}
@@ -0,0 +1,276 @@
+package feriado
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/feriado"
+)
+
+type FeriadoHandler interface {
+	Create(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	DiasUteis(c *fiber.Ctx) error
+}
+
+type feriadoHandler struct {
+	Service feriado.Service
+}
+
+var (
+	ERROR_CREATE     = "Falha ao criar o feriado informado."
+	ERROR_FIND_ALL   = "Falha ao consultar feriados."
+	ERROR_FIND_BY    = "Falha ao consultar feriado por ID."
+	ERROR_DELETE     = "Falha ao apagar o feriado informado."
+	ERROR_DIAS_UTEIS = "Falha ao calcular os dias úteis do período."
+
+	CREATE_SUCCESS     = "Feriado criado com sucesso."
+	FIND_ALL_SUCCESS   = "Consulta realizada com sucesso."
+	FIND_BY_SUCCESS    = "Consulta realizada com sucesso."
+	DELETE_SUCCESS     = "Feriado apagado com sucesso."
+	DIAS_UTEIS_SUCCESS = "Cálculo realizado com sucesso."
+
+	FIND_BY_RESULT_EMPTY  = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
+)
+
+func NewHandler(service feriado.Service) FeriadoHandler {
+	return &feriadoHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Cadastra um novo feriado estadual ou municipal
+// @Description Cadastra um novo feriado local de acordo com as informações fornecidas. Feriados nacionais são gerados automaticamente e não precisam ser cadastrados.
+//
+// @Tags    Feriado
+// @Accept  json
+// @Produce json
+//
+// @Param data        body string  true  "Data do feriado"
+// @Param nome        body string  true  "Nome do feriado"
+// @Param abrangencia body string  true  "Abrangência do feriado. Aceita apenas os valores 'estadual' e 'municipal'" Enums(estadual, municipal)
+// @Param estado      body string  true  "Estado onde o feriado é observado, conforme cadastrado nos endereços"
+// @Param cidade      body string  false "Cidade onde o feriado é observado. Obrigatório para feriados municipais"
+// @Param recorrente  body boolean false "Indica se o feriado se repete todo ano no mesmo dia e mês"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /feriados [post]
+func (h *feriadoHandler) Create(c *fiber.Ctx) error {
+	feriado := models.Feriado{}
+
+	c.BodyParser(&feriado)
+
+	if err := feriado.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	feriado.Criado = time.Now()
+
+	feriado, err := h.Service.Create(c.UserContext(), feriado)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    feriado,
+	})
+}
+
+// FindAll godoc
+// @Summary     Retorna os feriados de um ano
+// @Description Retorna os feriados nacionais do ano, incluindo os móveis (Carnaval, Sexta-feira Santa e Corpus Christi), somados aos feriados estaduais e municipais cadastrados para a localidade informada
+//
+// @Tags    Feriado
+// @Accept  json
+// @Produce json
+//
+// @Param ano    query int    false "Ano de referência. Se não informado, utiliza o ano atual"
+// @Param uf     query string false "Estado para incluir os feriados estaduais"
+// @Param cidade query string false "Cidade para incluir os feriados municipais. Requer o estado"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /feriados [get]
+func (h *feriadoHandler) FindAll(c *fiber.Ctx) error {
+	ano := c.QueryInt("ano", time.Now().Year())
+	uf := c.Query("uf", "")
+	cidade := c.Query("cidade", "")
+
+	result, err := h.Service.FindAll(c.UserContext(), ano, uf, cidade)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Consulta um feriado cadastrado por ID
+// @Description Retorna as informações de um feriado estadual ou municipal de acordo com seu ID
+//
+// @Tags    Feriado
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "O ID do feriado para retornar"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /feriados/{id} [get]
+func (h *feriadoHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga um feriado
+// @Description Realiza um soft-delete de um feriado cadastrado com base no ID informado
+//
+// @Tags    Feriado
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "O ID do feriado a ser apagado"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /feriados/{id} [delete]
+func (h *feriadoHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
+
+// DiasUteis godoc
+// @Summary     Calcula os dias úteis entre duas datas
+// @Description Conta os dias de segunda a sexta entre as datas informadas, inclusive, desconsiderando os feriados da localidade
+//
+// @Tags    Feriado
+// @Accept  json
+// @Produce json
+//
+// @Param inicio query string true  "Data inicial no formato AAAA-MM-DD"
+// @Param fim    query string true  "Data final no formato AAAA-MM-DD"
+// @Param uf     query string false "Estado para considerar os feriados estaduais"
+// @Param cidade query string false "Cidade para considerar os feriados municipais. Requer o estado"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /feriados/dias-uteis [get]
+func (h *feriadoHandler) DiasUteis(c *fiber.Ctx) error {
+	inicio, err := time.ParseInLocation(time.DateOnly, c.Query("inicio", ""), time.Local)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DIAS_UTEIS,
+			Errors:  []string{"Data inicial inválida ou não informada."},
+		})
+	}
+
+	fim, err := time.ParseInLocation(time.DateOnly, c.Query("fim", ""), time.Local)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DIAS_UTEIS,
+			Errors:  []string{"Data final inválida ou não informada."},
+		})
+	}
+
+	if fim.Before(inicio) {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DIAS_UTEIS,
+			Errors:  []string{"A data final deve ser posterior à data inicial."},
+		})
+	}
+
+	result, err := h.Service.DiasUteis(c.UserContext(), inicio, fim, c.Query("uf", ""), c.Query("cidade", ""))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DIAS_UTEIS,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: DIAS_UTEIS_SUCCESS,
+		Data:    result,
+	})
+}
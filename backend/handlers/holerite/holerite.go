@@ -0,0 +1,297 @@
+package holerite
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/holerite"
+)
+
+type HoleriteHandler interface {
+	Create(c *fiber.Ctx) error
+	FindByEmprego(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Classificar(c *fiber.Ctx) error
+	Conferir(c *fiber.Ctx) error
+}
+
+type holeriteHandler struct {
+	Service holerite.Service
+}
+
+var (
+	ERROR_CREATE      = "Falha ao registrar o holerite."
+	ERROR_FIND_ALL    = "Falha ao consultar holerites."
+	ERROR_FIND_BY_ID  = "Falha ao consultar o holerite informado."
+	ERROR_CLASSIFICAR = "Falha ao classificar as linhas do holerite."
+	ERROR_CONFERIR    = "Falha ao conferir os tributos do holerite."
+	ERROR_ID_EMPREGO  = "ID do emprego inválido ou não informado."
+	ERROR_DEPENDENTES = "Quantidade de dependentes inválida."
+
+	CREATE_SUCCESS      = "Holerite registrado com sucesso."
+	FIND_ALL_SUCCESS    = "Consulta realizada com sucesso."
+	FIND_BY_ID_SUCCESS  = "Consulta realizada com sucesso."
+	CLASSIFICAR_SUCCESS = "Linhas do holerite classificadas com sucesso."
+	CONFERIR_SUCCESS    = "Conferência realizada com sucesso."
+	CONFERIR_DIVERGENTE = "Conferência realizada. Há divergências entre os valores retidos e os recalculados ou linhas sem rubrica."
+
+	FIND_ALL_RESULT_EMPTY   = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_BY_ID_RESULT_EMPTY = "Nenhum holerite encontrado com o ID informado."
+)
+
+func NewHandler(service holerite.Service) HoleriteHandler {
+	return &holeriteHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Registra um holerite do emprego
+// @Description Registra o holerite do mês de referência com as suas linhas, vinculado à remuneração vigente no emprego no mês.
+// @Description Linhas sem rubrica são classificadas automaticamente pela descrição com o catálogo de rubricas.
+//
+// @Tags    Holerite
+// @Accept  json
+// @Produce json
+//
+// @Param id             path string true  "ID do emprego"
+// @Param referencia     body string true  "Mês de referência"
+// @Param moeda          body string false "Moeda do holerite (ISO 4217), por padrão a da remuneração vigente"
+// @Param data_pagamento body string false "Data de pagamento, usada na conversão para reais"
+// @Param detalhamento   body array  true  "Linhas do holerite, com tipo (credito ou debito), valor, descricao e, opcionalmente, id_rubrica"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/holerites [post]
+func (h *holeriteHandler) Create(c *fiber.Ctx) error {
+	idEmprego, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	holerite := models.Holerite{}
+
+	c.BodyParser(&holerite)
+
+	holerite.IDEmprego = idEmprego
+
+	if err := holerite.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	holerite, err = h.Service.Create(c.UserContext(), holerite)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    holerite,
+	})
+}
+
+// FindByEmprego godoc
+// @Summary     Retorna os holerites de um emprego
+// @Description Retorna os holerites do emprego ordenados pelo mês de referência, com as linhas e a rubrica de cada uma
+//
+// @Tags    Holerite
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "ID do emprego"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/holerites [get]
+func (h *holeriteHandler) FindByEmprego(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	result, err := h.Service.FindByEmprego(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Retorna um holerite
+// @Description Retorna um holerite do emprego com as linhas e a rubrica de cada uma
+//
+// @Tags    Holerite
+// @Accept  json
+// @Produce json
+//
+// @Param id          path string true "ID do emprego"
+// @Param id_holerite path string true "ID do holerite"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/holerites/{id_holerite} [get]
+func (h *holeriteHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idHolerite := c.Params("id_holerite", "")
+	if id == "" || idHolerite == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id, idHolerite)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_ID_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_ID_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Classificar godoc
+// @Summary     Classifica as linhas de um holerite
+// @Description Atribui rubricas às linhas do holerite pela descrição, usando o nome e os termos das rubricas do catálogo.
+// @Description Por padrão, apenas as linhas sem rubrica são classificadas. Com o parâmetro todas, as linhas já classificadas também são revistas.
+//
+// @Tags    Holerite
+// @Accept  json
+// @Produce json
+//
+// @Param id          path  string true  "ID do emprego"
+// @Param id_holerite path  string true  "ID do holerite"
+// @Param todas       query bool   false "Reclassifica também as linhas que já têm rubrica"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/holerites/{id_holerite}/classificar [post]
+func (h *holeriteHandler) Classificar(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idHolerite := c.Params("id_holerite", "")
+	if id == "" || idHolerite == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CLASSIFICAR,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.Classificar(c.UserContext(), id, idHolerite, c.QueryBool("todas", false))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CLASSIFICAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CLASSIFICAR_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Conferir godoc
+// @Summary     Confere o INSS e o IRRF de um holerite
+// @Description Recalcula o INSS e o IRRF do holerite a partir das incidências das rubricas e das tabelas vigentes no mês de referência, inclusive sobre o 13º salário,
+// @Description e aponta as diferenças em relação aos valores retidos pela empresa. O IRRF considera a dedução mais vantajosa entre as legais e o desconto simplificado.
+// @Description Diferenças de até R$ 0,05 são atribuídas a arredondamentos. Linhas sem rubrica são listadas e impedem a conferência de ser considerada consistente.
+//
+// @Tags    Holerite
+// @Accept  json
+// @Produce json
+//
+// @Param id          path  string true  "ID do emprego"
+// @Param id_holerite path  string true  "ID do holerite"
+// @Param dependentes query int    false "Quantidade de dependentes para a dedução do IRRF"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/holerites/{id_holerite}/conferencia [get]
+func (h *holeriteHandler) Conferir(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idHolerite := c.Params("id_holerite", "")
+	if id == "" || idHolerite == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CONFERIR,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	dependentes := c.QueryInt("dependentes", 0)
+	if dependentes < 0 {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CONFERIR,
+			Errors:  []string{ERROR_DEPENDENTES},
+		})
+	}
+
+	result, err := h.Service.Conferir(c.UserContext(), id, idHolerite, dependentes)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CONFERIR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	message := CONFERIR_SUCCESS
+	if !result.Consistente {
+		message = CONFERIR_DIVERGENTE
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: message,
+		Data:    result,
+	})
+}
@@ -0,0 +1,177 @@
+package indice
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/indice"
+)
+
+type IndiceHandler interface {
+	FindAll(c *fiber.Ctx) error
+	Importar(c *fiber.Ctx) error
+	EvolucaoReal(c *fiber.Ctx) error
+}
+
+type indiceHandler struct {
+	Service indice.Service
+}
+
+var (
+	ERROR_FIND_ALL      = "Falha ao consultar as variações do índice."
+	ERROR_IMPORTAR      = "Falha ao importar as variações do índice."
+	ERROR_EVOLUCAO_REAL = "Falha ao calcular a evolução real da remuneração."
+	ERROR_ARQUIVO       = "Arquivo CSV não informado no campo arquivo."
+	ERROR_ID_EMPREGO    = "ID do emprego inválido ou não informado."
+
+	FIND_ALL_SUCCESS      = "Consulta realizada com sucesso."
+	IMPORTAR_SUCCESS      = "Variações do índice importadas com sucesso."
+	EVOLUCAO_REAL_SUCCESS = "Evolução real da remuneração calculada com sucesso."
+
+	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
+)
+
+func NewHandler(service indice.Service) IndiceHandler {
+	return &indiceHandler{
+		Service: service,
+	}
+}
+
+// FindAll godoc
+// @Summary     Retorna as variações mensais de um índice
+// @Description Retorna as variações mensais importadas do índice de preços, ordenadas pelo mês de referência
+//
+// @Tags    Índice
+// @Accept  json
+// @Produce json
+//
+// @Param indice path  string true  "Índice de preços" Enums(ipca, inpc, igpm)
+// @Param inicio query string false "Primeiro mês de referência (AAAA-MM-DD)"
+// @Param fim    query string false "Último mês de referência (AAAA-MM-DD)"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /indices/{indice} [get]
+func (h *indiceHandler) FindAll(c *fiber.Ctx) error {
+	indice := c.Params("indice", "")
+	inicio := c.Query("inicio", "")
+	fim := c.Query("fim", "")
+
+	result, err := h.Service.FindAll(c.UserContext(), indice, inicio, fim)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Importar godoc
+// @Summary     Importa as variações mensais de um índice
+// @Description Importa as variações mensais do IPCA, do INPC ou do IGP-M a partir do arquivo CSV exportado do Sistema Gerenciador de Séries Temporais do Banco Central (séries 433, 188 e 189) ou de uma tabela do SIDRA do IBGE.
+// @Description Aceita arquivos separados por ponto e vírgula ou vírgula, em ISO-8859-1 ou UTF-8, com o mês em DD/MM/AAAA, MM/AAAA, AAAA-MM, AAAAMM ou por extenso. Meses já importados têm a variação atualizada.
+//
+// @Tags    Índice
+// @Accept  multipart/form-data
+// @Produce json
+//
+// @Param indice  path     string true "Índice de preços" Enums(ipca, inpc, igpm)
+// @Param arquivo formData file   true "Arquivo CSV com as variações mensais"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /indices/{indice}/importar [post]
+func (h *indiceHandler) Importar(c *fiber.Ctx) error {
+	indice := c.Params("indice", "")
+
+	cabecalho, err := c.FormFile("arquivo")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR,
+			Errors:  []string{ERROR_ARQUIVO},
+		})
+	}
+
+	arquivo, err := cabecalho.Open()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	defer arquivo.Close()
+
+	result, err := h.Service.Importar(c.UserContext(), indice, arquivo)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   result.Importados + result.Atualizados,
+		Message: IMPORTAR_SUCCESS,
+		Data:    result,
+	})
+}
+
+// EvolucaoReal godoc
+// @Summary     Retorna a evolução real da remuneração de um emprego
+// @Description Deflaciona cada salário do histórico do emprego pelo índice de preços para valores do mês-base e informa, em percentual, a variação nominal, a inflação e o ganho ou a perda real em cada reajuste e acumulados desde o salário inicial.
+// @Description Também informa a inflação desde o último reajuste e o ganho real acumulado descontando essa inflação.
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id     path  string true  "ID do emprego"
+// @Param indice query string false "Índice de preços, IPCA por padrão" Enums(ipca, inpc, igpm)
+// @Param base   query string false "Mês-base (AAAA-MM), por padrão o último mês com índice publicado"
+// @Param brl    query bool   false "Converte os salários em moeda estrangeira para reais pela PTAX da data de cada um"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/evolucao-real [get]
+func (h *indiceHandler) EvolucaoReal(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_EVOLUCAO_REAL,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	result, err := h.Service.EvolucaoReal(c.UserContext(), id, c.Query("indice", ""), c.Query("base", ""), c.QueryBool("brl", false))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_EVOLUCAO_REAL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result.Periodos),
+		Message: EVOLUCAO_REAL_SUCCESS,
+		Data:    result,
+	})
+}
@@ -0,0 +1,358 @@
+package notafiscal
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	notaFiscal "tsukuyomi/services/nota_fiscal"
+)
+
+type NotaFiscalHandler interface {
+	Create(c *fiber.Ctx) error
+	FindByEmprego(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	EstimarDAS(c *fiber.Ctx) error
+}
+
+type notaFiscalHandler struct {
+	Service notaFiscal.Service
+}
+
+var (
+	ERROR_CREATE      = "Falha ao registrar a nota fiscal informada."
+	ERROR_FIND_ALL    = "Falha ao consultar as notas fiscais do emprego."
+	ERROR_FIND_BY_ID  = "Falha ao consultar a nota fiscal informada."
+	ERROR_UPDATE      = "Falha ao atualizar a nota fiscal."
+	ERROR_DELETE      = "Falha ao apagar a nota fiscal informada."
+	ERROR_ESTIMAR_DAS = "Falha ao estimar o DAS do Simples Nacional."
+	ERROR_ID_EMPREGO  = "ID do emprego inválido ou não informado."
+
+	CREATE_SUCCESS      = "Nota fiscal registrada com sucesso."
+	FIND_ALL_SUCCESS    = "Consulta realizada com sucesso."
+	FIND_BY_ID_SUCCESS  = "Consulta realizada com sucesso."
+	UPDATE_SUCCESS      = "Nota fiscal atualizada com sucesso."
+	DELETE_SUCCESS      = "Nota fiscal apagada com sucesso."
+	ESTIMAR_DAS_SUCCESS = "Estimativa realizada com sucesso."
+
+	FIND_ALL_RESULT_EMPTY   = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_BY_ID_RESULT_EMPTY = "Nenhuma nota fiscal encontrada com o ID informado."
+)
+
+func NewHandler(service notaFiscal.Service) NotaFiscalHandler {
+	return &notaFiscalHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Registra uma nota fiscal do emprego
+// @Description Registra uma nota fiscal de serviço emitida em um emprego PJ. O número não pode se repetir no emprego.
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id             path string true  "ID do emprego"
+// @Param numero         body string true  "Número da nota fiscal"
+// @Param data_emissao   body string true  "Data de emissão"
+// @Param valor          body number true  "Valor do serviço"
+// @Param situacao       body string true  "Situação da nota fiscal" Enums(pendente, paga)
+// @Param data_pagamento body string false "Data de pagamento"
+// @Param descricao      body string false "Descrição do serviço"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/notas-fiscais [post]
+func (h *notaFiscalHandler) Create(c *fiber.Ctx) error {
+	idEmprego, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	nota := models.NotaFiscal{}
+
+	c.BodyParser(&nota)
+
+	nota.IDEmprego = idEmprego
+
+	if err := nota.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	nota.Criado = time.Now()
+
+	nota, err = h.Service.Create(c.UserContext(), nota)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    nota,
+	})
+}
+
+// FindByEmprego godoc
+// @Summary     Retorna as notas fiscais de um emprego
+// @Description Retorna as notas fiscais do emprego ordenadas pela data de emissão
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id       path  string true  "ID do emprego"
+// @Param situacao query string false "Situação da nota fiscal" Enums(pendente, paga)
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/notas-fiscais [get]
+func (h *notaFiscalHandler) FindByEmprego(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	result, err := h.Service.FindByEmprego(c.UserContext(), id, c.Query("situacao", ""))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Retorna uma nota fiscal
+// @Description Retorna uma nota fiscal do emprego com base no ID informado
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id             path string true "ID do emprego"
+// @Param id_nota_fiscal path string true "ID da nota fiscal"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/notas-fiscais/{id_nota_fiscal} [get]
+func (h *notaFiscalHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idNota := c.Params("id_nota_fiscal", "")
+	if id == "" || idNota == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id, idNota)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY_ID,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_ID_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_ID_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Update godoc
+// @Summary     Atualiza uma nota fiscal
+// @Description Atualiza uma nota fiscal do emprego de acordo com o ID e as informações informadas. Para registrar o pagamento, informe a situação paga e a data de pagamento.
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id             path string true  "ID do emprego"
+// @Param id_nota_fiscal path string true  "ID da nota fiscal"
+// @Param numero         body string false "Número da nota fiscal"
+// @Param data_emissao   body string false "Data de emissão"
+// @Param valor          body number false "Valor do serviço"
+// @Param situacao       body string false "Situação da nota fiscal" Enums(pendente, paga)
+// @Param data_pagamento body string false "Data de pagamento"
+// @Param descricao      body string false "Descrição do serviço"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/notas-fiscais/{id_nota_fiscal} [put]
+func (h *notaFiscalHandler) Update(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idNota := c.Params("id_nota_fiscal", "")
+	if id == "" || idNota == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	nota, err := h.Service.FindByID(c.UserContext(), id, idNota)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if nota.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_ID_RESULT_EMPTY,
+		})
+	}
+
+	c.BodyParser(&nota)
+
+	if err := nota.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	now := time.Now()
+	nota.Atualizado = &now
+
+	err = h.Service.Update(c.UserContext(), nota)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: UPDATE_SUCCESS,
+		Data:    nota,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga uma nota fiscal
+// @Description Realiza um soft-delete de uma nota fiscal do emprego
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id             path string true "ID do emprego"
+// @Param id_nota_fiscal path string true "O ID da nota fiscal a ser apagada"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/notas-fiscais/{id_nota_fiscal} [delete]
+func (h *notaFiscalHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idNota := c.Params("id_nota_fiscal", "")
+	if id == "" || idNota == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id, idNota)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
+
+// EstimarDAS godoc
+// @Summary     Estima o DAS do Simples Nacional
+// @Description Estima o DAS da competência pelos anexos III ou V do Simples Nacional, com a receita das notas fiscais emitidas no mês e a receita bruta dos doze meses anteriores (RBT12).
+// @Description Sem anexo informado, ele é definido pelo Fator R: a folha de salários, calculada pelo pró-labore mensal, dividida pela RBT12, com o anexo III a partir de 28%.
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id          path  string true  "ID do emprego"
+// @Param competencia query string false "Competência (AAAA-MM), por padrão o mês atual"
+// @Param pro_labore  query number false "Pró-labore mensal, usado na folha de salários do Fator R"
+// @Param anexo       query string false "Anexo do Simples Nacional, por padrão definido pelo Fator R" Enums(iii, v)
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/notas-fiscais/das [get]
+func (h *notaFiscalHandler) EstimarDAS(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_ESTIMAR_DAS,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	result, err := h.Service.EstimarDAS(c.UserContext(), id, c.Query("competencia", ""), c.Query("pro_labore", ""), c.Query("anexo", ""))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_ESTIMAR_DAS,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: ESTIMAR_DAS_SUCCESS,
+		Data:    result,
+	})
+}
@@ -0,0 +1,334 @@
+package ocupacao
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/ocupacao"
+)
+
+type OcupacaoHandler interface {
+	Create(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	ImportarCBO(c *fiber.Ctx) error
+}
+
+type ocupacaoHandler struct {
+	Service ocupacao.Service
+}
+
+var (
+	ERROR_CREATE       = "Falha ao criar a ocupação informada."
+	ERROR_FIND_ALL     = "Falha ao consultar ocupações."
+	ERROR_FIND_BY      = "Falha ao consultar ocupação por ID."
+	ERROR_UPDATE       = "Falha ao atualizar ocupação."
+	ERROR_DELETE       = "Falha ao apagar a ocupação informada."
+	ERROR_IMPORTAR_CBO = "Falha ao importar o arquivo de ocupações da CBO."
+	ERROR_ARQUIVO      = "Arquivo não informado."
+
+	CREATE_SUCCESS       = "Ocupação criada com sucesso."
+	FIND_ALL_SUCCESS     = "Consulta realizada com sucesso."
+	FIND_BY_SUCCESS      = "Consulta realizada com sucesso."
+	UPDATE_SUCCESS       = "Ocupação atualizada com sucesso."
+	DELETE_SUCCESS       = "Ocupação apagada com sucesso."
+	IMPORTAR_CBO_SUCCESS = "Arquivo de ocupações da CBO importado com sucesso."
+
+	FIND_BY_RESULT_EMPTY  = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
+)
+
+func NewHandler(service ocupacao.Service) OcupacaoHandler {
+	return &ocupacaoHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Cadastra uma nova ocupação
+// @Description Cadastra uma nova ocupação no catálogo, opcionalmente com o código da Classificação Brasileira de Ocupações (CBO)
+//
+// @Tags    Ocupação
+// @Accept  json
+// @Produce json
+//
+// @Param nome body string true  "Nome da ocupação"
+// @Param cbo  body string false "Código CBO, com ou sem hífen. Ex.: 2124-05"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /ocupacoes [post]
+func (h *ocupacaoHandler) Create(c *fiber.Ctx) error {
+	ocupacao := models.Ocupacao{}
+
+	c.BodyParser(&ocupacao)
+
+	if err := ocupacao.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	ocupacao.Criado = time.Now()
+
+	ocupacao, err := h.Service.Create(c.UserContext(), ocupacao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    ocupacao,
+	})
+}
+
+// FindAll godoc
+// @Summary     Retorna as ocupações
+// @Description Retorna as ocupações do catálogo que atendam aos critérios informados, ordenadas por nome
+//
+// @Tags    Ocupação
+// @Accept  json
+// @Produce json
+//
+// @Param search query string false "Campo aberto para pesquisa no nome e no código CBO"
+// @Param cbo    query string false "Código CBO"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /ocupacoes [get]
+func (h *ocupacaoHandler) FindAll(c *fiber.Ctx) error {
+	search := c.Query("search", "")
+	cbo := c.Query("cbo", "")
+
+	result, err := h.Service.FindAll(c.UserContext(), search, cbo)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Consulta uma ocupação por ID
+// @Description Retorna as informações de uma ocupação de acordo com seu ID
+//
+// @Tags    Ocupação
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "O ID da ocupação para retornar"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /ocupacoes/{id} [get]
+func (h *ocupacaoHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Update godoc
+// @Summary     Atualiza uma ocupação
+// @Description Atualiza um registro de ocupação de acordo com o ID e as informações informadas
+//
+// @Tags    Ocupação
+// @Accept  json
+// @Produce json
+//
+// @Param id   path string true  "O ID da ocupação a ser atualizada"
+// @Param nome body string false "Nome da ocupação"
+// @Param cbo  body string false "Código CBO, com ou sem hífen"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /ocupacoes/{id} [put]
+func (h *ocupacaoHandler) Update(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	ocupacao, err := h.Service.FindByID(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if ocupacao.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_RESULT_EMPTY,
+		})
+	}
+
+	c.BodyParser(&ocupacao)
+
+	if err := ocupacao.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	now := time.Now()
+	ocupacao.Atualizado = &now
+
+	err = h.Service.Update(c.UserContext(), ocupacao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: UPDATE_SUCCESS,
+		Data:    ocupacao,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga uma ocupação
+// @Description Realiza um soft-delete de uma ocupação com base no ID informado
+//
+// @Tags    Ocupação
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "O ID da ocupação a ser apagada"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /ocupacoes/{id} [delete]
+func (h *ocupacaoHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
+
+// ImportarCBO godoc
+// @Summary     Importa as ocupações da CBO
+// @Description Importa a lista oficial de ocupações da Classificação Brasileira de Ocupações a partir do arquivo CSV publicado pelo Ministério do Trabalho (CBO2002 - Ocupacao.csv), com as colunas CODIGO e TITULO.
+// @Description Aceita arquivos separados por ponto e vírgula ou vírgula, em ISO-8859-1 ou UTF-8. Ocupações com código já cadastrado têm o nome atualizado.
+//
+// @Tags    Ocupação
+// @Accept  multipart/form-data
+// @Produce json
+//
+// @Param arquivo formData file true "Arquivo CSV de ocupações da CBO"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /ocupacoes/importar-cbo [post]
+func (h *ocupacaoHandler) ImportarCBO(c *fiber.Ctx) error {
+	cabecalho, err := c.FormFile("arquivo")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR_CBO,
+			Errors:  []string{ERROR_ARQUIVO},
+		})
+	}
+
+	arquivo, err := cabecalho.Open()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR_CBO,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	defer arquivo.Close()
+
+	result, err := h.Service.ImportarCBO(c.UserContext(), arquivo)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR_CBO,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   result.Importadas + result.Atualizadas,
+		Message: IMPORTAR_CBO_SUCCESS,
+		Data:    result,
+	})
+}
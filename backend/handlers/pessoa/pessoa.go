@@ -0,0 +1,339 @@
+package pessoa
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/pessoa"
+)
+
+type PessoaHandler interface {
+	Create(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+	FindByEmpresa(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+}
+
+type pessoaHandler struct {
+	Service pessoa.Service
+}
+
+var (
+	ERROR_CREATE     = "Falha ao cadastrar a pessoa informada."
+	ERROR_FIND_ALL   = "Falha ao consultar pessoas."
+	ERROR_FIND_BY    = "Falha ao consultar pessoa por ID."
+	ERROR_UPDATE     = "Falha ao atualizar pessoa."
+	ERROR_DELETE     = "Falha ao apagar a pessoa informada."
+	ERROR_ID_EMPRESA = "ID da empresa não informado."
+
+	CREATE_SUCCESS   = "Pessoa cadastrada com sucesso."
+	FIND_ALL_SUCCESS = "Consulta realizada com sucesso."
+	FIND_BY_SUCCESS  = "Consulta realizada com sucesso."
+	UPDATE_SUCCESS   = "Pessoa atualizada com sucesso."
+	DELETE_SUCCESS   = "Pessoa apagada com sucesso."
+
+	FIND_BY_RESULT_EMPTY  = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
+)
+
+func NewHandler(service pessoa.Service) PessoaHandler {
+	return &pessoaHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Cadastra uma pessoa de contato da empresa
+// @Description Cadastra uma pessoa da empresa, como um recrutador ou alguém do RH. Os contatos da pessoa são cadastrados nos contatos da empresa, informando o ID dela.
+//
+// @Tags    Pessoa
+// @Accept  json
+// @Produce json
+//
+// @Param id_empresa   body int    true  "ID da empresa"
+// @Param nome         body string true  "Nome da pessoa"
+// @Param cargo        body string false "Cargo da pessoa na empresa"
+// @Param departamento body string false "Departamento da pessoa na empresa"
+// @Param linkedin     body string false "Perfil da pessoa no LinkedIn"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /pessoas [post]
+func (h *pessoaHandler) Create(c *fiber.Ctx) error {
+	pessoa := models.Pessoa{}
+
+	c.BodyParser(&pessoa)
+
+	if err := pessoa.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	pessoa.Criado = time.Now()
+
+	pessoa, err := h.Service.Create(c.UserContext(), pessoa)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    pessoa,
+	})
+}
+
+// FindAll godoc
+// @Summary     Pesquisa as pessoas de contato
+// @Description Retorna as pessoas de todas as empresas que atendam aos critérios informados, ordenadas pela empresa e pelo nome
+//
+// @Tags    Pessoa
+// @Accept  json
+// @Produce json
+//
+// @Param search       query string false "Campo aberto para pesquisa no nome, cargo, departamento, LinkedIn e nome da empresa"
+// @Param empresa      query string false "ID ou nome da empresa"
+// @Param departamento query string false "Departamento da pessoa"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /pessoas [get]
+func (h *pessoaHandler) FindAll(c *fiber.Ctx) error {
+	search := c.Query("search", "")
+	empresa := c.Query("empresa", "")
+	departamento := c.Query("departamento", "")
+
+	result, err := h.Service.FindAll(c.UserContext(), search, empresa, departamento)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByEmpresa godoc
+// @Summary     Retorna as pessoas de contato de uma empresa
+// @Description Retorna as pessoas da empresa, com os contatos de cada uma, ordenadas pelo nome
+//
+// @Tags    Pessoa
+// @Accept  json
+// @Produce json
+//
+// @Param id     path  string true  "ID da empresa"
+// @Param search query string false "Campo aberto para pesquisa no nome, cargo, departamento e LinkedIn"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /empresa/{id}/pessoas [get]
+func (h *pessoaHandler) FindByEmpresa(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{ERROR_ID_EMPRESA},
+		})
+	}
+
+	result, err := h.Service.FindByEmpresa(c.UserContext(), id, c.Query("search", ""))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Consulta uma pessoa de contato por ID
+// @Description Retorna as informações de uma pessoa com os contatos dela
+//
+// @Tags    Pessoa
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "O ID da pessoa para retornar"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /pessoas/{id} [get]
+func (h *pessoaHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Update godoc
+// @Summary     Atualiza uma pessoa de contato
+// @Description Atualiza os dados de uma pessoa. A empresa da pessoa não é alterada.
+//
+// @Tags    Pessoa
+// @Accept  json
+// @Produce json
+//
+// @Param id           path string true  "O ID da pessoa a ser atualizada"
+// @Param nome         body string false "Nome da pessoa"
+// @Param cargo        body string false "Cargo da pessoa na empresa"
+// @Param departamento body string false "Departamento da pessoa na empresa"
+// @Param linkedin     body string false "Perfil da pessoa no LinkedIn"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /pessoas/{id} [put]
+func (h *pessoaHandler) Update(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	pessoa, err := h.Service.FindByID(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if pessoa.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_RESULT_EMPTY,
+		})
+	}
+
+	idEmpresa := pessoa.IDEmpresa
+
+	c.BodyParser(&pessoa)
+
+	pessoa.IDEmpresa = idEmpresa
+
+	if err := pessoa.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	now := time.Now()
+	pessoa.Atualizado = &now
+
+	err = h.Service.Update(c.UserContext(), pessoa)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: UPDATE_SUCCESS,
+		Data:    pessoa,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga uma pessoa de contato
+// @Description Realiza um soft-delete de uma pessoa. Os contatos dela continuam como contatos gerais da empresa.
+//
+// @Tags    Pessoa
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "O ID da pessoa a ser apagada"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /pessoas/{id} [delete]
+func (h *pessoaHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
@@ -0,0 +1,277 @@
+package ponto
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/ponto"
+)
+
+type PontoHandler interface {
+	Conformidade(c *fiber.Ctx) error
+	Espelho(c *fiber.Ctx) error
+	ImportarAFD(c *fiber.Ctx) error
+}
+
+type pontoHandler struct {
+	Service ponto.Service
+}
+
+var (
+	ERROR_CONFORMIDADE = "Falha ao verificar a conformidade da jornada."
+	ERROR_ID_EMPREGO   = "ID do emprego não informado."
+	ERROR_PERIODO      = "Período inválido. Informe as datas no formato AAAA-MM-DD, com a data final posterior à inicial."
+	ERROR_ESPELHO      = "Falha ao gerar o espelho de ponto."
+	ERROR_MES          = "Mês inválido. Informe o mês no formato AAAA-MM."
+	ERROR_FORMATO      = "Formato inválido. Aceita apenas os valores 'json', 'csv' e 'pdf'."
+	ERROR_IMPORTAR_AFD = "Falha ao importar o arquivo AFD."
+	ERROR_ARQUIVO      = "Arquivo não informado."
+
+	CONFORMIDADE_SUCCESS = "Verificação realizada com sucesso."
+	ESPELHO_SUCCESS      = "Espelho de ponto gerado com sucesso."
+	IMPORTAR_AFD_SUCCESS = "Arquivo AFD importado com sucesso."
+
+	CONFORMIDADE_EMPTY = "Nenhuma violação encontrada no período."
+)
+
+func NewHandler(service ponto.Service) PontoHandler {
+	return &pontoHandler{
+		Service: service,
+	}
+}
+
+// Conformidade godoc
+// @Summary     Verifica a conformidade da jornada com a CLT
+// @Description Retorna, por dia, as violações das regras de jornada encontradas nas marcações do emprego: descanso inferior a 11h entre jornadas (interjornada),
+// @Description intervalo inferior a 1h em jornadas acima de 6h, mais de 2h extras no dia e mais de 6 dias consecutivos de trabalho.
+// @Description Se o período não for informado, considera o mês atual.
+//
+// @Tags    Ponto
+// @Accept  json
+// @Produce json
+//
+// @Param id     path  string true  "ID do emprego"
+// @Param inicio query string false "Data inicial no formato AAAA-MM-DD"
+// @Param fim    query string false "Data final no formato AAAA-MM-DD"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/conformidade [get]
+func (h *pontoHandler) Conformidade(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CONFORMIDADE,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	inicio, fim, err := periodo(c)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CONFORMIDADE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	result, err := h.Service.Conformidade(c.UserContext(), id, inicio, fim)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CONFORMIDADE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	message := CONFORMIDADE_SUCCESS
+	if result.Violacoes == 0 {
+		message = CONFORMIDADE_EMPTY
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   result.Violacoes,
+		Message: message,
+		Data:    result,
+	})
+}
+
+// Espelho godoc
+// @Summary     Gera o espelho de ponto mensal de um emprego
+// @Description Retorna o espelho de ponto do mês com as marcações de cada dia, horas previstas e trabalhadas, saldo diário, feriados, totais e saldo do banco de horas.
+// @Description O espelho pode ser exportado em CSV ou PDF, gerados localmente, ou retornado em JSON. Se o mês não for informado, considera o mês atual.
+//
+// @Tags    Ponto
+// @Accept  json
+// @Produce json,text/csv,application/pdf
+//
+// @Param id      path  string true  "ID do emprego"
+// @Param mes     query string false "Mês de referência no formato AAAA-MM"
+// @Param formato query string false "Formato do espelho. Aceita apenas os valores 'json', 'csv' e 'pdf'" Enums(json, csv, pdf)
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/espelho-ponto [get]
+func (h *pontoHandler) Espelho(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_ESPELHO,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	mes := time.Now()
+
+	if valor := c.Query("mes", ""); valor != "" {
+		var err error
+
+		if mes, err = time.ParseInLocation("2006-01", valor, time.Local); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+				Message: ERROR_ESPELHO,
+				Errors:  []string{ERROR_MES},
+			})
+		}
+	}
+
+	formato := c.Query("formato", "json")
+	if formato != "json" && formato != "csv" && formato != "pdf" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_ESPELHO,
+			Errors:  []string{ERROR_FORMATO},
+		})
+	}
+
+	result, err := h.Service.Espelho(c.UserContext(), id, mes)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_ESPELHO,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	arquivo := fmt.Sprintf("espelho-ponto-%s-%s.%s", id, result.Mes.Format("2006-01"), formato)
+
+	switch formato {
+	case "csv":
+		conteudo, err := ponto.EspelhoCSV(result)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+				Message: ERROR_ESPELHO,
+				Errors:  []string{err.Error()},
+			})
+		}
+
+		c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+		c.Attachment(arquivo)
+
+		return c.Status(fiber.StatusOK).Send(conteudo)
+	case "pdf":
+		c.Set(fiber.HeaderContentType, "application/pdf")
+		c.Attachment(arquivo)
+
+		return c.Status(fiber.StatusOK).Send(ponto.EspelhoPDF(result))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result.Dias),
+		Message: ESPELHO_SUCCESS,
+		Data:    result,
+	})
+}
+
+// ImportarAFD godoc
+// @Summary     Importa marcações de ponto de um arquivo AFD
+// @Description Importa as marcações de um Arquivo Fonte de Dados (AFD) gerado por relógios de ponto nos leiautes das Portarias 671/2021 e 1510/2009.
+// @Description O CRC-16 dos registros e a cadeia de hash SHA-256 das marcações do REP-P são validados quando presentes, e marcações já registradas no cartão de ponto do emprego são ignoradas.
+// @Description Como o AFD não distingue entradas de saídas, as marcações de cada dia são alternadas a partir de uma entrada.
+// @Description Retorna a situação de cada linha do arquivo que não foi importada silenciosamente: importada, ignorada ou com erro.
+//
+// @Tags    Ponto
+// @Accept  multipart/form-data
+// @Produce json
+//
+// @Param id      path     string true  "ID do emprego"
+// @Param arquivo formData file   true  "Arquivo AFD"
+// @Param cpf     formData string false "CPF do empregado, ou PIS no leiaute da Portaria 1510, para importar apenas as suas marcações"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/ponto/importar [post]
+func (h *pontoHandler) ImportarAFD(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR_AFD,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	cabecalho, err := c.FormFile("arquivo")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR_AFD,
+			Errors:  []string{ERROR_ARQUIVO},
+		})
+	}
+
+	arquivo, err := cabecalho.Open()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR_AFD,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	defer arquivo.Close()
+
+	result, err := h.Service.ImportarAFD(c.UserContext(), id, arquivo, c.FormValue("cpf", ""))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR_AFD,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   result.Importadas,
+		Message: IMPORTAR_AFD_SUCCESS,
+		Data:    result,
+	})
+}
+
+// periodo lê as datas inicial e final da query. Sem datas, retorna o mês atual.
+func periodo(c *fiber.Ctx) (time.Time, time.Time, error) {
+	hoje := time.Now()
+
+	inicio := time.Date(hoje.Year(), hoje.Month(), 1, 0, 0, 0, 0, time.Local)
+	fim := inicio.AddDate(0, 1, -1)
+
+	var err error
+
+	if valor := c.Query("inicio", ""); valor != "" {
+		if inicio, err = time.ParseInLocation(time.DateOnly, valor, time.Local); err != nil {
+			return time.Time{}, time.Time{}, errors.New(ERROR_PERIODO)
+		}
+	}
+
+	if valor := c.Query("fim", ""); valor != "" {
+		if fim, err = time.ParseInLocation(time.DateOnly, valor, time.Local); err != nil {
+			return time.Time{}, time.Time{}, errors.New(ERROR_PERIODO)
+		}
+	}
+
+	if fim.Before(inicio) {
+		return time.Time{}, time.Time{}, errors.New(ERROR_PERIODO)
+	}
+
+	return inicio, fim, nil
+}
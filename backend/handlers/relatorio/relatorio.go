@@ -0,0 +1,114 @@
+package relatorio
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/relatorio"
+)
+
+type RelatorioHandler interface {
+	Rendimentos(c *fiber.Ctx) error
+}
+
+type relatorioHandler struct {
+	Service relatorio.Service
+}
+
+var (
+	ERROR_RENDIMENTOS = "Falha ao gerar o informe de rendimentos."
+	ERROR_ANO         = "Ano inválido. Informe o ano-calendário com quatro dígitos."
+	ERROR_FORMATO     = "Formato inválido. Aceita apenas os valores 'json' e 'csv'."
+
+	RENDIMENTOS_SUCCESS = "Informe de rendimentos gerado com sucesso."
+	RENDIMENTOS_EMPTY   = "Nenhum holerite encontrado no ano informado."
+)
+
+func NewHandler(service relatorio.Service) RelatorioHandler {
+	return &relatorioHandler{
+		Service: service,
+	}
+}
+
+// Rendimentos godoc
+// @Summary     Gera o informe de rendimentos do ano
+// @Description Agrupa as linhas dos holerites pagos no ano (regime de caixa: data de pagamento ou, sem ela, mês de referência) por CNPJ da empresa, com os campos da ficha "Rendimentos tributáveis recebidos de pessoa jurídica" da DIRPF:
+// @Description rendimentos tributáveis, contribuição previdenciária oficial, imposto retido na fonte, 13º salário (líquido do INSS sobre ele) e IRRF sobre o 13º.
+// @Description Também informa a participação nos lucros, de tributação exclusiva, e os rendimentos isentos. As linhas são classificadas pela rubrica ou, quando não houver, pela descrição.
+// @Description Holerites em moeda estrangeira são convertidos para reais pela PTAX de compra da data de pagamento ou, sem ela, do último dia do mês de referência.
+// @Description Se o ano não for informado, considera o ano anterior, que é o ano-calendário da declaração em curso.
+//
+// @Tags    Relatórios
+// @Accept  json
+// @Produce json,text/csv
+//
+// @Param ano     query string false "Ano-calendário"
+// @Param formato query string false "Formato do informe. Aceita apenas os valores 'json' e 'csv'" Enums(json, csv)
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /relatorios/rendimentos [get]
+func (h *relatorioHandler) Rendimentos(c *fiber.Ctx) error {
+	ano := time.Now().Year() - 1
+
+	if valor := c.Query("ano", ""); valor != "" {
+		var err error
+
+		if ano, err = strconv.Atoi(valor); err != nil || ano < 1000 || ano > 9999 {
+			return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+				Message: ERROR_RENDIMENTOS,
+				Errors:  []string{ERROR_ANO},
+			})
+		}
+	}
+
+	formato := c.Query("formato", "json")
+	if formato != "json" && formato != "csv" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_RENDIMENTOS,
+			Errors:  []string{ERROR_FORMATO},
+		})
+	}
+
+	result, err := h.Service.Rendimentos(c.UserContext(), ano)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_RENDIMENTOS,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if formato == "csv" {
+		conteudo, err := relatorio.RendimentosCSV(result)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+				Message: ERROR_RENDIMENTOS,
+				Errors:  []string{err.Error()},
+			})
+		}
+
+		c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+		c.Attachment(fmt.Sprintf("informe-rendimentos-%d.csv", ano))
+
+		return c.Status(fiber.StatusOK).Send(conteudo)
+	}
+
+	if len(result.Fontes) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: RENDIMENTOS_EMPTY,
+			Data:    result,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result.Fontes),
+		Message: RENDIMENTOS_SUCCESS,
+		Data:    result,
+	})
+}
@@ -0,0 +1,182 @@
+package remuneracao
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/remuneracao"
+)
+
+type RemuneracaoHandler interface {
+	Create(c *fiber.Ctx) error
+	FindByEmprego(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+}
+
+type remuneracaoHandler struct {
+	Service remuneracao.Service
+}
+
+var (
+	ERROR_CREATE     = "Falha ao registrar a remuneração informada."
+	ERROR_FIND_ALL   = "Falha ao consultar o histórico salarial."
+	ERROR_DELETE     = "Falha ao apagar a remuneração informada."
+	ERROR_ID_EMPREGO = "ID do emprego inválido ou não informado."
+
+	CREATE_SUCCESS   = "Remuneração registrada com sucesso."
+	FIND_ALL_SUCCESS = "Consulta realizada com sucesso."
+	DELETE_SUCCESS   = "Remuneração apagada com sucesso."
+
+	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
+)
+
+func NewHandler(service remuneracao.Service) RemuneracaoHandler {
+	return &remuneracaoHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Registra uma remuneração no histórico salarial do emprego
+// @Description Registra a remuneração recebida a partir da data informada. Sem a ocupação informada, a remuneração é vinculada à ocupação vigente no emprego na data.
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id          path string true  "ID do emprego"
+// @Param remuneracao body number true  "Valor da remuneração"
+// @Param moeda       body string false "Moeda da remuneração (ISO 4217), por padrão a do emprego"
+// @Param data        body string true  "Data a partir da qual a remuneração vigora"
+// @Param id_ocupacao body int    false "ID da ocupação do catálogo"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/remuneracoes [post]
+func (h *remuneracaoHandler) Create(c *fiber.Ctx) error {
+	idEmprego, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	remuneracao := models.Remuneracao{}
+
+	c.BodyParser(&remuneracao)
+
+	remuneracao.IDEmprego = idEmprego
+
+	if err := remuneracao.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	remuneracao.Criado = time.Now()
+
+	remuneracao, err = h.Service.Create(c.UserContext(), remuneracao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    remuneracao,
+	})
+}
+
+// FindByEmprego godoc
+// @Summary     Retorna o histórico salarial de um emprego
+// @Description Retorna as remunerações do emprego ordenadas por data, com a ocupação de cada uma
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "ID do emprego"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/remuneracoes [get]
+func (h *remuneracaoHandler) FindByEmprego(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{ERROR_ID_EMPREGO},
+		})
+	}
+
+	result, err := h.Service.FindByEmprego(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga uma remuneração
+// @Description Realiza um soft-delete de uma remuneração do histórico salarial do emprego
+//
+// @Tags    Emprego
+// @Accept  json
+// @Produce json
+//
+// @Param id             path string true "ID do emprego"
+// @Param id_remuneracao path string true "O ID da remuneração a ser apagada"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /emprego/{id}/remuneracoes/{id_remuneracao} [delete]
+func (h *remuneracaoHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	idRemuneracao := c.Params("id_remuneracao", "")
+	if id == "" || idRemuneracao == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id, idRemuneracao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
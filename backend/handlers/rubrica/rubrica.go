@@ -0,0 +1,328 @@
+package rubrica
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/rubrica"
+)
+
+type RubricaHandler interface {
+	Create(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	ImportarPadrao(c *fiber.Ctx) error
+}
+
+type rubricaHandler struct {
+	Service rubrica.Service
+}
+
+var (
+	ERROR_CREATE          = "Falha ao criar a rubrica informada."
+	ERROR_FIND_ALL        = "Falha ao consultar rubricas."
+	ERROR_FIND_BY         = "Falha ao consultar rubrica por ID."
+	ERROR_UPDATE          = "Falha ao atualizar rubrica."
+	ERROR_DELETE          = "Falha ao apagar a rubrica informada."
+	ERROR_IMPORTAR_PADRAO = "Falha ao importar o catálogo padrão de rubricas."
+
+	CREATE_SUCCESS          = "Rubrica criada com sucesso."
+	FIND_ALL_SUCCESS        = "Consulta realizada com sucesso."
+	FIND_BY_SUCCESS         = "Consulta realizada com sucesso."
+	UPDATE_SUCCESS          = "Rubrica atualizada com sucesso."
+	DELETE_SUCCESS          = "Rubrica apagada com sucesso."
+	IMPORTAR_PADRAO_SUCCESS = "Catálogo padrão de rubricas importado com sucesso."
+
+	FIND_BY_RESULT_EMPTY  = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
+)
+
+func NewHandler(service rubrica.Service) RubricaHandler {
+	return &rubricaHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Cadastra uma nova rubrica
+// @Description Cadastra uma rubrica no catálogo de verbas do holerite, com as incidências de INSS, IRRF e FGTS.
+// @Description Em rubricas de débito, as incidências indicam que o valor é deduzido da base, como em faltas.
+//
+// @Tags    Rubrica
+// @Accept  json
+// @Produce json
+//
+// @Param codigo      body string true  "Código único da rubrica"
+// @Param nome        body string true  "Nome da rubrica"
+// @Param tipo        body string true  "Tipo das linhas classificadas na rubrica" Enums(credito, debito)
+// @Param categoria   body string true  "Categoria da rubrica" Enums(salario, hora_extra, adicional, comissao, ferias, decimo_terceiro, participacao_lucros, beneficio, reembolso, adiantamento, falta, inss, inss_decimo_terceiro, irrf, irrf_decimo_terceiro, irrf_participacao_lucros, vale_transporte, vale_refeicao, plano_saude, pensao_alimenticia, outros)
+// @Param incide_inss body bool   false "Compõe a base de cálculo do INSS"
+// @Param incide_irrf body bool   false "Compõe a base de cálculo do IRRF"
+// @Param incide_fgts body bool   false "Compõe a base de cálculo do FGTS"
+// @Param termos      body string false "Palavras-chave para a classificação pela descrição, separadas por ponto e vírgula"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /rubricas [post]
+func (h *rubricaHandler) Create(c *fiber.Ctx) error {
+	rubrica := models.Rubrica{}
+
+	c.BodyParser(&rubrica)
+
+	if err := rubrica.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	rubrica.Criado = time.Now()
+
+	rubrica, err := h.Service.Create(c.UserContext(), rubrica)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    rubrica,
+	})
+}
+
+// FindAll godoc
+// @Summary     Retorna as rubricas
+// @Description Retorna as rubricas do catálogo que atendam aos critérios informados, ordenadas por tipo e nome
+//
+// @Tags    Rubrica
+// @Accept  json
+// @Produce json
+//
+// @Param search    query string false "Campo aberto para pesquisa no código, no nome e nos termos"
+// @Param tipo      query string false "Tipo da rubrica" Enums(credito, debito)
+// @Param categoria query string false "Categoria da rubrica"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /rubricas [get]
+func (h *rubricaHandler) FindAll(c *fiber.Ctx) error {
+	search := c.Query("search", "")
+	tipo := c.Query("tipo", "")
+	categoria := c.Query("categoria", "")
+
+	result, err := h.Service.FindAll(c.UserContext(), search, tipo, categoria)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Consulta uma rubrica por ID
+// @Description Retorna as informações de uma rubrica de acordo com seu ID
+//
+// @Tags    Rubrica
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "O ID da rubrica para retornar"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /rubricas/{id} [get]
+func (h *rubricaHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Update godoc
+// @Summary     Atualiza uma rubrica
+// @Description Atualiza um registro de rubrica de acordo com o ID e as informações informadas. As linhas já classificadas mantêm a rubrica.
+//
+// @Tags    Rubrica
+// @Accept  json
+// @Produce json
+//
+// @Param id          path string true  "O ID da rubrica a ser atualizada"
+// @Param codigo      body string false "Código único da rubrica"
+// @Param nome        body string false "Nome da rubrica"
+// @Param tipo        body string false "Tipo das linhas classificadas na rubrica" Enums(credito, debito)
+// @Param categoria   body string false "Categoria da rubrica"
+// @Param incide_inss body bool   false "Compõe a base de cálculo do INSS"
+// @Param incide_irrf body bool   false "Compõe a base de cálculo do IRRF"
+// @Param incide_fgts body bool   false "Compõe a base de cálculo do FGTS"
+// @Param termos      body string false "Palavras-chave para a classificação pela descrição, separadas por ponto e vírgula"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /rubricas/{id} [put]
+func (h *rubricaHandler) Update(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	rubrica, err := h.Service.FindByID(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if rubrica.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_RESULT_EMPTY,
+		})
+	}
+
+	c.BodyParser(&rubrica)
+
+	if err := rubrica.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	now := time.Now()
+	rubrica.Atualizado = &now
+
+	err = h.Service.Update(c.UserContext(), rubrica)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: UPDATE_SUCCESS,
+		Data:    rubrica,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga uma rubrica
+// @Description Realiza um soft-delete de uma rubrica com base no ID informado
+//
+// @Tags    Rubrica
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "O ID da rubrica a ser apagada"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /rubricas/{id} [delete]
+func (h *rubricaHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
+
+// ImportarPadrao godoc
+// @Summary     Importa o catálogo padrão de rubricas
+// @Description Cadastra as rubricas usuais (salário base, horas extras, férias, 13º salário, INSS, IRRF, vale-transporte, vale-refeição, plano de saúde, adiantamento, entre outras) com as incidências e os termos de classificação.
+// @Description Rubricas com código já cadastrado não são alteradas.
+//
+// @Tags    Rubrica
+// @Accept  json
+// @Produce json
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /rubricas/padrao [post]
+func (h *rubricaHandler) ImportarPadrao(c *fiber.Ctx) error {
+	result, err := h.Service.ImportarPadrao(c.UserContext())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_IMPORTAR_PADRAO,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   result.Importadas,
+		Message: IMPORTAR_PADRAO_SUCCESS,
+		Data:    result,
+	})
+}
@@ -0,0 +1,74 @@
+package simulacao
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/simulacao"
+)
+
+type SimulacaoHandler interface {
+	CompararOfertas(c *fiber.Ctx) error
+}
+
+type simulacaoHandler struct {
+	Service simulacao.Service
+}
+
+var (
+	ERROR_COMPARAR_OFERTAS = "Falha ao comparar as ofertas informadas."
+
+	COMPARAR_OFERTAS_SUCCESS = "Comparação realizada com sucesso."
+)
+
+func NewHandler(service simulacao.Service) SimulacaoHandler {
+	return &simulacaoHandler{
+		Service: service,
+	}
+}
+
+// CompararOfertas godoc
+// @Summary     Compara ofertas de trabalho CLT e PJ
+// @Description Calcula a renda líquida anual de cada oferta com as tabelas de tributos vigentes. No regime CLT, considera o 13º salário, as férias com o terço constitucional, o FGTS depositado, o INSS e o IRRF.
+// @Description No regime PJ, o salário é o faturamento mensal, tributado pelo Simples Nacional no anexo definido pelo Fator R, com o INSS e o IRRF sobre o pró-labore e os custos da empresa. Os benefícios mensais entram nas duas modalidades.
+// @Description Com o emprego atual informado, cada oferta traz a diferença em relação ao líquido anual da compensação vigente dele. Salários em moeda estrangeira são convertidos pela PTAX do dia; sem cotação importada, a comparação é recusada.
+//
+// @Tags    Simulação
+// @Accept  json
+// @Produce json
+//
+// @Param id_emprego  body integer        false "ID do emprego atual"
+// @Param dependentes body integer        false "Quantidade de dependentes para o IRRF"
+// @Param ofertas     body []models.Oferta true  "Ofertas a comparar"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /simulacoes/comparar-ofertas [post]
+func (h *simulacaoHandler) CompararOfertas(c *fiber.Ctx) error {
+	comparacao := models.ComparacaoOfertas{}
+
+	c.BodyParser(&comparacao)
+
+	if err := comparacao.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_COMPARAR_OFERTAS,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	result, err := h.Service.CompararOfertas(c.UserContext(), comparacao)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_COMPARAR_OFERTAS,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result.Ofertas),
+		Message: COMPARAR_OFERTAS_SUCCESS,
+		Data:    result,
+	})
+}
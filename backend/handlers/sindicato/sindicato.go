@@ -0,0 +1,280 @@
+package sindicato
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/sindicato"
+)
+
+type SindicatoHandler interface {
+	Create(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+}
+
+type sindicatoHandler struct {
+	Service sindicato.Service
+}
+
+var (
+	ERROR_CREATE   = "Falha ao criar o sindicato informado."
+	ERROR_FIND_ALL = "Falha ao consultar sindicatos."
+	ERROR_FIND_BY  = "Falha ao consultar sindicato por ID."
+	ERROR_UPDATE   = "Falha ao atualizar sindicato."
+	ERROR_DELETE   = "Falha ao apagar o sindicato informado."
+
+	CREATE_SUCCESS   = "Sindicato criado com sucesso."
+	FIND_ALL_SUCCESS = "Consulta realizada com sucesso."
+	FIND_BY_SUCCESS  = "Consulta realizada com sucesso."
+	UPDATE_SUCCESS   = "Sindicato atualizado com sucesso."
+	DELETE_SUCCESS   = "Sindicato apagado com sucesso."
+
+	FIND_BY_RESULT_EMPTY  = "Nenhum resultado encontrado para os parâmetros informados."
+	FIND_ALL_RESULT_EMPTY = "Nenhum resultado encontrado para os parâmetros informados."
+)
+
+func NewHandler(service sindicato.Service) SindicatoHandler {
+	return &sindicatoHandler{
+		Service: service,
+	}
+}
+
+// Create godoc
+// @Summary     Cadastra um novo sindicato
+// @Description Cadastra o sindicato de uma categoria com o mês da data-base, em que os salários são reajustados pela convenção coletiva
+//
+// @Tags    Sindicato
+// @Accept  json
+// @Produce json
+//
+// @Param nome          body string  true  "Nome do sindicato"
+// @Param cnpj          body string  false "CNPJ do sindicato"
+// @Param mes_data_base body integer true  "Mês da data-base, de 1 a 12"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /sindicatos [post]
+func (h *sindicatoHandler) Create(c *fiber.Ctx) error {
+	sindicato := models.Sindicato{}
+
+	c.BodyParser(&sindicato)
+
+	if err := sindicato.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	sindicato.Criado = time.Now()
+
+	sindicato, err := h.Service.Create(c.UserContext(), sindicato)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_CREATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: CREATE_SUCCESS,
+		Data:    sindicato,
+	})
+}
+
+// FindAll godoc
+// @Summary     Retorna os sindicatos
+// @Description Retorna os sindicatos que atendam aos critérios informados, ordenados por nome
+//
+// @Tags    Sindicato
+// @Accept  json
+// @Produce json
+//
+// @Param search query string false "Campo aberto para pesquisa no nome e no CNPJ"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /sindicatos [get]
+func (h *sindicatoHandler) FindAll(c *fiber.Ctx) error {
+	search := c.Query("search", "")
+
+	result, err := h.Service.FindAll(c.UserContext(), search)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_ALL,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if len(result) == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_ALL_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   len(result),
+		Message: FIND_ALL_SUCCESS,
+		Data:    result,
+	})
+}
+
+// FindByID godoc
+// @Summary     Consulta um sindicato por ID
+// @Description Retorna as informações de um sindicato de acordo com seu ID
+//
+// @Tags    Sindicato
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "O ID do sindicato para retornar"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /sindicatos/{id} [get]
+func (h *sindicatoHandler) FindByID(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	result, err := h.Service.FindByID(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_FIND_BY,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if result.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_RESULT_EMPTY,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: FIND_BY_SUCCESS,
+		Data:    result,
+	})
+}
+
+// Update godoc
+// @Summary     Atualiza um sindicato
+// @Description Atualiza um registro de sindicato de acordo com o ID e as informações informadas
+//
+// @Tags    Sindicato
+// @Accept  json
+// @Produce json
+//
+// @Param id            path string  true  "O ID do sindicato a ser atualizado"
+// @Param nome          body string  false "Nome do sindicato"
+// @Param cnpj          body string  false "CNPJ do sindicato"
+// @Param mes_data_base body integer false "Mês da data-base, de 1 a 12"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /sindicatos/{id} [put]
+func (h *sindicatoHandler) Update(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	sindicato, err := h.Service.FindByID(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	if sindicato.ID == 0 {
+		return c.Status(fiber.StatusOK).JSON(models.Response{
+			Message: FIND_BY_RESULT_EMPTY,
+		})
+	}
+
+	c.BodyParser(&sindicato)
+
+	if err := sindicato.Validate(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	now := time.Now()
+	sindicato.Atualizado = &now
+
+	err = h.Service.Update(c.UserContext(), sindicato)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_UPDATE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Count:   1,
+		Message: UPDATE_SUCCESS,
+		Data:    sindicato,
+	})
+}
+
+// Delete godoc
+// @Summary     Apaga um sindicato
+// @Description Realiza um soft-delete de um sindicato com base no ID informado
+//
+// @Tags    Sindicato
+// @Accept  json
+// @Produce json
+//
+// @Param id path string true "O ID do sindicato a ser apagado"
+//
+// @Success 200 {object} models.Response
+// @Failure 400 {object} models.Response
+// @Failure 500 {object} models.Response
+//
+// @Router /sindicatos/{id} [delete]
+func (h *sindicatoHandler) Delete(c *fiber.Ctx) error {
+	id := c.Params("id", "")
+	if id == "" {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{"Nenhum ID informado."},
+		})
+	}
+
+	err := h.Service.Delete(c.UserContext(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.Response{
+			Message: ERROR_DELETE,
+			Errors:  []string{err.Error()},
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.Response{
+		Message: DELETE_SUCCESS,
+	})
+}
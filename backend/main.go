@@ -9,6 +9,7 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/recover"
 
 	"tsukuyomi/config"
+	"tsukuyomi/models"
 	"tsukuyomi/routers"
 )
 
@@ -22,6 +23,10 @@ func main() {
 	config := config.Load()
 	log.Debug("Config loaded")
 
+	if config.App.DinheiroJSON != "" {
+		models.DinheiroJSON = config.App.DinheiroJSON
+	}
+
 	app := fiber.New(fiber.Config{DisableStartupMessage: true})
 	app.Use(recover.New())
 	app.Use(cors.New())
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+const (
+	AUSENCIA_FALTA_INJUSTIFICADA = "falta_injustificada"
+	AUSENCIA_ATESTADO_MEDICO     = "atestado_medico"
+	AUSENCIA_LICENCA_MATERNIDADE = "licenca_maternidade"
+	AUSENCIA_LICENCA_PATERNIDADE = "licenca_paternidade"
+	AUSENCIA_LUTO                = "luto"
+	AUSENCIA_CASAMENTO           = "casamento"
+	AUSENCIA_DOACAO_SANGUE       = "doacao_sangue"
+	AUSENCIA_FERIAS              = "ferias"
+)
+
+var TIPOS_AUSENCIA = map[string]string{
+	AUSENCIA_FALTA_INJUSTIFICADA: "Falta injustificada",
+	AUSENCIA_ATESTADO_MEDICO:     "Atestado médico",
+	AUSENCIA_LICENCA_MATERNIDADE: "Licença maternidade",
+	AUSENCIA_LICENCA_PATERNIDADE: "Licença paternidade",
+	AUSENCIA_LUTO:                "Luto",
+	AUSENCIA_CASAMENTO:           "Casamento",
+	AUSENCIA_DOACAO_SANGUE:       "Doação de sangue",
+	AUSENCIA_FERIAS:              "Férias",
+}
+
+type Ausencia struct {
+	ID         int64           `json:"id"`
+	IDEmprego  int64           `json:"id_emprego"`
+	Tipo       string          `json:"tipo"`
+	DataInicio time.Time       `json:"data_inicio"`
+	DataFim    time.Time       `json:"data_fim"`
+	Observacao *string         `json:"observacao"`
+	Anexos     []AusenciaAnexo `json:"anexos"`
+	Criado     time.Time       `json:"criado"`
+	Atualizado *time.Time      `json:"atualizado"`
+	Apagado    *time.Time      `json:"apagado"`
+}
+
+type AusenciaAnexo struct {
+	ID           int64     `json:"id"`
+	IDAusencia   int64     `json:"id_ausencia"`
+	Nome         string    `json:"nome"`
+	TipoConteudo string    `json:"tipo_conteudo"`
+	Tamanho      int64     `json:"tamanho"`
+	Conteudo     []byte    `json:"-"`
+	Criado       time.Time `json:"criado"`
+}
+
+func (a Ausencia) Validate() error {
+	return validation.ValidateStruct(
+		&a,
+		validation.Field(&a.IDEmprego, validation.Required),
+		validation.Field(&a.Tipo, validation.Required, validation.By(func(_ interface{}) error {
+			if _, ok := TIPOS_AUSENCIA[a.Tipo]; !ok {
+				return validation.NewError("validation_ausencia_tipo", "tipo de ausência inválido")
+			}
+
+			return nil
+		})),
+		validation.Field(&a.DataInicio, validation.Required),
+		validation.Field(&a.DataFim, validation.Required, validation.Min(a.DataInicio).Error("deve ser igual ou posterior à data de início")),
+	)
+}
+
+// Justificada indica se a ausência é abonada. Faltas injustificadas são
+// descontadas do banco de horas e fazem perder o descanso semanal remunerado.
+func (a Ausencia) Justificada() bool {
+	return a.Tipo != AUSENCIA_FALTA_INJUSTIFICADA
+}
+
+// Abrange indica se o dia está dentro do período da ausência.
+func (a Ausencia) Abrange(dia time.Time) bool {
+	dia = time.Date(dia.Year(), dia.Month(), dia.Day(), 0, 0, 0, 0, time.Local)
+	inicio := time.Date(a.DataInicio.Year(), a.DataInicio.Month(), a.DataInicio.Day(), 0, 0, 0, 0, time.Local)
+	fim := time.Date(a.DataFim.Year(), a.DataFim.Month(), a.DataFim.Day(), 0, 0, 0, 0, time.Local)
+
+	return !dia.Before(inicio) && !dia.After(fim)
+}
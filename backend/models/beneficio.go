@@ -0,0 +1,97 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// Tipos de benefício.
+const (
+	BENEFICIO_VALE_REFEICAO       = "vale_refeicao"
+	BENEFICIO_VALE_ALIMENTACAO    = "vale_alimentacao"
+	BENEFICIO_VALE_TRANSPORTE     = "vale_transporte"
+	BENEFICIO_PLANO_SAUDE         = "plano_saude"
+	BENEFICIO_PLANO_ODONTOLOGICO  = "plano_odontologico"
+	BENEFICIO_AUXILIO_HOME_OFFICE = "auxilio_home_office"
+	BENEFICIO_ACADEMIA            = "academia"
+	BENEFICIO_PLR                 = "plr"
+	BENEFICIO_OUTRO               = "outro"
+)
+
+// Periodicidades dos benefícios. Benefícios diários são pagos por dia útil.
+const (
+	PERIODICIDADE_DIARIA     = "diaria"
+	PERIODICIDADE_MENSAL     = "mensal"
+	PERIODICIDADE_TRIMESTRAL = "trimestral"
+	PERIODICIDADE_SEMESTRAL  = "semestral"
+	PERIODICIDADE_ANUAL      = "anual"
+)
+
+// Beneficio é um benefício concedido no emprego durante a vigência. Valor é o
+// valor concedido pela empresa em cada período e Coparticipacao é a parte
+// descontada do empregado. Na PLR, o alvo pode ser informado em quantidade de
+// salários; nesse caso, o valor acompanha a remuneração vigente.
+type Beneficio struct {
+	ID              int64      `json:"id"`
+	IDEmprego       int64      `json:"id_emprego"`
+	Tipo            string     `json:"tipo"`
+	Descricao       *string    `json:"descricao"`
+	Valor           Dinheiro   `json:"valor"`
+	MultiploSalario *float64   `json:"multiplo_salario"`
+	Coparticipacao  Dinheiro   `json:"coparticipacao"`
+	Periodicidade   string     `json:"periodicidade"`
+	DataInicio      time.Time  `json:"data_inicio"`
+	DataFim         *time.Time `json:"data_fim"`
+	Criado          time.Time  `json:"criado"`
+	Atualizado      *time.Time `json:"atualizado"`
+	Apagado         *time.Time `json:"apagado"`
+}
+
+// CompensacaoTotal soma a remuneração vigente e os benefícios vigentes do
+// emprego, em valores mensais e anuais. Os benefícios são informados pelo
+// valor líquido da coparticipação. O salário em moeda estrangeira é
+// convertido para reais pela PTAX do dia, com o valor original e a cotação
+// usada; sem cotação, ele fica na moeda original e os totais não são somados.
+type CompensacaoTotal struct {
+	Data                time.Time              `json:"data"`
+	Moeda               string                 `json:"moeda"`
+	Salario             Dinheiro               `json:"salario"`
+	SalarioOriginal     *Dinheiro              `json:"salario_original,omitempty"`
+	Conversao           *Conversao             `json:"conversao,omitempty"`
+	SalarioAnual        Dinheiro               `json:"salario_anual"`
+	Beneficios          []BeneficioCompensacao `json:"beneficios"`
+	BeneficiosMensal    Dinheiro               `json:"beneficios_mensal"`
+	BeneficiosAnual     Dinheiro               `json:"beneficios_anual"`
+	CoparticipacaoAnual Dinheiro               `json:"coparticipacao_anual"`
+	TotalMensal         *Dinheiro              `json:"total_mensal,omitempty"`
+	TotalAnual          *Dinheiro              `json:"total_anual,omitempty"`
+}
+
+type BeneficioCompensacao struct {
+	IDBeneficio    int64    `json:"id_beneficio"`
+	Tipo           string   `json:"tipo"`
+	Valor          Dinheiro `json:"valor"`
+	Coparticipacao Dinheiro `json:"coparticipacao"`
+	Mensal         Dinheiro `json:"mensal"`
+	Anual          Dinheiro `json:"anual"`
+}
+
+func (b Beneficio) Validate() error {
+	return validation.ValidateStruct(
+		&b,
+		validation.Field(&b.IDEmprego, validation.Required),
+		validation.Field(&b.Tipo, validation.Required, validation.In(
+			BENEFICIO_VALE_REFEICAO, BENEFICIO_VALE_ALIMENTACAO, BENEFICIO_VALE_TRANSPORTE, BENEFICIO_PLANO_SAUDE,
+			BENEFICIO_PLANO_ODONTOLOGICO, BENEFICIO_AUXILIO_HOME_OFFICE, BENEFICIO_ACADEMIA, BENEFICIO_PLR, BENEFICIO_OUTRO,
+		)),
+		validation.Field(&b.Valor, validation.When(b.MultiploSalario == nil, DinheiroPositivo)),
+		validation.Field(&b.MultiploSalario, validation.NilOrNotEmpty, validation.Min(0.01)),
+		validation.Field(&b.Coparticipacao, DinheiroMin(0)),
+		validation.Field(&b.Periodicidade, validation.Required, validation.In(
+			PERIODICIDADE_DIARIA, PERIODICIDADE_MENSAL, PERIODICIDADE_TRIMESTRAL, PERIODICIDADE_SEMESTRAL, PERIODICIDADE_ANUAL,
+		)),
+		validation.Field(&b.DataInicio, validation.Required),
+		validation.Field(&b.DataFim, validation.When(b.DataFim != nil, validation.Min(b.DataInicio).Error("deve ser igual ou posterior à data de início"))),
+	)
+}
@@ -0,0 +1,31 @@
+package models
+
+// Tipos de resultado da busca global.
+const (
+	BUSCA_EMPRESA  = "empresa"
+	BUSCA_ENDERECO = "endereco"
+	BUSCA_CONTATO  = "contato"
+	BUSCA_EMPREGO  = "emprego"
+)
+
+// ResultadoBusca é um registro encontrado pela busca global. Pontuação é a
+// relevância, de 0 a 1, e Campo é o campo que mais correspondeu à busca.
+type ResultadoBusca struct {
+	Tipo      string  `json:"tipo"`
+	ID        int64   `json:"id"`
+	Titulo    string  `json:"titulo"`
+	Descricao string  `json:"descricao"`
+	Campo     string  `json:"campo"`
+	Pontuacao float64 `json:"pontuacao"`
+}
+
+// Busca traz os resultados da busca global agrupados pelo tipo de registro,
+// cada grupo ordenado pela relevância.
+type Busca struct {
+	Termo     string           `json:"termo"`
+	Empresas  []ResultadoBusca `json:"empresas"`
+	Enderecos []ResultadoBusca `json:"enderecos"`
+	Contatos  []ResultadoBusca `json:"contatos"`
+	Empregos  []ResultadoBusca `json:"empregos"`
+	Total     int              `json:"total"`
+}
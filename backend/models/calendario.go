@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+const (
+	EVENTO_ENTREVISTA = "entrevista"
+	EVENTO_FERIADO    = "feriado"
+	EVENTO_FERIAS     = "ferias"
+)
+
+// EventoCalendario é um evento exportado no calendário. Nos eventos de dia
+// inteiro, Inicio e Fim são o primeiro e o último dia, inclusive.
+type EventoCalendario struct {
+	UID        string    `json:"uid"`
+	Tipo       string    `json:"tipo"`
+	Titulo     string    `json:"titulo"`
+	Descricao  string    `json:"descricao"`
+	Local      string    `json:"local"`
+	Inicio     time.Time `json:"inicio"`
+	Fim        time.Time `json:"fim"`
+	DiaInteiro bool      `json:"dia_inteiro"`
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// Situações da candidatura, na ordem do processo seletivo. Aceito e recusado
+// encerram o processo.
+const (
+	CANDIDATURA_APLICADO   = "aplicado"
+	CANDIDATURA_TRIAGEM    = "triagem"
+	CANDIDATURA_ENTREVISTA = "entrevista"
+	CANDIDATURA_PROPOSTA   = "proposta"
+	CANDIDATURA_ACEITO     = "aceito"
+	CANDIDATURA_RECUSADO   = "recusado"
+)
+
+// SituacoesCandidatura são as situações na ordem do processo seletivo.
+var SituacoesCandidatura = []string{
+	CANDIDATURA_APLICADO,
+	CANDIDATURA_TRIAGEM,
+	CANDIDATURA_ENTREVISTA,
+	CANDIDATURA_PROPOSTA,
+	CANDIDATURA_ACEITO,
+	CANDIDATURA_RECUSADO,
+}
+
+// Candidatura é uma candidatura a uma vaga na empresa. Origem é o canal da
+// vaga, como uma indicação ou um site de vagas. A situação só muda pelas
+// transições, registradas no histórico, e a candidatura aceita pode ser
+// convertida no emprego.
+type Candidatura struct {
+	ID                int64                  `json:"id"`
+	IDEmpresa         int64                  `json:"id_empresa"`
+	Empresa           *Empresa               `json:"empresa,omitempty"`
+	Cargo             string                 `json:"cargo"`
+	Origem            *string                `json:"origem"`
+	PretensaoSalarial *Dinheiro              `json:"pretensao_salarial"`
+	SalarioOferecido  *Dinheiro              `json:"salario_oferecido"`
+	Moeda             string                 `json:"moeda"`
+	TipoContrato      *string                `json:"tipo_contrato"`
+	Situacao          string                 `json:"situacao"`
+	IDEmprego         *int64                 `json:"id_emprego"`
+	Observacao        *string                `json:"observacao"`
+	Historico         []TransicaoCandidatura `json:"historico,omitempty"`
+	Criado            time.Time              `json:"criado"`
+	Atualizado        *time.Time             `json:"atualizado"`
+	Apagado           *time.Time             `json:"apagado"`
+}
+
+// TransicaoCandidatura é uma mudança de situação da candidatura. A primeira
+// transição, no registro da candidatura, não tem situação anterior.
+type TransicaoCandidatura struct {
+	ID               int64     `json:"id"`
+	IDCandidatura    int64     `json:"id_candidatura"`
+	SituacaoAnterior *string   `json:"situacao_anterior"`
+	Situacao         string    `json:"situacao"`
+	Data             time.Time `json:"data"`
+	Observacao       *string   `json:"observacao"`
+}
+
+// ConversaoCandidatura traz os dados do emprego que não constam da
+// candidatura. Sem remuneração inicial, é usado o salário oferecido.
+type ConversaoCandidatura struct {
+	TipoContrato       string    `json:"tipo_contrato"`
+	DataInicio         time.Time `json:"data_inicio"`
+	CargaHoraria       int64     `json:"carga_horaria"`
+	RemuneracaoInicial *Dinheiro `json:"remuneracao_inicial"`
+}
+
+func (c Candidatura) Validate() error {
+	return validation.ValidateStruct(
+		&c,
+		validation.Field(&c.IDEmpresa, validation.Required),
+		validation.Field(&c.Cargo, validation.Required, validation.Length(1, 255)),
+		validation.Field(&c.Origem, validation.NilOrNotEmpty, validation.Length(1, 100)),
+		validation.Field(&c.PretensaoSalarial, DinheiroPositivo),
+		validation.Field(&c.SalarioOferecido, DinheiroPositivo),
+		validation.Field(&c.Moeda, Moeda),
+	)
+}
+
+func (t TransicaoCandidatura) Validate() error {
+	return validation.ValidateStruct(
+		&t,
+		validation.Field(&t.Situacao, validation.Required, validation.In(
+			CANDIDATURA_APLICADO, CANDIDATURA_TRIAGEM, CANDIDATURA_ENTREVISTA, CANDIDATURA_PROPOSTA, CANDIDATURA_ACEITO, CANDIDATURA_RECUSADO,
+		)),
+	)
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	PONTO_ENTRADA = "entrada"
+	PONTO_SAIDA   = "saida"
+)
+
+// CartaoPonto é uma marcação de ponto. IDCorrecao identifica, na apuração, as
+// marcações incluídas ou alteradas por uma correção aprovada.
+type CartaoPonto struct {
+	ID         int64      `json:"id"`
+	IDEmprego  int64      `json:"id_emprego"`
+	Horario    time.Time  `json:"horario"`
+	Tipo       string     `json:"tipo"`
+	Saldo      int64      `json:"saldo"`
+	IDCorrecao *int64     `json:"id_correcao,omitempty"`
+	Criado     time.Time  `json:"criado"`
+	Atualizado *time.Time `json:"atualizado"`
+	Apagado    *time.Time `json:"apagado"`
+}
+
+// ApuracaoDia consolida as marcações de um dia com a jornada prevista. Turnos
+// que atravessam a meia-noite pertencem ao dia da entrada. Marcacoes traz as
+// marcações com as correções aprovadas aplicadas, e Originais as marcações
+// registradas no dia, sem correções.
+type ApuracaoDia struct {
+	Data          time.Time       `json:"data"`
+	Marcacoes     []CartaoPonto   `json:"marcacoes"`
+	Originais     []CartaoPonto   `json:"marcacoes_originais"`
+	Corrigido     bool            `json:"corrigido"`
+	Previsto      JornadaPrevista `json:"previsto"`
+	Trabalhado    int64           `json:"trabalhado"`
+	Intervalo     int64           `json:"intervalo"`
+	Saldo         int64           `json:"saldo"`
+	Entrada       *time.Time      `json:"entrada"`
+	Saida         *time.Time      `json:"saida"`
+	Inconsistente bool            `json:"inconsistente"`
+}
+
+type EspelhoPonto struct {
+	Empresa         Empresa       `json:"empresa"`
+	Emprego         Emprego       `json:"emprego"`
+	Mes             time.Time     `json:"mes"`
+	Dias            []ApuracaoDia `json:"dias"`
+	TotalPrevisto   int64         `json:"total_previsto"`
+	TotalTrabalhado int64         `json:"total_trabalhado"`
+	SaldoMes        int64         `json:"saldo_mes"`
+	SaldoAnterior   int64         `json:"saldo_anterior"`
+	SaldoBancoHoras int64         `json:"saldo_banco_horas"`
+}
+
+const (
+	AFD_IMPORTADA = "importada"
+	AFD_IGNORADA  = "ignorada"
+	AFD_ERRO      = "erro"
+)
+
+type ImportacaoAFD struct {
+	Linhas     int        `json:"linhas"`
+	Importadas int        `json:"importadas"`
+	Ignoradas  int        `json:"ignoradas"`
+	Erros      int        `json:"erros"`
+	Detalhes   []LinhaAFD `json:"detalhes"`
+}
+
+type LinhaAFD struct {
+	Linha    int        `json:"linha"`
+	NSR      string     `json:"nsr"`
+	Tipo     string     `json:"tipo"`
+	Horario  *time.Time `json:"horario,omitempty"`
+	Situacao string     `json:"situacao"`
+	Motivo   string     `json:"motivo,omitempty"`
+}
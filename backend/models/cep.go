@@ -0,0 +1,20 @@
+package models
+
+// CEP é um código postal na base de CEPs importada. Logradouro e bairro ficam
+// vazios nas cidades com CEP único.
+type CEP struct {
+	CEP         string  `json:"cep"`
+	Logradouro  string  `json:"logradouro"`
+	Complemento *string `json:"complemento"`
+	Bairro      string  `json:"bairro"`
+	Cidade      string  `json:"cidade"`
+	Estado      string  `json:"estado"`
+}
+
+// ImportacaoCEP resume a carga do arquivo de CEPs.
+type ImportacaoCEP struct {
+	Linhas     int      `json:"linhas"`
+	Importados int      `json:"importados"`
+	Ignorados  int      `json:"ignorados"`
+	Erros      []string `json:"erros"`
+}
@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+// Situações cadastrais do CNPJ nos dados abertos da Receita Federal.
+var SituacoesCadastraisCNPJ = map[string]string{
+	"01": "nula",
+	"02": "ativa",
+	"03": "suspensa",
+	"04": "inapta",
+	"08": "baixada",
+}
+
+// CNPJEmpresa é a empresa nos dados abertos do CNPJ, identificada pela raiz
+// de oito dígitos comum à matriz e às filiais.
+type CNPJEmpresa struct {
+	CNPJBasico       string   `json:"cnpj_basico"`
+	RazaoSocial      string   `json:"razao_social"`
+	NaturezaJuridica string   `json:"natureza_juridica"`
+	CapitalSocial    Dinheiro `json:"capital_social"`
+	Porte            string   `json:"porte"`
+}
+
+// CNPJEstabelecimento é a matriz ou uma filial nos dados abertos do CNPJ.
+// Município é o código da Receita Federal, diferente do código do IBGE.
+type CNPJEstabelecimento struct {
+	CNPJ                string     `json:"cnpj"`
+	CNPJBasico          string     `json:"cnpj_basico"`
+	Matriz              bool       `json:"matriz"`
+	NomeFantasia        *string    `json:"nome_fantasia"`
+	SituacaoCadastral   string     `json:"situacao_cadastral"`
+	DataInicioAtividade *time.Time `json:"data_inicio_atividade"`
+	CNAEPrincipal       string     `json:"cnae_principal"`
+	TipoLogradouro      string     `json:"tipo_logradouro"`
+	Logradouro          string     `json:"logradouro"`
+	Numero              string     `json:"numero"`
+	Complemento         *string    `json:"complemento"`
+	Bairro              string     `json:"bairro"`
+	CEP                 string     `json:"cep"`
+	UF                  string     `json:"uf"`
+	Municipio           string     `json:"municipio"`
+	Telefone            *string    `json:"telefone"`
+	Email               *string    `json:"email"`
+}
+
+// CNPJMunicipio é um município na tabela de códigos da Receita Federal.
+type CNPJMunicipio struct {
+	Codigo string `json:"codigo"`
+	Nome   string `json:"nome"`
+}
+
+// ConsultaCNPJ é o resultado da consulta de um CNPJ nos dados importados, com
+// o endereço no formato do cadastro. Empresa é a empresa já cadastrada com o
+// CNPJ, quando houver.
+type ConsultaCNPJ struct {
+	CNPJ                string     `json:"cnpj"`
+	RazaoSocial         string     `json:"razao_social"`
+	NomeFantasia        *string    `json:"nome_fantasia"`
+	Matriz              bool       `json:"matriz"`
+	SituacaoCadastral   string     `json:"situacao_cadastral"`
+	DataInicioAtividade *time.Time `json:"data_inicio_atividade"`
+	CNAEPrincipal       string     `json:"cnae_principal"`
+	Telefone            *string    `json:"telefone"`
+	Email               *string    `json:"email"`
+	Endereco            Endereco   `json:"endereco"`
+	Empresa             *Empresa   `json:"empresa"`
+}
+
+// ImportacaoCNPJ resume a carga dos arquivos de dados abertos do CNPJ.
+type ImportacaoCNPJ struct {
+	Arquivos         []string `json:"arquivos"`
+	Empresas         int      `json:"empresas"`
+	Estabelecimentos int      `json:"estabelecimentos"`
+	Municipios       int      `json:"municipios"`
+	Ignorados        int      `json:"ignorados"`
+	Erros            []string `json:"erros"`
+}
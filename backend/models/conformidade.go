@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	REGRA_INTERJORNADA       = "interjornada"
+	REGRA_INTERVALO_ALMOCO   = "intervalo_intrajornada"
+	REGRA_HORAS_EXTRAS       = "horas_extras_diarias"
+	REGRA_DIAS_CONSECUTIVOS  = "dias_consecutivos"
+	UNIDADE_VIOLACAO_MINUTOS = "minutos"
+	UNIDADE_VIOLACAO_DIAS    = "dias"
+)
+
+type Violacao struct {
+	Regra     string `json:"regra"`
+	Descricao string `json:"descricao"`
+	Medido    int64  `json:"medido"`
+	Limite    int64  `json:"limite"`
+	Unidade   string `json:"unidade"`
+}
+
+type ConformidadeDia struct {
+	Data      time.Time  `json:"data"`
+	Violacoes []Violacao `json:"violacoes"`
+}
+
+type RelatorioConformidade struct {
+	IDEmprego int64             `json:"id_emprego"`
+	Inicio    time.Time         `json:"inicio"`
+	Fim       time.Time         `json:"fim"`
+	Violacoes int               `json:"violacoes"`
+	Dias      []ConformidadeDia `json:"dias"`
+}
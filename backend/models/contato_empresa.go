@@ -1,14 +1,40 @@
 package models
 
-import "time"
+import (
+	"time"
 
+	"github.com/invopop/validation"
+)
+
+const (
+	CONTATO_TELEFONE = "telefone"
+	CONTATO_WHATSAPP = "whatsapp"
+	CONTATO_EMAIL    = "email"
+	CONTATO_SITE     = "site"
+	CONTATO_LINKEDIN = "linkedin"
+)
+
+// ContatoEmpresa é um contato da empresa. Quando pertence a uma pessoa da
+// empresa, como o e-mail de um recrutador, IDPessoa a identifica; sem ela, é
+// um contato geral, como a central telefônica.
 type ContatoEmpresa struct {
 	ID         int64      `json:"id"`
 	IDEmpresa  int64      `json:"id_empresa,omitempty"`
 	Empresa    Empresa    `json:"empresa,omitempty"`
+	IDPessoa   *int64     `json:"id_pessoa"`
+	Pessoa     *Pessoa    `json:"pessoa,omitempty"`
 	Tipo       string     `json:"tipo"`
 	Contato    string     `json:"contato"`
 	Criado     time.Time  `json:"criado"`
 	Atualizado *time.Time `json:"atualizado"`
 	Apagado    *time.Time `json:"apagado"`
 }
+
+func (c ContatoEmpresa) Validate() error {
+	return validation.ValidateStruct(
+		&c,
+		validation.Field(&c.IDEmpresa, validation.Required),
+		validation.Field(&c.Tipo, validation.Required, validation.In(CONTATO_TELEFONE, CONTATO_WHATSAPP, CONTATO_EMAIL, CONTATO_SITE, CONTATO_LINKEDIN)),
+		validation.Field(&c.Contato, validation.Required, validation.Length(1, 255)),
+	)
+}
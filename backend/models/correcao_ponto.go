@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+const (
+	CORRECAO_INCLUIR = "incluir"
+	CORRECAO_ALTERAR = "alterar"
+	CORRECAO_REMOVER = "remover"
+
+	CORRECAO_PENDENTE  = "pendente"
+	CORRECAO_APROVADA  = "aprovada"
+	CORRECAO_REJEITADA = "rejeitada"
+)
+
+// CorrecaoPonto é uma solicitação de ajuste no cartão de ponto. A marcação
+// original nunca é alterada: correções aprovadas são aplicadas sobre as
+// marcações na apuração, e o espelho mostra os dois registros.
+type CorrecaoPonto struct {
+	ID            int64        `json:"id"`
+	IDEmprego     int64        `json:"id_emprego"`
+	IDCartaoPonto *int64       `json:"id_cartao_ponto"`
+	Acao          string       `json:"acao"`
+	Horario       *time.Time   `json:"horario"`
+	Tipo          *string      `json:"tipo"`
+	Justificativa string       `json:"justificativa"`
+	Situacao      string       `json:"situacao"`
+	MotivoDecisao *string      `json:"motivo_decisao"`
+	Decidido      *time.Time   `json:"decidido"`
+	Original      *CartaoPonto `json:"original,omitempty"`
+	Criado        time.Time    `json:"criado"`
+	Atualizado    *time.Time   `json:"atualizado"`
+	Apagado       *time.Time   `json:"apagado"`
+}
+
+// DecisaoCorrecao aprova ou rejeita uma correção pendente.
+type DecisaoCorrecao struct {
+	Situacao string  `json:"situacao"`
+	Motivo   *string `json:"motivo"`
+}
+
+func (c CorrecaoPonto) Validate() error {
+	return validation.ValidateStruct(
+		&c,
+		validation.Field(&c.IDEmprego, validation.Required),
+		validation.Field(&c.Acao, validation.Required, validation.In(CORRECAO_INCLUIR, CORRECAO_ALTERAR, CORRECAO_REMOVER)),
+		validation.Field(
+			&c.IDCartaoPonto,
+			validation.When(c.Acao == CORRECAO_INCLUIR, validation.Nil.Error("não deve ser informado ao incluir uma marcação")).
+				Else(validation.Required.Error("é obrigatório ao alterar ou remover uma marcação")),
+		),
+		validation.Field(
+			&c.Horario,
+			validation.When(c.Acao == CORRECAO_REMOVER, validation.Nil.Error("não deve ser informado ao remover uma marcação")).
+				Else(validation.Required.Error("é obrigatório ao incluir ou alterar uma marcação")),
+		),
+		validation.Field(
+			&c.Tipo,
+			validation.When(c.Acao == CORRECAO_INCLUIR, validation.Required.Error("é obrigatório ao incluir uma marcação")),
+			validation.When(c.Acao == CORRECAO_REMOVER, validation.Nil.Error("não deve ser informado ao remover uma marcação")),
+			validation.When(c.Tipo != nil, validation.In(PONTO_ENTRADA, PONTO_SAIDA)),
+		),
+		validation.Field(&c.Justificativa, validation.Required, validation.Length(10, 0)),
+	)
+}
+
+func (d DecisaoCorrecao) Validate() error {
+	return validation.ValidateStruct(
+		&d,
+		validation.Field(&d.Situacao, validation.Required, validation.In(CORRECAO_APROVADA, CORRECAO_REJEITADA)),
+		validation.Field(&d.Motivo, validation.When(d.Situacao == CORRECAO_REJEITADA, validation.Required.Error("é obrigatório ao rejeitar uma correção"))),
+	)
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// MOEDA_BRL é a moeda padrão de empregos, remunerações e holerites.
+const MOEDA_BRL = "BRL"
+
+// Moeda valida o código de moeda de três letras da ISO 4217, como USD e EUR.
+var Moeda = validation.Match(regexp.MustCompile(`^[A-Z]{3}$`)).Error("deve ser o código da moeda com três letras maiúsculas, como BRL, USD ou EUR")
+
+// Cotacao é a taxa PTAX de fechamento da moeda no dia, em reais por unidade
+// da moeda, publicada pelo Banco Central.
+type Cotacao struct {
+	ID         int64      `json:"id"`
+	Moeda      string     `json:"moeda"`
+	Data       time.Time  `json:"data"`
+	Compra     float64    `json:"compra"`
+	Venda      float64    `json:"venda"`
+	Criado     time.Time  `json:"criado"`
+	Atualizado *time.Time `json:"atualizado"`
+	Apagado    *time.Time `json:"apagado"`
+}
+
+type ImportacaoCotacoes struct {
+	Linhas      int      `json:"linhas"`
+	Importadas  int      `json:"importadas"`
+	Atualizadas int      `json:"atualizadas"`
+	Ignoradas   int      `json:"ignoradas"`
+	Erros       []string `json:"erros"`
+}
+
+// Conversao é a cotação usada para converter para reais um valor em moeda
+// estrangeira na data de pagamento. A cotação é a do próprio dia ou, em dias
+// sem cotação, a do último dia útil anterior.
+type Conversao struct {
+	Moeda   string    `json:"moeda"`
+	Data    time.Time `json:"data"`
+	Cotacao time.Time `json:"cotacao"`
+	Taxa    float64   `json:"taxa"`
+}
+
+func (c Cotacao) Validate() error {
+	return validation.ValidateStruct(
+		&c,
+		validation.Field(&c.Moeda, validation.Required, Moeda),
+		validation.Field(&c.Data, validation.Required),
+		validation.Field(&c.Compra, validation.Required, validation.Min(0.0).Exclusive()),
+		validation.Field(&c.Venda, validation.Required, validation.Min(0.0).Exclusive()),
+	)
+}
@@ -0,0 +1,275 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/invopop/validation"
+)
+
+// ESCALA_DINHEIRO é a quantidade de unidades de Dinheiro em um real. Os
+// valores guardam quatro casas decimais para que os cálculos intermediários,
+// como as faixas do INSS, não acumulem arredondamentos; o arredondamento para
+// centavos é sempre explícito.
+const ESCALA_DINHEIRO = 10000
+
+// Regras de arredondamento para centavos.
+const (
+	// ARREDONDAR_MEIO_ACIMA arredonda a metade para longe do zero, como nos
+	// cálculos da folha de pagamento e dos tributos.
+	ARREDONDAR_MEIO_ACIMA Arredondamento = iota
+	// ARREDONDAR_MEIO_PAR arredonda a metade para o centavo par, o
+	// arredondamento bancário.
+	ARREDONDAR_MEIO_PAR
+	// ARREDONDAR_TRUNCAR descarta as frações de centavo.
+	ARREDONDAR_TRUNCAR
+)
+
+// Formatos do Dinheiro no JSON.
+const (
+	DINHEIRO_JSON_NUMERO = "numero"
+	DINHEIRO_JSON_TEXTO  = "texto"
+)
+
+// DinheiroJSON define se os valores são escritos no JSON como número, como
+// 1234.56, ou como texto, como "1234.56", para clientes que convertem números
+// para ponto flutuante. Na leitura, os dois formatos são aceitos.
+var DinheiroJSON = DINHEIRO_JSON_NUMERO
+
+// DinheiroPositivo valida que o valor é de pelo menos um centavo. Como o
+// Dinheiro implementa driver.Valuer, as regras Required e Min do validation
+// comparam o texto do valor e não servem para ele.
+var DinheiroPositivo = DinheiroMin(Centavos(1))
+
+type Arredondamento int
+
+// Dinheiro é um valor monetário decimal exato, em décimos de milésimo de
+// real. Somas e subtrações usam os operadores da linguagem; multiplicações e
+// divisões são exatas até a quarta casa decimal, arredondada para o par.
+type Dinheiro int64
+
+// NovoDinheiro converte um valor decimal escrito como float64, como 1412.00
+// ou 0.075, pela sua representação decimal mais curta, sem os erros da
+// representação binária.
+func NovoDinheiro(valor float64) Dinheiro {
+	return deRacional(racional(valor))
+}
+
+// Centavos cria um valor a partir da quantidade de centavos.
+func Centavos(centavos int64) Dinheiro {
+	return Dinheiro(centavos * ESCALA_DINHEIRO / 100)
+}
+
+// ParseDinheiro lê um valor decimal com ponto ou vírgula como separador
+// decimal, como "1234.56" ou "1.234,56".
+func ParseDinheiro(valor string) (Dinheiro, error) {
+	valor = strings.TrimSpace(valor)
+
+	if strings.Contains(valor, ",") {
+		valor = strings.ReplaceAll(valor, ".", "")
+		valor = strings.ReplaceAll(valor, ",", ".")
+	}
+
+	r, ok := new(big.Rat).SetString(valor)
+	if !ok || strings.ContainsAny(valor, "/eE") {
+		return 0, fmt.Errorf("valor monetário inválido: %q", valor)
+	}
+
+	return deRacional(r), nil
+}
+
+// DinheiroMin valida que o valor, quando informado, não é menor que o mínimo.
+func DinheiroMin(minimo Dinheiro) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		switch valor := value.(type) {
+		case *Dinheiro:
+			if valor == nil {
+				return nil
+			}
+
+			return DinheiroMin(minimo).Validate(*valor)
+		case Dinheiro:
+			if valor < minimo {
+				return validation.NewError("validation_min_greater_equal_than_required", fmt.Sprintf("must be no less than %s", minimo))
+			}
+		}
+
+		return nil
+	})
+}
+
+// Float64 retorna o valor como ponto flutuante, para cálculos que não são
+// monetários, como percentuais e fatores.
+func (d Dinheiro) Float64() float64 {
+	return float64(d) / ESCALA_DINHEIRO
+}
+
+// Multiplicar retorna o valor multiplicado pelo fator decimal, como uma
+// alíquota de 0.075.
+func (d Dinheiro) Multiplicar(fator float64) Dinheiro {
+	return deRacional(new(big.Rat).Mul(d.racional(), racional(fator)))
+}
+
+// Percentual retorna o percentual do valor, como 4.5 para 4,5%.
+func (d Dinheiro) Percentual(percentual float64) Dinheiro {
+	r := new(big.Rat).Mul(d.racional(), racional(percentual))
+
+	return deRacional(r.Quo(r, big.NewRat(100, 1)))
+}
+
+// Dividir retorna o valor dividido pelo divisor decimal. A divisão por zero
+// resulta em zero.
+func (d Dinheiro) Dividir(divisor float64) Dinheiro {
+	if divisor == 0 {
+		return 0
+	}
+
+	return deRacional(new(big.Rat).Quo(d.racional(), racional(divisor)))
+}
+
+// Razao retorna a razão entre dois valores, como a variação de um salário.
+func (d Dinheiro) Razao(divisor Dinheiro) float64 {
+	if divisor == 0 {
+		return 0
+	}
+
+	return float64(d) / float64(divisor)
+}
+
+// Arredondar arredonda o valor para centavos com a regra informada.
+func (d Dinheiro) Arredondar(regra Arredondamento) Dinheiro {
+	const unidade = ESCALA_DINHEIRO / 100
+
+	centavos := int64(d) / unidade
+	resto := int64(d) % unidade
+
+	sinal := int64(1)
+	if resto < 0 {
+		sinal, resto = -1, -resto
+	}
+
+	switch regra {
+	case ARREDONDAR_MEIO_ACIMA:
+		if resto*2 >= unidade {
+			centavos += sinal
+		}
+	case ARREDONDAR_MEIO_PAR:
+		if resto*2 > unidade || (resto*2 == unidade && centavos%2 != 0) {
+			centavos += sinal
+		}
+	}
+
+	return Dinheiro(centavos * unidade)
+}
+
+// String escreve o valor com duas casas decimais, ou quatro quando houver
+// frações de centavo.
+func (d Dinheiro) String() string {
+	sinal := ""
+	valor := int64(d)
+
+	if valor < 0 {
+		sinal, valor = "-", -valor
+	}
+
+	inteiro := valor / ESCALA_DINHEIRO
+	fracao := valor % ESCALA_DINHEIRO
+
+	if fracao%100 == 0 {
+		return fmt.Sprintf("%s%d.%02d", sinal, inteiro, fracao/100)
+	}
+
+	return fmt.Sprintf("%s%d.%04d", sinal, inteiro, fracao)
+}
+
+func (d Dinheiro) MarshalJSON() ([]byte, error) {
+	if DinheiroJSON == DINHEIRO_JSON_TEXTO {
+		return []byte(strconv.Quote(d.String())), nil
+	}
+
+	return []byte(d.String()), nil
+}
+
+func (d *Dinheiro) UnmarshalJSON(dados []byte) error {
+	valor := string(dados)
+
+	if valor == "null" {
+		return nil
+	}
+
+	if texto, err := strconv.Unquote(valor); err == nil {
+		valor = texto
+	}
+
+	dinheiro, err := ParseDinheiro(valor)
+	if err != nil {
+		return err
+	}
+
+	*d = dinheiro
+
+	return nil
+}
+
+// Scan lê as colunas DECIMAL, que o driver do MySQL entrega como texto.
+func (d *Dinheiro) Scan(src interface{}) error {
+	switch valor := src.(type) {
+	case nil:
+		*d = 0
+	case []byte:
+		return d.Scan(string(valor))
+	case string:
+		dinheiro, err := ParseDinheiro(valor)
+		if err != nil {
+			return err
+		}
+
+		*d = dinheiro
+	case int64:
+		*d = Dinheiro(valor * ESCALA_DINHEIRO)
+	case float64:
+		*d = NovoDinheiro(valor)
+	default:
+		return fmt.Errorf("tipo %T incompatível com Dinheiro", src)
+	}
+
+	return nil
+}
+
+func (d Dinheiro) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+func (d Dinheiro) racional() *big.Rat {
+	return big.NewRat(int64(d), ESCALA_DINHEIRO)
+}
+
+func racional(valor float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(valor, 'f', -1, 64))
+
+	return r
+}
+
+// deRacional converte para Dinheiro arredondando a quarta casa decimal para
+// o par.
+func deRacional(r *big.Rat) Dinheiro {
+	escalado := new(big.Rat).Mul(r, big.NewRat(ESCALA_DINHEIRO, 1))
+
+	quociente, resto := new(big.Int).QuoRem(escalado.Num(), escalado.Denom(), new(big.Int))
+
+	dobro := new(big.Int).Abs(resto)
+	dobro.Lsh(dobro, 1)
+
+	if c := dobro.Cmp(escalado.Denom()); c > 0 || (c == 0 && quociente.Bit(0) == 1) {
+		if resto.Sign() < 0 {
+			quociente.Sub(quociente, big.NewInt(1))
+		} else {
+			quociente.Add(quociente, big.NewInt(1))
+		}
+	}
+
+	return Dinheiro(quociente.Int64())
+}
@@ -0,0 +1,136 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseDinheiro(t *testing.T) {
+	casos := []struct {
+		valor    string
+		esperado Dinheiro
+		erro     bool
+	}{
+		{"1234.56", 12345600, false},
+		{"1.234,56", 12345600, false},
+		{" 0,5 ", 5000, false},
+		{"-10.01", -100100, false},
+		{"0.00005", 0, false},
+		{"0.00015", 2, false},
+		{"1412", 14120000, false},
+		{"1e3", 0, true},
+		{"1/2", 0, true},
+		{"abc", 0, true},
+		{"", 0, true},
+	}
+
+	for _, caso := range casos {
+		obtido, err := ParseDinheiro(caso.valor)
+		if (err != nil) != caso.erro {
+			t.Errorf("ParseDinheiro(%q) retornou erro %v", caso.valor, err)
+			continue
+		}
+
+		if obtido != caso.esperado {
+			t.Errorf("ParseDinheiro(%q) = %d, esperado %d", caso.valor, obtido, caso.esperado)
+		}
+	}
+}
+
+func TestDinheiroArredondar(t *testing.T) {
+	casos := []struct {
+		valor    Dinheiro
+		regra    Arredondamento
+		esperado Dinheiro
+	}{
+		{12350, ARREDONDAR_MEIO_ACIMA, 12400},
+		{12250, ARREDONDAR_MEIO_ACIMA, 12300},
+		{12349, ARREDONDAR_MEIO_ACIMA, 12300},
+		{-12350, ARREDONDAR_MEIO_ACIMA, -12400},
+		{12350, ARREDONDAR_MEIO_PAR, 12400},
+		{12250, ARREDONDAR_MEIO_PAR, 12200},
+		{12251, ARREDONDAR_MEIO_PAR, 12300},
+		{-12250, ARREDONDAR_MEIO_PAR, -12200},
+		{12399, ARREDONDAR_TRUNCAR, 12300},
+		{-12399, ARREDONDAR_TRUNCAR, -12300},
+	}
+
+	for _, caso := range casos {
+		if obtido := caso.valor.Arredondar(caso.regra); obtido != caso.esperado {
+			t.Errorf("Dinheiro(%d).Arredondar(%d) = %d, esperado %d", caso.valor, caso.regra, obtido, caso.esperado)
+		}
+	}
+}
+
+func TestDinheiroOperacoes(t *testing.T) {
+	casos := []struct {
+		nome     string
+		obtido   Dinheiro
+		esperado string
+	}{
+		{"NovoDinheiro sem erro binário", NovoDinheiro(0.1) + NovoDinheiro(0.2), "0.30"},
+		{"Centavos", Centavos(199), "1.99"},
+		{"Multiplicar pela alíquota", NovoDinheiro(1412.00).Multiplicar(0.075), "105.90"},
+		{"Multiplicar arredonda a quarta casa para o par", NovoDinheiro(0.03).Multiplicar(0.005), "0.0002"},
+		{"Multiplicar mantém o par na quarta casa", NovoDinheiro(0.01).Multiplicar(0.005), "0.00"},
+		{"Percentual", NovoDinheiro(1000.00).Percentual(4.5), "45.00"},
+		{"Dividir", NovoDinheiro(100.00).Dividir(3), "33.3333"},
+		{"Dividir por zero", NovoDinheiro(100.00).Dividir(0), "0.00"},
+		{"negativo", NovoDinheiro(-0.05), "-0.05"},
+	}
+
+	for _, caso := range casos {
+		if obtido := caso.obtido.String(); obtido != caso.esperado {
+			t.Errorf("%s = %s, esperado %s", caso.nome, obtido, caso.esperado)
+		}
+	}
+}
+
+func TestDinheiroJSON(t *testing.T) {
+	formato := DinheiroJSON
+	defer func() { DinheiroJSON = formato }()
+
+	casos := []struct {
+		formato string
+		valor   Dinheiro
+		json    string
+	}{
+		{DINHEIRO_JSON_NUMERO, NovoDinheiro(1234.56), `1234.56`},
+		{DINHEIRO_JSON_NUMERO, NovoDinheiro(0.0125), `0.0125`},
+		{DINHEIRO_JSON_TEXTO, NovoDinheiro(1234.56), `"1234.56"`},
+		{DINHEIRO_JSON_TEXTO, NovoDinheiro(-7), `"-7.00"`},
+	}
+
+	for _, caso := range casos {
+		DinheiroJSON = caso.formato
+
+		dados, err := json.Marshal(caso.valor)
+		if err != nil || string(dados) != caso.json {
+			t.Errorf("json.Marshal(%s) no formato %s = %s, %v, esperado %s", caso.valor, caso.formato, dados, err, caso.json)
+		}
+
+		var lido Dinheiro
+		if err := json.Unmarshal(dados, &lido); err != nil || lido != caso.valor {
+			t.Errorf("json.Unmarshal(%s) = %s, %v, esperado %s", dados, lido, err, caso.valor)
+		}
+	}
+
+	leituras := []struct {
+		json     string
+		esperado Dinheiro
+		erro     bool
+	}{
+		{`"1.234,56"`, NovoDinheiro(1234.56), false},
+		{`null`, NovoDinheiro(9), false},
+		{`"abc"`, 0, true},
+	}
+
+	for _, leitura := range leituras {
+		lido := NovoDinheiro(9)
+
+		err := json.Unmarshal([]byte(leitura.json), &lido)
+		if (err != nil) != leitura.erro || (!leitura.erro && lido != leitura.esperado) {
+			t.Errorf("json.Unmarshal(%s) = %s, %v, esperado %s", leitura.json, lido, err, leitura.esperado)
+		}
+	}
+}
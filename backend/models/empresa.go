@@ -2,14 +2,42 @@ package models
 
 import (
 	"time"
+
+	"github.com/invopop/validation"
 )
 
+// Empresa é uma empresa cadastrada. Matriz indica, pelo número de ordem do
+// CNPJ, se é a matriz ou uma filial, e Estabelecimentos traz as outras
+// empresas cadastradas com a mesma raiz de CNPJ.
 type Empresa struct {
-	ID         int64       `json:"id"`
-	Nome       string      `json:"nome"`
-	CNPJ       string      `json:"cnpj"`
-	Enderecos  []*Endereco `json:"enderecos,omitempty"`
-	Criado     time.Time   `json:"criado"`
-	Atualizado *time.Time  `json:"atualizado"`
-	Apagado    *time.Time  `json:"apagado"`
+	ID               int64                    `json:"id"`
+	Nome             string                   `json:"nome"`
+	CNPJ             string                   `json:"cnpj"`
+	Matriz           *bool                    `json:"matriz,omitempty"`
+	Enderecos        []*Endereco              `json:"enderecos,omitempty"`
+	Estabelecimentos []EstabelecimentoEmpresa `json:"estabelecimentos,omitempty"`
+	Criado           time.Time                `json:"criado"`
+	Atualizado       *time.Time               `json:"atualizado"`
+	Apagado          *time.Time               `json:"apagado"`
+}
+
+// EstabelecimentoEmpresa é outra empresa cadastrada com a mesma raiz de CNPJ,
+// os oito primeiros dígitos, comuns à matriz e às filiais.
+type EstabelecimentoEmpresa struct {
+	ID     int64  `json:"id"`
+	Nome   string `json:"nome"`
+	CNPJ   string `json:"cnpj"`
+	Matriz bool   `json:"matriz"`
+}
+
+// MesclagemEmpresa traz as empresas duplicadas a serem mescladas em outra.
+type MesclagemEmpresa struct {
+	Duplicadas []int64 `json:"duplicadas"`
+}
+
+func (m MesclagemEmpresa) Validate() error {
+	return validation.ValidateStruct(
+		&m,
+		validation.Field(&m.Duplicadas, validation.Required, validation.Each(validation.Required)),
+	)
 }
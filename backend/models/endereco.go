@@ -2,8 +2,13 @@ package models
 
 import (
 	"time"
+
+	"github.com/invopop/validation"
 )
 
+// Endereco é um endereço cadastrado. Chave é o endereço completo normalizado,
+// único entre os endereços não apagados. Avisos traz as divergências entre o
+// endereço informado e a base de CEPs, quando conferido.
 type Endereco struct {
 	ID          int64      `json:"id"`
 	Logradouro  string     `json:"logradouro"`
@@ -13,8 +18,38 @@ type Endereco struct {
 	Cidade      string     `json:"cidade"`
 	CEP         string     `json:"cep"`
 	Estado      string     `json:"estado"`
+	Chave       string     `json:"-"`
 	Empresas    []*Empresa `json:"empresas"`
+	Avisos      []string   `json:"avisos,omitempty"`
 	Criado      time.Time  `json:"criado"`
 	Atualizado  *time.Time `json:"atualizado"`
 	Apagado     *time.Time `json:"apagado"`
 }
+
+// DuplicidadeEndereco agrupa os endereços com mesmo logradouro, número, cidade
+// e estado depois de normalizados, candidatos a serem mesclados.
+type DuplicidadeEndereco struct {
+	Chave     string     `json:"chave"`
+	Enderecos []Endereco `json:"enderecos"`
+}
+
+// NormalizacaoEnderecos resume a normalização dos endereços cadastrados antes
+// da chave. Colisoes lista os endereços que ficaram sem chave por repetirem a
+// de outro endereço; eles aparecem no relatório de duplicados.
+type NormalizacaoEnderecos struct {
+	Enderecos int      `json:"enderecos"`
+	Chaves    int      `json:"chaves"`
+	Colisoes  []string `json:"colisoes"`
+}
+
+// MesclagemEndereco traz os endereços duplicados a serem mesclados em outro.
+type MesclagemEndereco struct {
+	Duplicados []int64 `json:"duplicados"`
+}
+
+func (m MesclagemEndereco) Validate() error {
+	return validation.ValidateStruct(
+		&m,
+		validation.Field(&m.Duplicados, validation.Required, validation.Each(validation.Required)),
+	)
+}
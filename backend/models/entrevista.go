@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+const (
+	ENTREVISTA_REMOTA     = "remota"
+	ENTREVISTA_PRESENCIAL = "presencial"
+)
+
+const (
+	ENTREVISTA_APROVADO  = "aprovado"
+	ENTREVISTA_REPROVADO = "reprovado"
+)
+
+// Entrevista é uma entrevista do processo seletivo da candidatura. A duração é
+// definida em minutos. Entrevistas presenciais são no endereço informado e as
+// remotas, no link. Os entrevistadores são contatos da empresa da candidatura.
+type Entrevista struct {
+	ID                int64            `json:"id"`
+	IDCandidatura     int64            `json:"id_candidatura"`
+	Candidatura       *Candidatura     `json:"candidatura,omitempty"`
+	DataHora          time.Time        `json:"data_hora"`
+	Duracao           int64            `json:"duracao"`
+	Formato           string           `json:"formato"`
+	IDEndereco        *int64           `json:"id_endereco"`
+	Endereco          *Endereco        `json:"endereco,omitempty"`
+	Link              *string          `json:"link"`
+	IDEntrevistadores []int64          `json:"id_entrevistadores"`
+	Entrevistadores   []ContatoEmpresa `json:"entrevistadores,omitempty"`
+	Anotacoes         *string          `json:"anotacoes"`
+	Resultado         *string          `json:"resultado"`
+	Criado            time.Time        `json:"criado"`
+	Atualizado        *time.Time       `json:"atualizado"`
+	Apagado           *time.Time       `json:"apagado"`
+}
+
+// Fim retorna o horário previsto para o fim da entrevista.
+func (e Entrevista) Fim() time.Time {
+	return e.DataHora.Add(time.Duration(e.Duracao) * time.Minute)
+}
+
+func (e Entrevista) Validate() error {
+	return validation.ValidateStruct(
+		&e,
+		validation.Field(&e.IDCandidatura, validation.Required),
+		validation.Field(&e.DataHora, validation.Required),
+		validation.Field(&e.Duracao, validation.Required, validation.Min(int64(1))),
+		validation.Field(&e.Formato, validation.Required, validation.In(ENTREVISTA_REMOTA, ENTREVISTA_PRESENCIAL)),
+		validation.Field(&e.IDEndereco, validation.Required.When(e.Formato == ENTREVISTA_PRESENCIAL)),
+		validation.Field(&e.Link, validation.NilOrNotEmpty, validation.Length(1, 255)),
+		validation.Field(&e.Resultado, validation.NilOrNotEmpty, validation.In(ENTREVISTA_APROVADO, ENTREVISTA_REPROVADO)),
+	)
+}
@@ -0,0 +1,119 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+const (
+	ESCALA_SEMANAL     = "semanal"
+	ESCALA_REVEZAMENTO = "revezamento"
+)
+
+// Escala define a jornada prevista de um emprego a partir de uma data. Em
+// escalas semanais (5x2, 6x1 ou horários diferentes por dia) a posição de cada
+// dia é o dia da semana, começando em 0 para domingo. Em escalas de
+// revezamento (12x36, 4x2) a posição é o dia do ciclo, contado a partir da
+// data de início.
+type Escala struct {
+	ID         int64       `json:"id"`
+	IDEmprego  int64       `json:"id_emprego"`
+	Tipo       string      `json:"tipo"`
+	Descricao  *string     `json:"descricao"`
+	DataInicio time.Time   `json:"data_inicio"`
+	Intervalo  int64       `json:"intervalo"`
+	Dias       []EscalaDia `json:"dias"`
+	Criado     time.Time   `json:"criado"`
+	Atualizado *time.Time  `json:"atualizado"`
+	Apagado    *time.Time  `json:"apagado"`
+}
+
+type EscalaDia struct {
+	ID      int64 `json:"id,omitempty"`
+	Posicao int   `json:"posicao"`
+	Minutos int64 `json:"minutos"`
+}
+
+// JornadaPrevista é a carga horária esperada em um dia. Ausências justificadas
+// abonam os minutos da escala, e a perda do descanso semanal remunerado por
+// falta injustificada na semana acrescenta a média diária da semana ao domingo.
+type JornadaPrevista struct {
+	Data        time.Time `json:"data"`
+	Minutos     int64     `json:"minutos"`
+	Intervalo   int64     `json:"intervalo"`
+	IDEscala    *int64    `json:"id_escala"`
+	Feriado     *string   `json:"feriado,omitempty"`
+	Ausencia    *string   `json:"ausencia,omitempty"`
+	Abonado     int64     `json:"abonado"`
+	DescontoDSR int64     `json:"desconto_dsr"`
+}
+
+func (e Escala) Validate() error {
+	return validation.ValidateStruct(
+		&e,
+		validation.Field(&e.IDEmprego, validation.Required),
+		validation.Field(&e.Tipo, validation.Required, validation.In(ESCALA_SEMANAL, ESCALA_REVEZAMENTO)),
+		validation.Field(&e.DataInicio, validation.Required),
+		validation.Field(&e.Intervalo, validation.Min(int64(0))),
+		validation.Field(&e.Dias, validation.Required, validation.Length(1, 0), validation.By(e.validarDias)),
+	)
+}
+
+func (e Escala) validarDias(_ interface{}) error {
+	ciclo := e.Ciclo()
+	vistos := make(map[int]bool, len(e.Dias))
+
+	for _, dia := range e.Dias {
+		if dia.Posicao < 0 || dia.Posicao >= ciclo {
+			return validation.NewError("validation_escala_posicao", "a posição de cada dia deve estar entre 0 e o tamanho do ciclo")
+		}
+
+		if dia.Minutos < 0 || dia.Minutos > 24*60 {
+			return validation.NewError("validation_escala_minutos", "os minutos de cada dia devem estar entre 0 e 1440")
+		}
+
+		if vistos[dia.Posicao] {
+			return validation.NewError("validation_escala_repetida", "cada posição deve ser informada apenas uma vez")
+		}
+
+		vistos[dia.Posicao] = true
+	}
+
+	return nil
+}
+
+// Ciclo retorna a quantidade de dias após a qual a escala se repete.
+func (e Escala) Ciclo() int {
+	if e.Tipo == ESCALA_SEMANAL {
+		return 7
+	}
+
+	return len(e.Dias)
+}
+
+// MinutosPrevistos retorna a carga horária prevista pela escala para o dia
+// informado. Posições não informadas em uma escala semanal são folgas.
+func (e Escala) MinutosPrevistos(dia time.Time) int64 {
+	posicao := int(dia.Weekday())
+
+	if e.Tipo == ESCALA_REVEZAMENTO {
+		if len(e.Dias) == 0 {
+			return 0
+		}
+
+		inicio := time.Date(e.DataInicio.Year(), e.DataInicio.Month(), e.DataInicio.Day(), 0, 0, 0, 0, time.UTC)
+		atual := time.Date(dia.Year(), dia.Month(), dia.Day(), 0, 0, 0, 0, time.UTC)
+
+		decorridos := int(atual.Sub(inicio).Hours() / 24)
+		posicao = ((decorridos % len(e.Dias)) + len(e.Dias)) % len(e.Dias)
+	}
+
+	for _, d := range e.Dias {
+		if d.Posicao == posicao {
+			return d.Minutos
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEscalaMinutosPrevistos(t *testing.T) {
+	semanal := Escala{
+		Tipo: ESCALA_SEMANAL,
+		Dias: []EscalaDia{
+			{Posicao: 1, Minutos: 480},
+			{Posicao: 2, Minutos: 480},
+			{Posicao: 3, Minutos: 480},
+			{Posicao: 4, Minutos: 480},
+			{Posicao: 5, Minutos: 480},
+			{Posicao: 6, Minutos: 240},
+		},
+	}
+
+	revezamento := Escala{
+		Tipo:       ESCALA_REVEZAMENTO,
+		DataInicio: time.Date(2026, time.January, 1, 8, 0, 0, 0, time.Local),
+		Dias: []EscalaDia{
+			{Posicao: 0, Minutos: 720},
+			{Posicao: 1, Minutos: 0},
+		},
+	}
+
+	casos := []struct {
+		nome    string
+		escala  Escala
+		dia     time.Time
+		minutos int64
+	}{
+		{"semanal no domingo", semanal, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.Local), 0},
+		{"semanal na segunda", semanal, time.Date(2026, time.March, 2, 0, 0, 0, 0, time.Local), 480},
+		{"semanal no sábado", semanal, time.Date(2026, time.March, 7, 0, 0, 0, 0, time.Local), 240},
+		{"revezamento no início", revezamento, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.Local), 720},
+		{"revezamento na folga", revezamento, time.Date(2026, time.January, 2, 23, 59, 0, 0, time.Local), 0},
+		{"revezamento no ciclo seguinte", revezamento, time.Date(2026, time.January, 3, 0, 0, 0, 0, time.Local), 720},
+		{"revezamento após a virada do mês", revezamento, time.Date(2026, time.February, 2, 0, 0, 0, 0, time.Local), 720},
+		{"revezamento antes do início", revezamento, time.Date(2025, time.December, 31, 0, 0, 0, 0, time.Local), 0},
+		{"revezamento dois dias antes do início", revezamento, time.Date(2025, time.December, 30, 0, 0, 0, 0, time.Local), 720},
+		{"revezamento sem dias", Escala{Tipo: ESCALA_REVEZAMENTO}, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.Local), 0},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			if obtido := caso.escala.MinutosPrevistos(caso.dia); obtido != caso.minutos {
+				t.Errorf("MinutosPrevistos(%s) = %d, esperado %d", caso.dia.Format(time.DateOnly), obtido, caso.minutos)
+			}
+		})
+	}
+}
+
+func TestEscalaValidate(t *testing.T) {
+	base := Escala{
+		IDEmprego:  1,
+		Tipo:       ESCALA_SEMANAL,
+		DataInicio: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.Local),
+	}
+
+	casos := []struct {
+		nome   string
+		dias   []EscalaDia
+		valido bool
+	}{
+		{"dias válidos", []EscalaDia{{Posicao: 1, Minutos: 480}, {Posicao: 2, Minutos: 480}}, true},
+		{"posição fora da semana", []EscalaDia{{Posicao: 7, Minutos: 480}}, false},
+		{"posição repetida", []EscalaDia{{Posicao: 1, Minutos: 480}, {Posicao: 1, Minutos: 240}}, false},
+		{"mais minutos que o dia", []EscalaDia{{Posicao: 1, Minutos: 24*60 + 1}}, false},
+		{"sem dias", nil, false},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			escala := base
+			escala.Dias = caso.dias
+
+			if err := escala.Validate(); (err == nil) != caso.valido {
+				t.Errorf("Validate() = %v, esperado válido = %t", err, caso.valido)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+const (
+	ABRANGENCIA_NACIONAL  = "nacional"
+	ABRANGENCIA_ESTADUAL  = "estadual"
+	ABRANGENCIA_MUNICIPAL = "municipal"
+)
+
+type Feriado struct {
+	ID          int64      `json:"id,omitempty"`
+	Data        time.Time  `json:"data"`
+	Nome        string     `json:"nome"`
+	Abrangencia string     `json:"abrangencia"`
+	Estado      *string    `json:"estado"`
+	Cidade      *string    `json:"cidade"`
+	Recorrente  bool       `json:"recorrente"`
+	Facultativo bool       `json:"facultativo"`
+	Criado      time.Time  `json:"criado,omitempty"`
+	Atualizado  *time.Time `json:"atualizado,omitempty"`
+	Apagado     *time.Time `json:"apagado,omitempty"`
+}
+
+type DiasUteis struct {
+	Inicio    time.Time `json:"inicio"`
+	Fim       time.Time `json:"fim"`
+	DiasUteis int       `json:"dias_uteis"`
+	Feriados  []Feriado `json:"feriados"`
+}
+
+func (f Feriado) Validate() error {
+	return validation.ValidateStruct(
+		&f,
+		validation.Field(&f.Data, validation.Required),
+		validation.Field(&f.Nome, validation.Required),
+		validation.Field(&f.Abrangencia, validation.Required, validation.In(ABRANGENCIA_ESTADUAL, ABRANGENCIA_MUNICIPAL)),
+		validation.Field(&f.Estado, validation.Required),
+		validation.Field(&f.Cidade, validation.Required.When(f.Abrangencia == ABRANGENCIA_MUNICIPAL)),
+	)
+}
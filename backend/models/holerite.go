@@ -0,0 +1,92 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+const (
+	HOLERITE_CREDITO = "credito"
+	HOLERITE_DEBITO  = "debito"
+)
+
+// Tributos conferidos no holerite.
+const (
+	TRIBUTO_INSS                 = "inss"
+	TRIBUTO_INSS_DECIMO_TERCEIRO = "inss_decimo_terceiro"
+	TRIBUTO_IRRF                 = "irrf"
+	TRIBUTO_IRRF_DECIMO_TERCEIRO = "irrf_decimo_terceiro"
+)
+
+// Holerite é o demonstrativo de pagamento de um mês de referência. A
+// remuneração é a vigente no emprego no mês de referência. Sem a moeda
+// informada, vale a moeda da remuneração.
+type Holerite struct {
+	ID            int64                  `json:"id"`
+	IDEmprego     int64                  `json:"id_emprego"`
+	IDRemuneracao int64                  `json:"id_remuneracao"`
+	Empresa       Empresa                `json:"empresa,omitempty"`
+	Referencia    time.Time              `json:"referencia"`
+	Moeda         string                 `json:"moeda"`
+	DataPagamento *time.Time             `json:"data_pagamento"`
+	Detalhamento  []DetalhamentoHolerite `json:"detalhamento"`
+}
+
+// DetalhamentoHolerite é uma linha do holerite. Sem a rubrica informada, a
+// linha é classificada pela descrição.
+type DetalhamentoHolerite struct {
+	ID         int64    `json:"id"`
+	IDHolerite int64    `json:"id_holerite"`
+	IDRubrica  *int64   `json:"id_rubrica"`
+	Rubrica    *Rubrica `json:"rubrica,omitempty"`
+	Tipo       string   `json:"tipo"`
+	Valor      Dinheiro `json:"valor"`
+	Descricao  string   `json:"descricao"`
+}
+
+// ConferenciaHolerite compara o INSS e o IRRF retidos no holerite com os
+// valores recalculados a partir das rubricas e das tabelas vigentes no mês de
+// referência.
+type ConferenciaHolerite struct {
+	IDHolerite       int64                `json:"id_holerite"`
+	Referencia       time.Time            `json:"referencia"`
+	Dependentes      int                  `json:"dependentes"`
+	Tributos         []ConferenciaTributo `json:"tributos"`
+	NaoClassificadas []string             `json:"nao_classificadas"`
+	Avisos           []string             `json:"avisos"`
+	Consistente      bool                 `json:"consistente"`
+}
+
+// ConferenciaTributo traz os rendimentos sujeitos ao tributo, a base de
+// cálculo após as deduções, o valor recalculado e o valor retido pela empresa. Diferenças acima da tolerância de arredondamento são
+// marcadas como divergentes.
+type ConferenciaTributo struct {
+	Tributo     string   `json:"tributo"`
+	Rendimentos Dinheiro `json:"rendimentos"`
+	Base        Dinheiro `json:"base"`
+	Deducoes    Dinheiro `json:"deducoes"`
+	Calculado   Dinheiro `json:"calculado"`
+	Retido      Dinheiro `json:"retido"`
+	Diferenca   Dinheiro `json:"diferenca"`
+	Divergente  bool     `json:"divergente"`
+}
+
+func (h Holerite) Validate() error {
+	return validation.ValidateStruct(
+		&h,
+		validation.Field(&h.IDEmprego, validation.Required),
+		validation.Field(&h.Referencia, validation.Required),
+		validation.Field(&h.Moeda, Moeda),
+		validation.Field(&h.Detalhamento, validation.Required),
+	)
+}
+
+func (d DetalhamentoHolerite) Validate() error {
+	return validation.ValidateStruct(
+		&d,
+		validation.Field(&d.Tipo, validation.Required, validation.In(HOLERITE_CREDITO, HOLERITE_DEBITO)),
+		validation.Field(&d.Valor, DinheiroPositivo),
+		validation.Field(&d.Descricao, validation.Required, validation.Length(1, 65535)),
+	)
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// Índices de preços com variação mensal publicados pelo IBGE (IPCA e INPC) e
+// pela FGV (IGP-M), disponíveis no Sistema Gerenciador de Séries Temporais do
+// Banco Central.
+const (
+	INDICE_IPCA = "ipca"
+	INDICE_INPC = "inpc"
+	INDICE_IGPM = "igpm"
+)
+
+// IndiceEconomico é a variação percentual de um índice de preços no mês de
+// referência, sempre no primeiro dia do mês.
+type IndiceEconomico struct {
+	ID         int64      `json:"id"`
+	Indice     string     `json:"indice"`
+	Referencia time.Time  `json:"referencia"`
+	Variacao   float64    `json:"variacao"`
+	Criado     time.Time  `json:"criado"`
+	Atualizado *time.Time `json:"atualizado"`
+	Apagado    *time.Time `json:"apagado"`
+}
+
+type ImportacaoIndices struct {
+	Indice      string   `json:"indice"`
+	Linhas      int      `json:"linhas"`
+	Importados  int      `json:"importados"`
+	Atualizados int      `json:"atualizados"`
+	Ignorados   int      `json:"ignorados"`
+	Erros       []string `json:"erros"`
+}
+
+// EvolucaoReal é o histórico salarial do emprego deflacionado pelo índice
+// para valores do mês-base. Os ganhos reais são percentuais: positivos quando
+// o salário superou a inflação e negativos quando perdeu poder de compra.
+type EvolucaoReal struct {
+	IDEmprego int64             `json:"id_emprego"`
+	Indice    string            `json:"indice"`
+	Moeda     string            `json:"moeda"`
+	Base      time.Time         `json:"base"`
+	Periodos  []PeriodoEvolucao `json:"periodos"`
+
+	// Ganho real entre o primeiro salário e o último reajuste.
+	GanhoRealAcumulado float64 `json:"ganho_real_acumulado"`
+
+	// Inflação desde o último reajuste até o último mês com índice
+	// publicado, ou até o fim do emprego, e o ganho real acumulado
+	// descontando essa inflação.
+	UltimoMes                   time.Time `json:"ultimo_mes"`
+	InflacaoDesdeUltimoReajuste float64   `json:"inflacao_desde_ultimo_reajuste"`
+	GanhoRealAtual              float64   `json:"ganho_real_atual"`
+}
+
+// PeriodoEvolucao é um salário do histórico, vigente a partir da data, com as
+// variações em relação ao salário anterior.
+type PeriodoEvolucao struct {
+	Data               time.Time `json:"data"`
+	Remuneracao        Dinheiro  `json:"remuneracao"`
+	RemuneracaoReal    Dinheiro  `json:"remuneracao_real"`
+	VariacaoNominal    float64   `json:"variacao_nominal"`
+	Inflacao           float64   `json:"inflacao"`
+	GanhoReal          float64   `json:"ganho_real"`
+	GanhoRealAcumulado float64   `json:"ganho_real_acumulado"`
+}
+
+func (i IndiceEconomico) Validate() error {
+	return validation.ValidateStruct(
+		&i,
+		validation.Field(&i.Indice, validation.Required, validation.In(INDICE_IPCA, INDICE_INPC, INDICE_IGPM)),
+		validation.Field(&i.Referencia, validation.Required),
+		validation.Field(&i.Variacao, validation.Min(-100.0).Exclusive()),
+	)
+}
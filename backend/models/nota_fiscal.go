@@ -0,0 +1,70 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// Situações da nota fiscal.
+const (
+	NOTA_FISCAL_PENDENTE = "pendente"
+	NOTA_FISCAL_PAGA     = "paga"
+)
+
+// Anexos do Simples Nacional para a prestação de serviços. Nas atividades
+// sujeitas ao Fator R, a empresa é tributada pelo anexo III quando a folha de
+// salários é de pelo menos 28% da receita bruta e pelo anexo V nos demais
+// casos.
+const (
+	ANEXO_III = "iii"
+	ANEXO_V   = "v"
+)
+
+// NotaFiscal é uma nota fiscal de serviço emitida no emprego em regime PJ.
+type NotaFiscal struct {
+	ID            int64      `json:"id"`
+	IDEmprego     int64      `json:"id_emprego"`
+	Numero        string     `json:"numero"`
+	DataEmissao   time.Time  `json:"data_emissao"`
+	Valor         Dinheiro   `json:"valor"`
+	Situacao      string     `json:"situacao"`
+	DataPagamento *time.Time `json:"data_pagamento"`
+	Descricao     *string    `json:"descricao"`
+	Criado        time.Time  `json:"criado"`
+	Atualizado    *time.Time `json:"atualizado"`
+	Apagado       *time.Time `json:"apagado"`
+}
+
+// EstimativaDAS é a estimativa do DAS do Simples Nacional na competência.
+// RBT12 é a receita bruta dos doze meses anteriores à competência e Folha12 é
+// a folha de salários, com o pró-labore, no mesmo período; as alíquotas são
+// percentuais.
+type EstimativaDAS struct {
+	IDEmprego       int64     `json:"id_emprego"`
+	Competencia     time.Time `json:"competencia"`
+	Anexo           string    `json:"anexo"`
+	ReceitaMes      Dinheiro  `json:"receita_mes"`
+	RBT12           Dinheiro  `json:"rbt12"`
+	Folha12         Dinheiro  `json:"folha12"`
+	FatorR          float64   `json:"fator_r"`
+	Faixa           int       `json:"faixa"`
+	AliquotaNominal float64   `json:"aliquota_nominal"`
+	ParcelaDeduzir  Dinheiro  `json:"parcela_deduzir"`
+	AliquotaEfetiva float64   `json:"aliquota_efetiva"`
+	Valor           Dinheiro  `json:"valor"`
+	Notas           int       `json:"notas"`
+	Avisos          []string  `json:"avisos"`
+}
+
+func (n NotaFiscal) Validate() error {
+	return validation.ValidateStruct(
+		&n,
+		validation.Field(&n.IDEmprego, validation.Required),
+		validation.Field(&n.Numero, validation.Required, validation.Length(1, 50)),
+		validation.Field(&n.DataEmissao, validation.Required),
+		validation.Field(&n.Valor, DinheiroPositivo),
+		validation.Field(&n.Situacao, validation.Required, validation.In(NOTA_FISCAL_PENDENTE, NOTA_FISCAL_PAGA)),
+		validation.Field(&n.DataPagamento, validation.When(n.DataPagamento != nil, validation.Min(n.DataEmissao).Error("deve ser igual ou posterior à data de emissão"))),
+	)
+}
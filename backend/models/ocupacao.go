@@ -0,0 +1,35 @@
+package models
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// Ocupacao é um cargo do catálogo de ocupações. O código CBO pode ser
+// informado com ou sem hífen (2124-05), mas é guardado sem formatação.
+type Ocupacao struct {
+	ID         int64      `json:"id"`
+	Nome       string     `json:"nome"`
+	CBO        *string    `json:"cbo"`
+	Criado     time.Time  `json:"criado"`
+	Atualizado *time.Time `json:"atualizado"`
+	Apagado    *time.Time `json:"apagado"`
+}
+
+type ImportacaoCBO struct {
+	Linhas      int      `json:"linhas"`
+	Importadas  int      `json:"importadas"`
+	Atualizadas int      `json:"atualizadas"`
+	Ignoradas   int      `json:"ignoradas"`
+	Erros       []string `json:"erros"`
+}
+
+func (o Ocupacao) Validate() error {
+	return validation.ValidateStruct(
+		&o,
+		validation.Field(&o.Nome, validation.Required, validation.Length(1, 255)),
+		validation.Field(&o.CBO, validation.NilOrNotEmpty, validation.Match(regexp.MustCompile(`^\d{4}-?\d{2}$`)).Error("deve conter os seis dígitos do código CBO")),
+	)
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// Pessoa é uma pessoa de contato da empresa, como um recrutador ou alguém do
+// RH, com os próprios contatos.
+type Pessoa struct {
+	ID           int64            `json:"id"`
+	IDEmpresa    int64            `json:"id_empresa"`
+	Empresa      *Empresa         `json:"empresa,omitempty"`
+	Nome         string           `json:"nome"`
+	Cargo        *string          `json:"cargo"`
+	Departamento *string          `json:"departamento"`
+	LinkedIn     *string          `json:"linkedin"`
+	Contatos     []ContatoEmpresa `json:"contatos,omitempty"`
+	Criado       time.Time        `json:"criado"`
+	Atualizado   *time.Time       `json:"atualizado"`
+	Apagado      *time.Time       `json:"apagado"`
+}
+
+func (p Pessoa) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(&p.IDEmpresa, validation.Required),
+		validation.Field(&p.Nome, validation.Required, validation.Length(1, 255)),
+		validation.Field(&p.Cargo, validation.NilOrNotEmpty, validation.Length(1, 100)),
+		validation.Field(&p.Departamento, validation.NilOrNotEmpty, validation.Length(1, 100)),
+		validation.Field(&p.LinkedIn, validation.NilOrNotEmpty, validation.Length(1, 255)),
+	)
+}
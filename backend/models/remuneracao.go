@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// Remuneracao é um registro do histórico salarial do emprego, vinculado à
+// ocupação exercida a partir da data informada. Sem a moeda informada, vale
+// a moeda do emprego.
+type Remuneracao struct {
+	ID          int64      `json:"id"`
+	IDEmprego   int64      `json:"id_emprego"`
+	IDOcupacao  int64      `json:"id_ocupacao"`
+	Ocupacao    *Ocupacao  `json:"ocupacao,omitempty"`
+	Remuneracao Dinheiro   `json:"remuneracao"`
+	Moeda       string     `json:"moeda"`
+	Data        time.Time  `json:"data"`
+	Criado      time.Time  `json:"criado"`
+	Atualizado  *time.Time `json:"atualizado"`
+	Apagado     *time.Time `json:"apagado"`
+}
+
+// EmpregoOcupacao é um período do histórico de ocupações de um emprego, como
+// uma promoção. O período termina na véspera do início da ocupação seguinte.
+// Remuneracao é opcional no cadastro e, quando informada, registra o novo
+// salário junto com a mudança de ocupação.
+type EmpregoOcupacao struct {
+	ID           int64         `json:"id"`
+	IDEmprego    int64         `json:"id_emprego"`
+	IDOcupacao   int64         `json:"id_ocupacao"`
+	Ocupacao     Ocupacao      `json:"ocupacao"`
+	DataInicio   time.Time     `json:"data_inicio"`
+	DataFim      *time.Time    `json:"data_fim"`
+	Observacao   *string       `json:"observacao"`
+	Remuneracao  *Dinheiro     `json:"remuneracao,omitempty"`
+	Moeda        string        `json:"moeda,omitempty"`
+	Remuneracoes []Remuneracao `json:"remuneracoes"`
+	Criado       time.Time     `json:"criado"`
+	Atualizado   *time.Time    `json:"atualizado"`
+	Apagado      *time.Time    `json:"apagado"`
+}
+
+func (r Remuneracao) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(&r.IDEmprego, validation.Required),
+		validation.Field(&r.Remuneracao, DinheiroPositivo),
+		validation.Field(&r.Moeda, Moeda),
+		validation.Field(&r.Data, validation.Required),
+	)
+}
+
+func (e EmpregoOcupacao) Validate() error {
+	return validation.ValidateStruct(
+		&e,
+		validation.Field(&e.IDEmprego, validation.Required),
+		validation.Field(&e.IDOcupacao, validation.Required),
+		validation.Field(&e.DataInicio, validation.Required),
+		validation.Field(&e.Remuneracao, DinheiroPositivo),
+		validation.Field(&e.Moeda, Moeda),
+	)
+}
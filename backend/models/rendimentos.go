@@ -0,0 +1,47 @@
+package models
+
+// Categorias das linhas do holerite para a declaração de imposto de renda.
+const (
+	RENDIMENTO_TRIBUTAVEL           = "tributavel"
+	RENDIMENTO_INSS                 = "inss"
+	RENDIMENTO_IRRF                 = "irrf"
+	RENDIMENTO_DECIMO_TERCEIRO      = "decimo_terceiro"
+	RENDIMENTO_INSS_DECIMO_TERCEIRO = "inss_decimo_terceiro"
+	RENDIMENTO_IRRF_DECIMO_TERCEIRO = "irrf_decimo_terceiro"
+	RENDIMENTO_PARTICIPACAO_LUCROS  = "participacao_lucros"
+	RENDIMENTO_IRRF_PARTICIPACAO    = "irrf_participacao_lucros"
+	RENDIMENTO_ISENTO               = "isento"
+	RENDIMENTO_OUTROS               = "outros"
+)
+
+// InformeRendimentos reúne, por fonte pagadora, os valores do ano usados na
+// declaração de ajuste anual, sempre em reais. Conversoes lista as cotações
+// usadas nos holerites em moeda estrangeira.
+type InformeRendimentos struct {
+	Ano        int                 `json:"ano"`
+	Fontes     []RendimentosFonte  `json:"fontes"`
+	Totais     RendimentosFonte    `json:"totais"`
+	Conversoes []ConversaoHolerite `json:"conversoes,omitempty"`
+}
+
+type ConversaoHolerite struct {
+	IDHolerite int64 `json:"id_holerite"`
+	Conversao
+}
+
+// RendimentosFonte segue os campos da ficha "Rendimentos tributáveis recebidos
+// de pessoa jurídica" da DIRPF. O 13º salário é informado líquido da
+// contribuição previdenciária sobre ele, como no comprovante de rendimentos.
+type RendimentosFonte struct {
+	CNPJ                       string   `json:"cnpj"`
+	Nome                       string   `json:"nome"`
+	RendimentosTributaveis     Dinheiro `json:"rendimentos_tributaveis"`
+	ContribuicaoPrevidenciaria Dinheiro `json:"contribuicao_previdenciaria"`
+	ImpostoRetido              Dinheiro `json:"imposto_retido"`
+	DecimoTerceiro             Dinheiro `json:"decimo_terceiro"`
+	IRRFDecimoTerceiro         Dinheiro `json:"irrf_decimo_terceiro"`
+	ParticipacaoLucros         Dinheiro `json:"participacao_lucros"`
+	IRRFParticipacaoLucros     Dinheiro `json:"irrf_participacao_lucros"`
+	RendimentosIsentos         Dinheiro `json:"rendimentos_isentos"`
+	Holerites                  int      `json:"holerites"`
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// Categorias das rubricas do holerite.
+const (
+	RUBRICA_SALARIO                  = "salario"
+	RUBRICA_HORA_EXTRA               = "hora_extra"
+	RUBRICA_ADICIONAL                = "adicional"
+	RUBRICA_COMISSAO                 = "comissao"
+	RUBRICA_FERIAS                   = "ferias"
+	RUBRICA_DECIMO_TERCEIRO          = "decimo_terceiro"
+	RUBRICA_PARTICIPACAO_LUCROS      = "participacao_lucros"
+	RUBRICA_BENEFICIO                = "beneficio"
+	RUBRICA_REEMBOLSO                = "reembolso"
+	RUBRICA_ADIANTAMENTO             = "adiantamento"
+	RUBRICA_FALTA                    = "falta"
+	RUBRICA_INSS                     = "inss"
+	RUBRICA_INSS_DECIMO_TERCEIRO     = "inss_decimo_terceiro"
+	RUBRICA_IRRF                     = "irrf"
+	RUBRICA_IRRF_DECIMO_TERCEIRO     = "irrf_decimo_terceiro"
+	RUBRICA_IRRF_PARTICIPACAO_LUCROS = "irrf_participacao_lucros"
+	RUBRICA_VALE_TRANSPORTE          = "vale_transporte"
+	RUBRICA_VALE_REFEICAO            = "vale_refeicao"
+	RUBRICA_PLANO_SAUDE              = "plano_saude"
+	RUBRICA_PENSAO_ALIMENTICIA       = "pensao_alimenticia"
+	RUBRICA_OUTROS                   = "outros"
+)
+
+var categoriasRubrica = []interface{}{
+	RUBRICA_SALARIO, RUBRICA_HORA_EXTRA, RUBRICA_ADICIONAL, RUBRICA_COMISSAO, RUBRICA_FERIAS,
+	RUBRICA_DECIMO_TERCEIRO, RUBRICA_PARTICIPACAO_LUCROS, RUBRICA_BENEFICIO, RUBRICA_REEMBOLSO,
+	RUBRICA_ADIANTAMENTO, RUBRICA_FALTA, RUBRICA_INSS, RUBRICA_INSS_DECIMO_TERCEIRO, RUBRICA_IRRF,
+	RUBRICA_IRRF_DECIMO_TERCEIRO, RUBRICA_IRRF_PARTICIPACAO_LUCROS, RUBRICA_VALE_TRANSPORTE,
+	RUBRICA_VALE_REFEICAO, RUBRICA_PLANO_SAUDE, RUBRICA_PENSAO_ALIMENTICIA, RUBRICA_OUTROS,
+}
+
+// Rubrica é um item do catálogo de verbas do holerite. As incidências indicam
+// se o valor compõe a base de cálculo do INSS, do IRRF e do FGTS; em rubricas
+// de débito, como faltas e pensão alimentícia, o valor é deduzido da base.
+// Termos são as palavras-chave, separadas por ponto e vírgula, usadas para
+// classificar as linhas pela descrição.
+type Rubrica struct {
+	ID         int64      `json:"id"`
+	Codigo     string     `json:"codigo"`
+	Nome       string     `json:"nome"`
+	Tipo       string     `json:"tipo"`
+	Categoria  string     `json:"categoria"`
+	IncideINSS bool       `json:"incide_inss"`
+	IncideIRRF bool       `json:"incide_irrf"`
+	IncideFGTS bool       `json:"incide_fgts"`
+	Termos     *string    `json:"termos"`
+	Criado     time.Time  `json:"criado"`
+	Atualizado *time.Time `json:"atualizado"`
+	Apagado    *time.Time `json:"apagado"`
+}
+
+type ImportacaoRubricas struct {
+	Importadas int `json:"importadas"`
+	Existentes int `json:"existentes"`
+}
+
+func (r Rubrica) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(&r.Codigo, validation.Required, validation.Length(1, 30)),
+		validation.Field(&r.Nome, validation.Required, validation.Length(1, 255)),
+		validation.Field(&r.Tipo, validation.Required, validation.In(HOLERITE_CREDITO, HOLERITE_DEBITO)),
+		validation.Field(&r.Categoria, validation.Required, validation.In(categoriasRubrica...).Error("categoria inválida")),
+		validation.Field(&r.Termos, validation.NilOrNotEmpty, validation.Length(1, 1000)),
+	)
+}
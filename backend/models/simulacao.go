@@ -0,0 +1,84 @@
+package models
+
+import (
+	"github.com/invopop/validation"
+)
+
+// Regimes de contratação das ofertas simuladas.
+const (
+	CONTRATO_CLT = "clt"
+	CONTRATO_PJ  = "pj"
+)
+
+// ComparacaoOfertas traz as ofertas a comparar e, opcionalmente, o emprego
+// atual, cuja compensação é a referência das diferenças.
+type ComparacaoOfertas struct {
+	IDEmprego   *int64   `json:"id_emprego"`
+	Dependentes int      `json:"dependentes"`
+	Ofertas     []Oferta `json:"ofertas"`
+}
+
+// Oferta é uma proposta de trabalho em valores mensais. No regime PJ, Salario
+// é o faturamento mensal, CustosPJ são os custos da empresa, como
+// contabilidade, e ProLabore é a retirada mensal dos sócios, por padrão 28% do
+// faturamento, o mínimo do Fator R para o anexo III. Beneficios é o valor
+// mensal dos benefícios, já descontadas as coparticipações.
+type Oferta struct {
+	Descricao    string    `json:"descricao"`
+	TipoContrato string    `json:"tipo_contrato"`
+	Salario      Dinheiro  `json:"salario"`
+	Beneficios   Dinheiro  `json:"beneficios"`
+	CustosPJ     Dinheiro  `json:"custos_pj"`
+	ProLabore    *Dinheiro `json:"pro_labore"`
+	Anexo        string    `json:"anexo"`
+}
+
+// ResultadoComparacao traz a simulação de cada oferta na ordem informada e,
+// quando houver emprego atual, a simulação dele.
+type ResultadoComparacao struct {
+	Atual   *SimulacaoOferta  `json:"atual,omitempty"`
+	Ofertas []SimulacaoOferta `json:"ofertas"`
+}
+
+// SimulacaoOferta é a renda anual de uma oferta. No regime CLT, inclui o 13º
+// salário, o terço de férias e o FGTS depositado; no regime PJ, desconta o
+// DAS, o INSS e o IRRF do pró-labore e os custos da empresa. A diferença é
+// em relação ao líquido anual do emprego atual.
+type SimulacaoOferta struct {
+	Descricao           string   `json:"descricao"`
+	TipoContrato        string   `json:"tipo_contrato"`
+	BrutoAnual          Dinheiro `json:"bruto_anual"`
+	DecimoTerceiro      Dinheiro `json:"decimo_terceiro"`
+	TercoFerias         Dinheiro `json:"terco_ferias"`
+	FGTS                Dinheiro `json:"fgts"`
+	BeneficiosAnual     Dinheiro `json:"beneficios_anual"`
+	INSS                Dinheiro `json:"inss"`
+	IRRF                Dinheiro `json:"irrf"`
+	DAS                 Dinheiro `json:"das"`
+	Anexo               string   `json:"anexo,omitempty"`
+	CustosPJ            Dinheiro `json:"custos_pj"`
+	LiquidoAnual        Dinheiro `json:"liquido_anual"`
+	LiquidoMensal       Dinheiro `json:"liquido_mensal"`
+	Diferenca           Dinheiro `json:"diferenca"`
+	DiferencaPercentual float64  `json:"diferenca_percentual"`
+}
+
+func (c ComparacaoOfertas) Validate() error {
+	return validation.ValidateStruct(
+		&c,
+		validation.Field(&c.Dependentes, validation.Min(0)),
+		validation.Field(&c.Ofertas, validation.Required),
+	)
+}
+
+func (o Oferta) Validate() error {
+	return validation.ValidateStruct(
+		&o,
+		validation.Field(&o.TipoContrato, validation.Required, validation.In(CONTRATO_CLT, CONTRATO_PJ)),
+		validation.Field(&o.Salario, DinheiroPositivo),
+		validation.Field(&o.Beneficios, DinheiroMin(0)),
+		validation.Field(&o.CustosPJ, DinheiroMin(0)),
+		validation.Field(&o.ProLabore, DinheiroMin(0)),
+		validation.Field(&o.Anexo, validation.In(ANEXO_III, ANEXO_V)),
+	)
+}
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// Situações de cada emprego na aplicação de um reajuste.
+const (
+	REAJUSTE_SIMULADO = "simulado"
+	REAJUSTE_APLICADO = "aplicado"
+	REAJUSTE_IGNORADO = "ignorado"
+	REAJUSTE_ERRO     = "erro"
+)
+
+// Sindicato é a entidade sindical da categoria. MesDataBase é o mês da
+// data-base, em que os salários da categoria são reajustados.
+type Sindicato struct {
+	ID          int64      `json:"id"`
+	Nome        string     `json:"nome"`
+	CNPJ        *string    `json:"cnpj"`
+	MesDataBase int        `json:"mes_data_base"`
+	Criado      time.Time  `json:"criado"`
+	Atualizado  *time.Time `json:"atualizado"`
+	Apagado     *time.Time `json:"apagado"`
+}
+
+// ConvencaoColetiva é o acordo ou a convenção coletiva de um ano aplicável
+// aos empregos da empresa, com o percentual de reajuste na data-base do
+// sindicato.
+type ConvencaoColetiva struct {
+	ID          int64      `json:"id"`
+	IDEmpresa   int64      `json:"id_empresa"`
+	IDSindicato int64      `json:"id_sindicato"`
+	Sindicato   *Sindicato `json:"sindicato,omitempty"`
+	Ano         int        `json:"ano"`
+	Percentual  float64    `json:"percentual"`
+	Observacao  *string    `json:"observacao"`
+	Criado      time.Time  `json:"criado"`
+	Atualizado  *time.Time `json:"atualizado"`
+	Apagado     *time.Time `json:"apagado"`
+}
+
+// AplicacaoReajuste é o resultado da aplicação do reajuste da convenção aos
+// empregos da empresa. Na simulação, nenhuma remuneração é registrada.
+type AplicacaoReajuste struct {
+	IDConvencao int64             `json:"id_convencao"`
+	DataBase    time.Time         `json:"data_base"`
+	Percentual  float64           `json:"percentual"`
+	Simulacao   bool              `json:"simulacao"`
+	Reajustes   []ReajusteEmprego `json:"reajustes"`
+	Aplicados   int               `json:"aplicados"`
+	Ignorados   int               `json:"ignorados"`
+	Erros       int               `json:"erros"`
+}
+
+type ReajusteEmprego struct {
+	IDEmprego     int64    `json:"id_emprego"`
+	Ocupacao      string   `json:"ocupacao"`
+	Anterior      Dinheiro `json:"anterior"`
+	Novo          Dinheiro `json:"novo"`
+	Diferenca     Dinheiro `json:"diferenca"`
+	Situacao      string   `json:"situacao"`
+	Motivo        *string  `json:"motivo,omitempty"`
+	IDRemuneracao *int64   `json:"id_remuneracao,omitempty"`
+}
+
+func (s Sindicato) Validate() error {
+	return validation.ValidateStruct(
+		&s,
+		validation.Field(&s.Nome, validation.Required, validation.Length(1, 255)),
+		validation.Field(&s.CNPJ, validation.NilOrNotEmpty, validation.Match(regexp.MustCompile(`^\d{2}\.?\d{3}\.?\d{3}/?\d{4}-?\d{2}$`)).Error("deve conter os 14 dígitos do CNPJ")),
+		validation.Field(&s.MesDataBase, validation.Required, validation.Min(1), validation.Max(12)),
+	)
+}
+
+func (c ConvencaoColetiva) Validate() error {
+	return validation.ValidateStruct(
+		&c,
+		validation.Field(&c.IDEmpresa, validation.Required),
+		validation.Field(&c.IDSindicato, validation.Required),
+		validation.Field(&c.Ano, validation.Required, validation.Min(1900), validation.Max(9999)),
+		validation.Field(&c.Percentual, validation.Required, validation.Min(0.01), validation.Max(100.0)),
+	)
+}
@@ -0,0 +1,319 @@
+package ausencia
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, ausencia models.Ausencia) (models.Ausencia, error)
+	FindByEmprego(ctx context.Context, id_emprego string) ([]models.Ausencia, error)
+	FindByPeriodo(ctx context.Context, id_emprego string, inicio, fim time.Time) ([]models.Ausencia, error)
+	FindByTipo(ctx context.Context, tipo string, inicio, fim time.Time) ([]models.Ausencia, error)
+	FindByID(ctx context.Context, id_emprego, id string) (models.Ausencia, error)
+	Delete(ctx context.Context, id_emprego, id string) error
+	CreateAnexo(ctx context.Context, anexo models.AusenciaAnexo) (models.AusenciaAnexo, error)
+	FindAnexo(ctx context.Context, id_ausencia, id string) (models.AusenciaAnexo, error)
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, ausencia models.Ausencia) (models.Ausencia, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO ausencias(id_emprego, tipo, data_inicio, data_fim, observacao, criado)
+		VALUES(?, ?, ?, ?, ?, ?)`,
+		ausencia.IDEmprego,
+		ausencia.Tipo,
+		ausencia.DataInicio,
+		ausencia.DataFim,
+		ausencia.Observacao,
+		ausencia.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Ausencia{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Ausencia{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	ausencia.ID = id
+	ausencia.Anexos = []models.AusenciaAnexo{}
+
+	return ausencia, nil
+}
+
+func (r *repository) FindByEmprego(ctx context.Context, id_emprego string) ([]models.Ausencia, error) {
+	return r.find(ctx, " AND aus.id_emprego = ?", id_emprego)
+}
+
+// FindByPeriodo retorna as ausências do emprego que têm ao menos um dia dentro
+// do período informado.
+func (r *repository) FindByPeriodo(ctx context.Context, id_emprego string, inicio, fim time.Time) ([]models.Ausencia, error) {
+	return r.find(
+		ctx,
+		" AND aus.id_emprego = ? AND aus.data_inicio <= ? AND aus.data_fim >= ?",
+		id_emprego,
+		fim.Format(time.DateOnly),
+		inicio.Format(time.DateOnly),
+	)
+}
+
+// FindByTipo retorna as ausências do tipo informado, de todos os empregos, que
+// têm ao menos um dia dentro do período informado.
+func (r *repository) FindByTipo(ctx context.Context, tipo string, inicio, fim time.Time) ([]models.Ausencia, error) {
+	return r.find(
+		ctx,
+		" AND aus.tipo = ? AND aus.data_inicio <= ? AND aus.data_fim >= ?",
+		tipo,
+		fim.Format(time.DateOnly),
+		inicio.Format(time.DateOnly),
+	)
+}
+
+func (r *repository) FindByID(ctx context.Context, id_emprego, id string) (models.Ausencia, error) {
+	ausencias, err := r.find(ctx, " AND aus.id_emprego = ? AND aus.id = ?", id_emprego, id)
+	if err != nil || len(ausencias) == 0 {
+		return models.Ausencia{}, err
+	}
+
+	return ausencias[0], nil
+}
+
+func (r *repository) find(ctx context.Context, conditions string, arguments ...interface{}) ([]models.Ausencia, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			aus.id,
+			aus.id_emprego,
+			aus.tipo,
+			aus.data_inicio,
+			aus.data_fim,
+			aus.observacao,
+			aus.criado,
+			aus.atualizado,
+			aus.apagado
+		FROM ausencias aus
+		WHERE aus.apagado IS NULL
+		`+conditions+`
+		ORDER BY aus.data_inicio, aus.id`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Ausencia{}, err
+	}
+
+	defer rows.Close()
+
+	var ausencias []models.Ausencia
+
+	for rows.Next() {
+		var ausencia = models.Ausencia{
+			Anexos: []models.AusenciaAnexo{},
+		}
+
+		err := rows.Scan(
+			&ausencia.ID,
+			&ausencia.IDEmprego,
+			&ausencia.Tipo,
+			&ausencia.DataInicio,
+			&ausencia.DataFim,
+			&ausencia.Observacao,
+			&ausencia.Criado,
+			&ausencia.Atualizado,
+			&ausencia.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Ausencia{}, err
+		}
+
+		anexos, err := r.DB().Select(
+			ctx,
+			`SELECT
+				anx.id,
+				anx.id_ausencia,
+				anx.nome,
+				anx.tipo_conteudo,
+				LENGTH(anx.conteudo),
+				anx.criado
+			FROM ausencia_anexos anx
+			WHERE anx.apagado IS NULL
+			AND anx.id_ausencia = ?`,
+			ausencia.ID,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT, err)
+			return []models.Ausencia{}, err
+		}
+
+		for anexos.Next() {
+			var anexo = models.AusenciaAnexo{}
+
+			err := anexos.Scan(
+				&anexo.ID,
+				&anexo.IDAusencia,
+				&anexo.Nome,
+				&anexo.TipoConteudo,
+				&anexo.Tamanho,
+				&anexo.Criado,
+			)
+
+			if err != nil {
+				anexos.Close()
+
+				log.Error(repositories.ERROR_SELECT_SCAN, err)
+				return []models.Ausencia{}, err
+			}
+
+			ausencia.Anexos = append(ausencia.Anexos, anexo)
+		}
+
+		anexos.Close()
+
+		ausencias = append(ausencias, ausencia)
+	}
+
+	return ausencias, nil
+}
+
+func (r *repository) Delete(ctx context.Context, id_emprego, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE ausencias SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id_emprego = ?
+		AND id = ?`,
+		id_emprego,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+func (r *repository) CreateAnexo(ctx context.Context, anexo models.AusenciaAnexo) (models.AusenciaAnexo, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO ausencia_anexos(id_ausencia, nome, tipo_conteudo, conteudo, criado)
+		VALUES(?, ?, ?, ?, ?)`,
+		anexo.IDAusencia,
+		anexo.Nome,
+		anexo.TipoConteudo,
+		anexo.Conteudo,
+		anexo.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.AusenciaAnexo{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.AusenciaAnexo{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	anexo.ID = id
+	anexo.Tamanho = int64(len(anexo.Conteudo))
+
+	return anexo, nil
+}
+
+func (r *repository) FindAnexo(ctx context.Context, id_ausencia, id string) (models.AusenciaAnexo, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			anx.id,
+			anx.id_ausencia,
+			anx.nome,
+			anx.tipo_conteudo,
+			anx.conteudo,
+			anx.criado
+		FROM ausencia_anexos anx
+		WHERE anx.apagado IS NULL
+		AND anx.id_ausencia = ?
+		AND anx.id = ?`,
+		id_ausencia,
+		id,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return models.AusenciaAnexo{}, err
+	}
+
+	defer rows.Close()
+
+	var anexo = models.AusenciaAnexo{}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&anexo.ID,
+			&anexo.IDAusencia,
+			&anexo.Nome,
+			&anexo.TipoConteudo,
+			&anexo.Conteudo,
+			&anexo.Criado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return models.AusenciaAnexo{}, err
+		}
+	}
+
+	anexo.Tamanho = int64(len(anexo.Conteudo))
+
+	return anexo, nil
+}
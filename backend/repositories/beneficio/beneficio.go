@@ -0,0 +1,213 @@
+package beneficio
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, beneficio models.Beneficio) (models.Beneficio, error)
+	FindByEmprego(ctx context.Context, id_emprego string) ([]models.Beneficio, error)
+	FindByID(ctx context.Context, id_emprego, id string) (models.Beneficio, error)
+	Update(ctx context.Context, beneficio models.Beneficio) error
+	Delete(ctx context.Context, id_emprego, id string) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, beneficio models.Beneficio) (models.Beneficio, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO beneficios(id_emprego, tipo, descricao, valor, multiplo_salario, coparticipacao, periodicidade, data_inicio, data_fim, criado)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		beneficio.IDEmprego,
+		beneficio.Tipo,
+		beneficio.Descricao,
+		beneficio.Valor,
+		beneficio.MultiploSalario,
+		beneficio.Coparticipacao,
+		beneficio.Periodicidade,
+		beneficio.DataInicio,
+		beneficio.DataFim,
+		beneficio.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Beneficio{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Beneficio{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	beneficio.ID = id
+
+	return beneficio, nil
+}
+
+func (r *repository) FindByEmprego(ctx context.Context, id_emprego string) ([]models.Beneficio, error) {
+	return r.find(ctx, " AND ben.id_emprego = ?", id_emprego)
+}
+
+func (r *repository) FindByID(ctx context.Context, id_emprego, id string) (models.Beneficio, error) {
+	beneficios, err := r.find(ctx, " AND ben.id_emprego = ? AND ben.id = ?", id_emprego, id)
+	if err != nil || len(beneficios) == 0 {
+		return models.Beneficio{}, err
+	}
+
+	return beneficios[0], nil
+}
+
+func (r *repository) find(ctx context.Context, conditions string, arguments ...interface{}) ([]models.Beneficio, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			ben.id,
+			ben.id_emprego,
+			ben.tipo,
+			ben.descricao,
+			ben.valor,
+			ben.multiplo_salario,
+			ben.coparticipacao,
+			ben.periodicidade,
+			ben.data_inicio,
+			ben.data_fim,
+			ben.criado,
+			ben.atualizado,
+			ben.apagado
+		FROM beneficios ben
+		WHERE ben.apagado IS NULL
+		`+conditions+`
+		ORDER BY ben.data_inicio, ben.id`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Beneficio{}, err
+	}
+
+	defer rows.Close()
+
+	var beneficios []models.Beneficio
+
+	for rows.Next() {
+		var beneficio = models.Beneficio{}
+
+		err := rows.Scan(
+			&beneficio.ID,
+			&beneficio.IDEmprego,
+			&beneficio.Tipo,
+			&beneficio.Descricao,
+			&beneficio.Valor,
+			&beneficio.MultiploSalario,
+			&beneficio.Coparticipacao,
+			&beneficio.Periodicidade,
+			&beneficio.DataInicio,
+			&beneficio.DataFim,
+			&beneficio.Criado,
+			&beneficio.Atualizado,
+			&beneficio.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Beneficio{}, err
+		}
+
+		beneficios = append(beneficios, beneficio)
+	}
+
+	return beneficios, nil
+}
+
+func (r *repository) Update(ctx context.Context, beneficio models.Beneficio) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE beneficios SET
+		tipo = ?,
+		descricao = ?,
+		valor = ?,
+		multiplo_salario = ?,
+		coparticipacao = ?,
+		periodicidade = ?,
+		data_inicio = ?,
+		data_fim = ?,
+		atualizado = ?
+		WHERE id_emprego = ?
+		AND id = ?`,
+		beneficio.Tipo,
+		beneficio.Descricao,
+		beneficio.Valor,
+		beneficio.MultiploSalario,
+		beneficio.Coparticipacao,
+		beneficio.Periodicidade,
+		beneficio.DataInicio,
+		beneficio.DataFim,
+		beneficio.Atualizado,
+		beneficio.IDEmprego,
+		beneficio.ID,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id_emprego, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE beneficios SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id_emprego = ?
+		AND id = ?`,
+		id_emprego,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
@@ -0,0 +1,441 @@
+package candidatura
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+	empregoRepository "tsukuyomi/repositories/emprego"
+)
+
+type Repository interface {
+	Create(ctx context.Context, candidatura models.Candidatura) (models.Candidatura, error)
+	FindAll(ctx context.Context, search, empresa, situacao string) ([]models.Candidatura, error)
+	FindByID(ctx context.Context, id string) (models.Candidatura, error)
+	FindHistorico(ctx context.Context, id string) ([]models.TransicaoCandidatura, error)
+	Update(ctx context.Context, candidatura models.Candidatura) error
+	Transitar(ctx context.Context, transicao models.TransicaoCandidatura) (bool, error)
+	Converter(ctx context.Context, id int64, emprego models.Emprego) (models.Emprego, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+// Create grava a candidatura e a transição inicial em uma única transação.
+func (r *repository) Create(ctx context.Context, candidatura models.Candidatura) (models.Candidatura, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO candidaturas(id_empresa, cargo, origem, pretensao_salarial, salario_oferecido, moeda, tipo_contrato, situacao, observacao, criado)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		candidatura.IDEmpresa,
+		candidatura.Cargo,
+		candidatura.Origem,
+		candidatura.PretensaoSalarial,
+		candidatura.SalarioOferecido,
+		candidatura.Moeda,
+		candidatura.TipoContrato,
+		candidatura.Situacao,
+		candidatura.Observacao,
+		candidatura.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Candidatura{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Candidatura{}, err
+	}
+
+	candidatura.ID = id
+
+	for i, transicao := range candidatura.Historico {
+		result, err := r.DB().Write(
+			ctx,
+			`INSERT INTO candidatura_transicoes(id_candidatura, situacao_anterior, situacao, data, observacao)
+			VALUES(?, ?, ?, ?, ?)`,
+			candidatura.ID,
+			transicao.SituacaoAnterior,
+			transicao.Situacao,
+			transicao.Data,
+			transicao.Observacao,
+		)
+
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return models.Candidatura{}, err
+		}
+
+		idTransicao, err := result.LastInsertId()
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return models.Candidatura{}, err
+		}
+
+		candidatura.Historico[i].ID = idTransicao
+		candidatura.Historico[i].IDCandidatura = candidatura.ID
+	}
+
+	r.DB().Commit(ctx)
+
+	return candidatura, nil
+}
+
+func (r *repository) FindAll(ctx context.Context, search, empresa, situacao string) ([]models.Candidatura, error) {
+	arguments := []interface{}{}
+	conditions := ""
+
+	if search != "" {
+		searchLike := fmt.Sprintf("%%%s%%", search)
+		conditions += " AND (can.cargo LIKE ? OR can.origem LIKE ? OR emp.nome LIKE ?)"
+		arguments = append(arguments, searchLike, searchLike, searchLike)
+	}
+
+	if empresa != "" {
+		conditions += " AND (emp.id = ? OR emp.nome = ?)"
+		arguments = append(arguments, empresa, empresa)
+	}
+
+	if situacao != "" {
+		conditions += " AND can.situacao = ?"
+		arguments = append(arguments, situacao)
+	}
+
+	return r.find(ctx, conditions, arguments...)
+}
+
+func (r *repository) FindByID(ctx context.Context, id string) (models.Candidatura, error) {
+	candidaturas, err := r.find(ctx, " AND can.id = ?", id)
+	if err != nil || len(candidaturas) == 0 {
+		return models.Candidatura{}, err
+	}
+
+	return candidaturas[0], nil
+}
+
+func (r *repository) find(ctx context.Context, conditions string, arguments ...interface{}) ([]models.Candidatura, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			can.id,
+			can.id_empresa,
+			emp.nome,
+			emp.cnpj,
+			can.cargo,
+			can.origem,
+			can.pretensao_salarial,
+			can.salario_oferecido,
+			can.moeda,
+			can.tipo_contrato,
+			can.situacao,
+			can.id_emprego,
+			can.observacao,
+			can.criado,
+			can.atualizado,
+			can.apagado
+		FROM candidaturas can
+		JOIN empresas emp ON emp.id = can.id_empresa
+		WHERE can.apagado IS NULL
+		AND emp.apagado IS NULL
+		`+conditions+`
+		ORDER BY can.criado DESC, can.id DESC`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Candidatura{}, err
+	}
+
+	defer rows.Close()
+
+	var candidaturas []models.Candidatura
+
+	for rows.Next() {
+		var candidatura = models.Candidatura{
+			Empresa: &models.Empresa{},
+		}
+
+		err := rows.Scan(
+			&candidatura.ID,
+			&candidatura.IDEmpresa,
+			&candidatura.Empresa.Nome,
+			&candidatura.Empresa.CNPJ,
+			&candidatura.Cargo,
+			&candidatura.Origem,
+			&candidatura.PretensaoSalarial,
+			&candidatura.SalarioOferecido,
+			&candidatura.Moeda,
+			&candidatura.TipoContrato,
+			&candidatura.Situacao,
+			&candidatura.IDEmprego,
+			&candidatura.Observacao,
+			&candidatura.Criado,
+			&candidatura.Atualizado,
+			&candidatura.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Candidatura{}, err
+		}
+
+		candidatura.Empresa.ID = candidatura.IDEmpresa
+
+		candidaturas = append(candidaturas, candidatura)
+	}
+
+	return candidaturas, nil
+}
+
+// FindHistorico retorna as transições da candidatura em ordem cronológica.
+func (r *repository) FindHistorico(ctx context.Context, id string) ([]models.TransicaoCandidatura, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			ct.id,
+			ct.id_candidatura,
+			ct.situacao_anterior,
+			ct.situacao,
+			ct.data,
+			ct.observacao
+		FROM candidatura_transicoes ct
+		WHERE ct.id_candidatura = ?
+		ORDER BY ct.data, ct.id`,
+		id,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.TransicaoCandidatura{}, err
+	}
+
+	defer rows.Close()
+
+	var historico []models.TransicaoCandidatura
+
+	for rows.Next() {
+		var transicao = models.TransicaoCandidatura{}
+
+		err := rows.Scan(
+			&transicao.ID,
+			&transicao.IDCandidatura,
+			&transicao.SituacaoAnterior,
+			&transicao.Situacao,
+			&transicao.Data,
+			&transicao.Observacao,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.TransicaoCandidatura{}, err
+		}
+
+		historico = append(historico, transicao)
+	}
+
+	return historico, nil
+}
+
+// Update atualiza os dados da candidatura. A situação só muda pelas
+// transições.
+func (r *repository) Update(ctx context.Context, candidatura models.Candidatura) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE candidaturas SET
+		id_empresa = ?,
+		cargo = ?,
+		origem = ?,
+		pretensao_salarial = ?,
+		salario_oferecido = ?,
+		moeda = ?,
+		tipo_contrato = ?,
+		observacao = ?,
+		atualizado = ?
+		WHERE id = ?`,
+		candidatura.IDEmpresa,
+		candidatura.Cargo,
+		candidatura.Origem,
+		candidatura.PretensaoSalarial,
+		candidatura.SalarioOferecido,
+		candidatura.Moeda,
+		candidatura.TipoContrato,
+		candidatura.Observacao,
+		candidatura.Atualizado,
+		candidatura.ID,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+// Transitar muda a situação da candidatura e registra a transição no
+// histórico em uma única transação. A situação só muda se ainda for a
+// situação anterior da transição, para que transições simultâneas não passem
+// ambas pela validação; retorna false quando ela já tinha mudado.
+func (r *repository) Transitar(ctx context.Context, transicao models.TransicaoCandidatura) (bool, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`UPDATE candidaturas SET
+		situacao = ?,
+		atualizado = ?
+		WHERE id = ? AND situacao = ?`,
+		transicao.Situacao,
+		transicao.Data,
+		transicao.IDCandidatura,
+		transicao.SituacaoAnterior,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return false, err
+	}
+
+	afetadas, err := result.RowsAffected()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return false, err
+	}
+
+	if afetadas == 0 {
+		r.DB().Rollback(ctx)
+		return false, nil
+	}
+
+	_, err = r.DB().Write(
+		ctx,
+		`INSERT INTO candidatura_transicoes(id_candidatura, situacao_anterior, situacao, data, observacao)
+		VALUES(?, ?, ?, ?, ?)`,
+		transicao.IDCandidatura,
+		transicao.SituacaoAnterior,
+		transicao.Situacao,
+		transicao.Data,
+		transicao.Observacao,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return false, err
+	}
+
+	r.DB().Commit(ctx)
+
+	return true, nil
+}
+
+// Converter grava o emprego e o vincula à candidatura em uma única
+// transação. O vínculo só é gravado se a candidatura ainda estiver aceita e
+// sem emprego; caso contrário, o emprego é desfeito e o ID retornado é zero.
+func (r *repository) Converter(ctx context.Context, id int64, emprego models.Emprego) (models.Emprego, error) {
+	r.DB().BeginTransaction(ctx)
+
+	idEmprego, err := empregoRepository.Inserir(ctx, r.DB(), emprego)
+	if err != nil {
+		r.DB().Rollback(ctx)
+		return models.Emprego{}, err
+	}
+
+	result, err := r.DB().Write(
+		ctx,
+		`UPDATE candidaturas SET
+		id_emprego = ?,
+		atualizado = ?
+		WHERE id = ? AND id_emprego IS NULL AND situacao = ?`,
+		idEmprego,
+		emprego.Criado,
+		id,
+		models.CANDIDATURA_ACEITO,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return models.Emprego{}, err
+	}
+
+	afetadas, err := result.RowsAffected()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return models.Emprego{}, err
+	}
+
+	if afetadas == 0 {
+		r.DB().Rollback(ctx)
+		return models.Emprego{}, nil
+	}
+
+	r.DB().Commit(ctx)
+
+	emprego.ID = idEmprego
+
+	return emprego, nil
+}
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE candidaturas SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id = ?`,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package cep
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Importar(ctx context.Context, ceps []models.CEP) error
+	FindByCEP(ctx context.Context, cep string) (models.CEP, error)
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+// Importar grava o lote de CEPs em uma única transação, atualizando os já
+// importados.
+func (r *repository) Importar(ctx context.Context, ceps []models.CEP) error {
+	if len(ceps) == 0 {
+		return nil
+	}
+
+	arguments := make([]interface{}, 0, len(ceps)*6)
+
+	for _, cep := range ceps {
+		arguments = append(arguments, cep.CEP, cep.Logradouro, cep.Complemento, cep.Bairro, cep.Cidade, cep.Estado)
+	}
+
+	grupo := "(?, ?, ?, ?, ?, ?)"
+
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`INSERT INTO ceps(cep, logradouro, complemento, bairro, cidade, estado)
+		VALUES `+strings.TrimSuffix(strings.Repeat(grupo+", ", len(ceps)), ", ")+`
+		ON DUPLICATE KEY UPDATE
+		logradouro = VALUES(logradouro),
+		complemento = VALUES(complemento),
+		bairro = VALUES(bairro),
+		cidade = VALUES(cidade),
+		estado = VALUES(estado)`,
+		arguments...,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+// FindByCEP retorna o CEP, informado só com os dígitos. Sem resultado, o CEP
+// retornado é vazio.
+func (r *repository) FindByCEP(ctx context.Context, cep string) (models.CEP, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			cep,
+			logradouro,
+			complemento,
+			bairro,
+			cidade,
+			estado
+		FROM ceps
+		WHERE cep = ?`,
+		cep,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return models.CEP{}, err
+	}
+
+	defer rows.Close()
+
+	var resultado models.CEP
+
+	if rows.Next() {
+		err := rows.Scan(
+			&resultado.CEP,
+			&resultado.Logradouro,
+			&resultado.Complemento,
+			&resultado.Bairro,
+			&resultado.Cidade,
+			&resultado.Estado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return models.CEP{}, err
+		}
+	}
+
+	return resultado, nil
+}
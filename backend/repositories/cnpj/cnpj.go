@@ -0,0 +1,325 @@
+package cnpj
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+	empresaRepository "tsukuyomi/repositories/empresa"
+	enderecoRepository "tsukuyomi/repositories/endereco"
+	enderecoEmpresaRepository "tsukuyomi/repositories/endereco_empresa"
+)
+
+type Repository interface {
+	ImportarEmpresas(ctx context.Context, empresas []models.CNPJEmpresa) error
+	ImportarEstabelecimentos(ctx context.Context, estabelecimentos []models.CNPJEstabelecimento) error
+	ImportarMunicipios(ctx context.Context, municipios []models.CNPJMunicipio) error
+	FindByCNPJ(ctx context.Context, cnpj string) (models.CNPJEstabelecimento, models.CNPJEmpresa, error)
+	FindEmpresaCadastrada(ctx context.Context, cnpj string) (models.Empresa, error)
+	Cadastrar(ctx context.Context, empresa models.Empresa, endereco models.Endereco) (models.EnderecoEmpresa, error)
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+// ImportarEmpresas grava o lote de empresas em uma única transação,
+// atualizando as já importadas.
+func (r *repository) ImportarEmpresas(ctx context.Context, empresas []models.CNPJEmpresa) error {
+	if len(empresas) == 0 {
+		return nil
+	}
+
+	arguments := make([]interface{}, 0, len(empresas)*5)
+
+	for _, empresa := range empresas {
+		arguments = append(arguments, empresa.CNPJBasico, empresa.RazaoSocial, empresa.NaturezaJuridica, empresa.CapitalSocial, empresa.Porte)
+	}
+
+	return r.importar(
+		ctx,
+		`INSERT INTO cnpj_empresas(cnpj_basico, razao_social, natureza_juridica, capital_social, porte)
+		VALUES `+valores(len(empresas), 5)+`
+		ON DUPLICATE KEY UPDATE
+		razao_social = VALUES(razao_social),
+		natureza_juridica = VALUES(natureza_juridica),
+		capital_social = VALUES(capital_social),
+		porte = VALUES(porte)`,
+		arguments...,
+	)
+}
+
+// ImportarEstabelecimentos grava o lote de estabelecimentos em uma única
+// transação, atualizando os já importados.
+func (r *repository) ImportarEstabelecimentos(ctx context.Context, estabelecimentos []models.CNPJEstabelecimento) error {
+	if len(estabelecimentos) == 0 {
+		return nil
+	}
+
+	arguments := make([]interface{}, 0, len(estabelecimentos)*17)
+
+	for _, estabelecimento := range estabelecimentos {
+		arguments = append(
+			arguments,
+			estabelecimento.CNPJ,
+			estabelecimento.CNPJBasico,
+			estabelecimento.Matriz,
+			estabelecimento.NomeFantasia,
+			estabelecimento.SituacaoCadastral,
+			estabelecimento.DataInicioAtividade,
+			estabelecimento.CNAEPrincipal,
+			estabelecimento.TipoLogradouro,
+			estabelecimento.Logradouro,
+			estabelecimento.Numero,
+			estabelecimento.Complemento,
+			estabelecimento.Bairro,
+			estabelecimento.CEP,
+			estabelecimento.UF,
+			estabelecimento.Municipio,
+			estabelecimento.Telefone,
+			estabelecimento.Email,
+		)
+	}
+
+	return r.importar(
+		ctx,
+		`INSERT INTO cnpj_estabelecimentos(cnpj, cnpj_basico, matriz, nome_fantasia, situacao_cadastral, data_inicio_atividade, cnae_principal,
+			tipo_logradouro, logradouro, numero, complemento, bairro, cep, uf, codigo_municipio, telefone, email)
+		VALUES `+valores(len(estabelecimentos), 17)+`
+		ON DUPLICATE KEY UPDATE
+		matriz = VALUES(matriz),
+		nome_fantasia = VALUES(nome_fantasia),
+		situacao_cadastral = VALUES(situacao_cadastral),
+		data_inicio_atividade = VALUES(data_inicio_atividade),
+		cnae_principal = VALUES(cnae_principal),
+		tipo_logradouro = VALUES(tipo_logradouro),
+		logradouro = VALUES(logradouro),
+		numero = VALUES(numero),
+		complemento = VALUES(complemento),
+		bairro = VALUES(bairro),
+		cep = VALUES(cep),
+		uf = VALUES(uf),
+		codigo_municipio = VALUES(codigo_municipio),
+		telefone = VALUES(telefone),
+		email = VALUES(email)`,
+		arguments...,
+	)
+}
+
+func (r *repository) ImportarMunicipios(ctx context.Context, municipios []models.CNPJMunicipio) error {
+	if len(municipios) == 0 {
+		return nil
+	}
+
+	arguments := make([]interface{}, 0, len(municipios)*2)
+
+	for _, municipio := range municipios {
+		arguments = append(arguments, municipio.Codigo, municipio.Nome)
+	}
+
+	return r.importar(
+		ctx,
+		`INSERT INTO cnpj_municipios(codigo, nome)
+		VALUES `+valores(len(municipios), 2)+`
+		ON DUPLICATE KEY UPDATE
+		nome = VALUES(nome)`,
+		arguments...,
+	)
+}
+
+func (r *repository) importar(ctx context.Context, query string, arguments ...interface{}) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(ctx, query, arguments...)
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+// valores monta os grupos de parâmetros de um INSERT com várias linhas.
+func valores(linhas, colunas int) string {
+	grupo := "(" + strings.TrimSuffix(strings.Repeat("?, ", colunas), ", ") + ")"
+
+	return strings.TrimSuffix(strings.Repeat(grupo+", ", linhas), ", ")
+}
+
+// FindByCNPJ retorna o estabelecimento com o nome do município e a empresa a
+// que ele pertence. Sem resultado, o CNPJ do estabelecimento é vazio.
+func (r *repository) FindByCNPJ(ctx context.Context, cnpj string) (models.CNPJEstabelecimento, models.CNPJEmpresa, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			est.cnpj,
+			est.cnpj_basico,
+			est.matriz,
+			est.nome_fantasia,
+			est.situacao_cadastral,
+			est.data_inicio_atividade,
+			est.cnae_principal,
+			est.tipo_logradouro,
+			est.logradouro,
+			est.numero,
+			est.complemento,
+			est.bairro,
+			est.cep,
+			est.uf,
+			COALESCE(mun.nome, est.codigo_municipio),
+			est.telefone,
+			est.email,
+			COALESCE(emp.razao_social, ''),
+			COALESCE(emp.natureza_juridica, ''),
+			COALESCE(emp.capital_social, 0),
+			COALESCE(emp.porte, '')
+		FROM cnpj_estabelecimentos est
+		LEFT JOIN cnpj_empresas emp ON emp.cnpj_basico = est.cnpj_basico
+		LEFT JOIN cnpj_municipios mun ON mun.codigo = est.codigo_municipio
+		WHERE est.cnpj = ?`,
+		cnpj,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return models.CNPJEstabelecimento{}, models.CNPJEmpresa{}, err
+	}
+
+	defer rows.Close()
+
+	var estabelecimento = models.CNPJEstabelecimento{}
+	var empresa = models.CNPJEmpresa{}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&estabelecimento.CNPJ,
+			&estabelecimento.CNPJBasico,
+			&estabelecimento.Matriz,
+			&estabelecimento.NomeFantasia,
+			&estabelecimento.SituacaoCadastral,
+			&estabelecimento.DataInicioAtividade,
+			&estabelecimento.CNAEPrincipal,
+			&estabelecimento.TipoLogradouro,
+			&estabelecimento.Logradouro,
+			&estabelecimento.Numero,
+			&estabelecimento.Complemento,
+			&estabelecimento.Bairro,
+			&estabelecimento.CEP,
+			&estabelecimento.UF,
+			&estabelecimento.Municipio,
+			&estabelecimento.Telefone,
+			&estabelecimento.Email,
+			&empresa.RazaoSocial,
+			&empresa.NaturezaJuridica,
+			&empresa.CapitalSocial,
+			&empresa.Porte,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return models.CNPJEstabelecimento{}, models.CNPJEmpresa{}, err
+		}
+
+		empresa.CNPJBasico = estabelecimento.CNPJBasico
+	}
+
+	return estabelecimento, empresa, nil
+}
+
+// FindEmpresaCadastrada retorna a empresa cadastrada com o CNPJ informado,
+// comparando apenas os dígitos, já que o CNPJ pode ter sido digitado com ou
+// sem formatação.
+func (r *repository) FindEmpresaCadastrada(ctx context.Context, cnpj string) (models.Empresa, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			emp.id,
+			emp.nome,
+			emp.cnpj,
+			emp.criado,
+			emp.atualizado,
+			emp.apagado
+		FROM empresas emp
+		WHERE emp.apagado IS NULL
+		AND REGEXP_REPLACE(emp.cnpj, '[^0-9]', '') = ?`,
+		cnpj,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return models.Empresa{}, err
+	}
+
+	defer rows.Close()
+
+	var empresa = models.Empresa{}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&empresa.ID,
+			&empresa.Nome,
+			&empresa.CNPJ,
+			&empresa.Criado,
+			&empresa.Atualizado,
+			&empresa.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return models.Empresa{}, err
+		}
+	}
+
+	return empresa, nil
+}
+
+// Cadastrar grava a empresa, o endereço e o vínculo entre eles em uma única
+// transação. Um endereço com ID já cadastrado é apenas vinculado.
+func (r *repository) Cadastrar(ctx context.Context, empresa models.Empresa, endereco models.Endereco) (models.EnderecoEmpresa, error) {
+	r.DB().BeginTransaction(ctx)
+
+	var err error
+
+	empresa.ID, err = empresaRepository.Inserir(ctx, r.DB(), empresa)
+	if err != nil {
+		r.DB().Rollback(ctx)
+		return models.EnderecoEmpresa{}, err
+	}
+
+	if endereco.ID == 0 {
+		endereco.ID, err = enderecoRepository.Inserir(ctx, r.DB(), endereco)
+		if err != nil {
+			r.DB().Rollback(ctx)
+			return models.EnderecoEmpresa{}, err
+		}
+	}
+
+	enderecoEmpresa := models.EnderecoEmpresa{
+		Empresa:  empresa,
+		Endereco: endereco,
+		Criado:   empresa.Criado,
+	}
+
+	enderecoEmpresa.ID, err = enderecoEmpresaRepository.Inserir(ctx, r.DB(), enderecoEmpresa)
+	if err != nil {
+		r.DB().Rollback(ctx)
+		return models.EnderecoEmpresa{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	return enderecoEmpresa, nil
+}
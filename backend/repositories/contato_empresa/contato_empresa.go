@@ -12,7 +12,7 @@ import (
 
 type Repository interface {
 	Create(ctx context.Context, contato models.ContatoEmpresa) (models.ContatoEmpresa, error)
-	FindAll(ctx context.Context, search, empresa, tipo, contato string) ([]models.ContatoEmpresa, error)
+	FindAll(ctx context.Context, search, empresa, pessoa, tipo, contato string) ([]models.ContatoEmpresa, error)
 	FindByID(ctx context.Context, id string) (models.ContatoEmpresa, error)
 	Update(ctx context.Context, contato models.ContatoEmpresa) error
 	Delete(ctx context.Context, id string) error
@@ -33,9 +33,10 @@ func (r *repository) Create(ctx context.Context, contato models.ContatoEmpresa)
 
 	result, err := r.DB().Write(
 		ctx,
-		`INSERT INTO contato_empresa(id_empresa, tipo, contato, criado)
-		VALUES(?, ?, ?, ?)`,
+		`INSERT INTO contato_empresa(id_empresa, id_pessoa, tipo, contato, criado)
+		VALUES(?, ?, ?, ?, ?)`,
 		contato.IDEmpresa,
+		contato.IDPessoa,
 		contato.Tipo,
 		contato.Contato,
 		contato.Criado,
@@ -63,7 +64,7 @@ func (r *repository) Create(ctx context.Context, contato models.ContatoEmpresa)
 	return contato, nil
 }
 
-func (r *repository) FindAll(ctx context.Context, search, empresa, tipo, contato string) ([]models.ContatoEmpresa, error) {
+func (r *repository) FindAll(ctx context.Context, search, empresa, pessoa, tipo, contato string) ([]models.ContatoEmpresa, error) {
 	arguments := []interface{}{}
 	var searchLike string
 
@@ -71,13 +72,18 @@ func (r *repository) FindAll(ctx context.Context, search, empresa, tipo, contato
 
 	if search != "" {
 		searchLike = fmt.Sprintf("%%%s%%", search)
-		conditions += " AND (cont.tipo LIKE ? OR cont.contato LIKE ? OR emp.nome LIKE ? OR emp.cnpj LIKE ?)"
-		arguments = append(arguments, searchLike, searchLike, searchLike, searchLike)
+		conditions += " AND (cont.tipo LIKE ? OR cont.contato LIKE ? OR emp.nome LIKE ? OR emp.cnpj LIKE ? OR pes.nome LIKE ?)"
+		arguments = append(arguments, searchLike, searchLike, searchLike, searchLike, searchLike)
 	}
 
 	if empresa != "" {
 		conditions += " AND (cont.id_empresa = ? OR emp.nome = ?)"
-		arguments = append(arguments, empresa)
+		arguments = append(arguments, empresa, empresa)
+	}
+
+	if pessoa != "" {
+		conditions += " AND (cont.id_pessoa = ? OR pes.nome = ?)"
+		arguments = append(arguments, pessoa, pessoa)
 	}
 
 	if tipo != "" {
@@ -100,6 +106,10 @@ func (r *repository) FindAll(ctx context.Context, search, empresa, tipo, contato
 			emp.criado,
 			emp.atualizado,
 			emp.apagado,
+			cont.id_pessoa,
+			pes.nome,
+			pes.cargo,
+			pes.departamento,
 			cont.tipo,
 			cont.contato,
 			cont.criado,
@@ -107,6 +117,7 @@ func (r *repository) FindAll(ctx context.Context, search, empresa, tipo, contato
 			cont.apagado
 		FROM contato_empresa cont
 		JOIN empresas emp ON emp.id = cont.id_empresa
+		LEFT JOIN pessoas pes ON pes.id = cont.id_pessoa AND pes.apagado IS NULL
 		WHERE cont.apagado IS NULL
 		AND emp.apagado IS NULL 
 		`+conditions,
@@ -124,6 +135,7 @@ func (r *repository) FindAll(ctx context.Context, search, empresa, tipo, contato
 
 	for rows.Next() {
 		var contato = models.ContatoEmpresa{}
+		var pessoa = pessoaContato{}
 
 		err := rows.Scan(
 			&contato.ID,
@@ -133,6 +145,10 @@ func (r *repository) FindAll(ctx context.Context, search, empresa, tipo, contato
 			&contato.Empresa.Criado,
 			&contato.Empresa.Atualizado,
 			&contato.Empresa.Apagado,
+			&contato.IDPessoa,
+			&pessoa.Nome,
+			&pessoa.Cargo,
+			&pessoa.Departamento,
 			&contato.Tipo,
 			&contato.Contato,
 			&contato.Criado,
@@ -145,6 +161,9 @@ func (r *repository) FindAll(ctx context.Context, search, empresa, tipo, contato
 			return []models.ContatoEmpresa{}, err
 		}
 
+		contato.IDEmpresa = contato.Empresa.ID
+		contato.Pessoa = pessoa.Pessoa(contato)
+
 		contatos = append(contatos, contato)
 	}
 
@@ -162,6 +181,10 @@ func (r *repository) FindByID(ctx context.Context, id string) (models.ContatoEmp
 			emp.criado,
 			emp.atualizado,
 			emp.apagado,
+			cont.id_pessoa,
+			pes.nome,
+			pes.cargo,
+			pes.departamento,
 			cont.tipo,
 			cont.contato,
 			cont.criado,
@@ -169,6 +192,7 @@ func (r *repository) FindByID(ctx context.Context, id string) (models.ContatoEmp
 			cont.apagado
 		FROM contato_empresa cont
 		JOIN empresas emp ON emp.id = cont.id_empresa
+		LEFT JOIN pessoas pes ON pes.id = cont.id_pessoa AND pes.apagado IS NULL
 		WHERE cont.apagado IS NULL
 		AND emp.apagado IS NULL 
 		AND cont.id = ?`,
@@ -183,6 +207,7 @@ func (r *repository) FindByID(ctx context.Context, id string) (models.ContatoEmp
 	defer rows.Close()
 
 	var contato = models.ContatoEmpresa{}
+	var pessoa = pessoaContato{}
 
 	for rows.Next() {
 		err := rows.Scan(
@@ -193,6 +218,10 @@ func (r *repository) FindByID(ctx context.Context, id string) (models.ContatoEmp
 			&contato.Empresa.Criado,
 			&contato.Empresa.Atualizado,
 			&contato.Empresa.Apagado,
+			&contato.IDPessoa,
+			&pessoa.Nome,
+			&pessoa.Cargo,
+			&pessoa.Departamento,
 			&contato.Tipo,
 			&contato.Contato,
 			&contato.Criado,
@@ -204,6 +233,9 @@ func (r *repository) FindByID(ctx context.Context, id string) (models.ContatoEmp
 			log.Error(repositories.ERROR_SELECT_SCAN, err)
 			return models.ContatoEmpresa{}, err
 		}
+
+		contato.IDEmpresa = contato.Empresa.ID
+		contato.Pessoa = pessoa.Pessoa(contato)
 	}
 
 	return contato, nil
@@ -216,11 +248,13 @@ func (r *repository) Update(ctx context.Context, contato models.ContatoEmpresa)
 		ctx,
 		`UPDATE contato_empresa SET 
 		id_empresa = ?, 
+		id_pessoa = ?,
 		tipo = ?, 
 		contato = ?,
 		atualizado = ?
 		WHERE id = ?`,
 		contato.IDEmpresa,
+		contato.IDPessoa,
 		contato.Tipo,
 		contato.Contato,
 		contato.Atualizado,
@@ -262,3 +296,25 @@ func (r *repository) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+// pessoaContato recebe as colunas da pessoa do contato, nulas quando o
+// contato é geral da empresa.
+type pessoaContato struct {
+	Nome         *string
+	Cargo        *string
+	Departamento *string
+}
+
+func (p pessoaContato) Pessoa(contato models.ContatoEmpresa) *models.Pessoa {
+	if contato.IDPessoa == nil || p.Nome == nil {
+		return nil
+	}
+
+	return &models.Pessoa{
+		ID:           *contato.IDPessoa,
+		IDEmpresa:    contato.IDEmpresa,
+		Nome:         *p.Nome,
+		Cargo:        p.Cargo,
+		Departamento: p.Departamento,
+	}
+}
@@ -0,0 +1,206 @@
+package convencaocoletiva
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, convencao models.ConvencaoColetiva) (models.ConvencaoColetiva, error)
+	FindByEmpresa(ctx context.Context, id_empresa string) ([]models.ConvencaoColetiva, error)
+	FindByID(ctx context.Context, id_empresa, id string) (models.ConvencaoColetiva, error)
+	Update(ctx context.Context, convencao models.ConvencaoColetiva) error
+	Delete(ctx context.Context, id_empresa, id string) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, convencao models.ConvencaoColetiva) (models.ConvencaoColetiva, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO convencoes_coletivas(id_empresa, id_sindicato, ano, percentual, observacao, criado)
+		VALUES(?, ?, ?, ?, ?, ?)`,
+		convencao.IDEmpresa,
+		convencao.IDSindicato,
+		convencao.Ano,
+		convencao.Percentual,
+		convencao.Observacao,
+		convencao.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.ConvencaoColetiva{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.ConvencaoColetiva{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	convencao.ID = id
+
+	return convencao, nil
+}
+
+// FindByEmpresa retorna as convenções da empresa com o sindicato de cada uma,
+// da mais recente para a mais antiga.
+func (r *repository) FindByEmpresa(ctx context.Context, id_empresa string) ([]models.ConvencaoColetiva, error) {
+	return r.find(ctx, " AND cct.id_empresa = ?", id_empresa)
+}
+
+func (r *repository) FindByID(ctx context.Context, id_empresa, id string) (models.ConvencaoColetiva, error) {
+	convencoes, err := r.find(ctx, " AND cct.id_empresa = ? AND cct.id = ?", id_empresa, id)
+	if err != nil || len(convencoes) == 0 {
+		return models.ConvencaoColetiva{}, err
+	}
+
+	return convencoes[0], nil
+}
+
+func (r *repository) find(ctx context.Context, conditions string, arguments ...interface{}) ([]models.ConvencaoColetiva, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			cct.id,
+			cct.id_empresa,
+			cct.id_sindicato,
+			cct.ano,
+			cct.percentual,
+			cct.observacao,
+			cct.criado,
+			cct.atualizado,
+			cct.apagado,
+			sin.nome,
+			sin.cnpj,
+			sin.mes_data_base
+		FROM convencoes_coletivas cct
+		JOIN sindicatos sin ON sin.id = cct.id_sindicato
+		WHERE cct.apagado IS NULL
+		`+conditions+`
+		ORDER BY cct.ano DESC, cct.id`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.ConvencaoColetiva{}, err
+	}
+
+	defer rows.Close()
+
+	var convencoes []models.ConvencaoColetiva
+
+	for rows.Next() {
+		var convencao = models.ConvencaoColetiva{
+			Sindicato: &models.Sindicato{},
+		}
+
+		err := rows.Scan(
+			&convencao.ID,
+			&convencao.IDEmpresa,
+			&convencao.IDSindicato,
+			&convencao.Ano,
+			&convencao.Percentual,
+			&convencao.Observacao,
+			&convencao.Criado,
+			&convencao.Atualizado,
+			&convencao.Apagado,
+			&convencao.Sindicato.Nome,
+			&convencao.Sindicato.CNPJ,
+			&convencao.Sindicato.MesDataBase,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.ConvencaoColetiva{}, err
+		}
+
+		convencao.Sindicato.ID = convencao.IDSindicato
+
+		convencoes = append(convencoes, convencao)
+	}
+
+	return convencoes, nil
+}
+
+func (r *repository) Update(ctx context.Context, convencao models.ConvencaoColetiva) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE convencoes_coletivas SET
+		id_sindicato = ?,
+		ano = ?,
+		percentual = ?,
+		observacao = ?,
+		atualizado = ?
+		WHERE id_empresa = ?
+		AND id = ?`,
+		convencao.IDSindicato,
+		convencao.Ano,
+		convencao.Percentual,
+		convencao.Observacao,
+		convencao.Atualizado,
+		convencao.IDEmpresa,
+		convencao.ID,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id_empresa, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE convencoes_coletivas SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id_empresa = ?
+		AND id = ?`,
+		id_empresa,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package correcaoponto
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, correcao models.CorrecaoPonto) (models.CorrecaoPonto, error)
+	FindByEmprego(ctx context.Context, id_emprego, situacao string) ([]models.CorrecaoPonto, error)
+	FindByID(ctx context.Context, id_emprego, id string) (models.CorrecaoPonto, error)
+	FindAprovadas(ctx context.Context, id_emprego string, inicio, fim time.Time) ([]models.CorrecaoPonto, error)
+	Decidir(ctx context.Context, correcao models.CorrecaoPonto) error
+	Delete(ctx context.Context, id_emprego, id string) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, correcao models.CorrecaoPonto) (models.CorrecaoPonto, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO correcoes_ponto(id_emprego, id_cartao_ponto, acao, horario, tipo, justificativa, situacao, criado)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?)`,
+		correcao.IDEmprego,
+		correcao.IDCartaoPonto,
+		correcao.Acao,
+		correcao.Horario,
+		correcao.Tipo,
+		correcao.Justificativa,
+		correcao.Situacao,
+		correcao.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.CorrecaoPonto{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.CorrecaoPonto{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	correcao.ID = id
+
+	return correcao, nil
+}
+
+// FindByEmprego retorna as correções do emprego, opcionalmente filtradas pela
+// situação.
+func (r *repository) FindByEmprego(ctx context.Context, id_emprego, situacao string) ([]models.CorrecaoPonto, error) {
+	if situacao != "" {
+		return r.find(ctx, " AND cor.id_emprego = ? AND cor.situacao = ?", id_emprego, situacao)
+	}
+
+	return r.find(ctx, " AND cor.id_emprego = ?", id_emprego)
+}
+
+func (r *repository) FindByID(ctx context.Context, id_emprego, id string) (models.CorrecaoPonto, error) {
+	correcoes, err := r.find(ctx, " AND cor.id_emprego = ? AND cor.id = ?", id_emprego, id)
+	if err != nil || len(correcoes) == 0 {
+		return models.CorrecaoPonto{}, err
+	}
+
+	return correcoes[0], nil
+}
+
+// FindAprovadas retorna as correções aprovadas do emprego cujo novo horário ou
+// cuja marcação original estão entre o início do dia inicial e o fim do dia
+// final.
+func (r *repository) FindAprovadas(ctx context.Context, id_emprego string, inicio, fim time.Time) ([]models.CorrecaoPonto, error) {
+	de := time.Date(inicio.Year(), inicio.Month(), inicio.Day(), 0, 0, 0, 0, time.Local)
+	ate := time.Date(fim.Year(), fim.Month(), fim.Day()+1, 0, 0, 0, 0, time.Local)
+
+	return r.find(
+		ctx,
+		` AND cor.id_emprego = ?
+		AND cor.situacao = ?
+		AND ((cor.horario >= ? AND cor.horario < ?) OR (pto.horario >= ? AND pto.horario < ?))`,
+		id_emprego,
+		models.CORRECAO_APROVADA,
+		de,
+		ate,
+		de,
+		ate,
+	)
+}
+
+func (r *repository) find(ctx context.Context, conditions string, arguments ...interface{}) ([]models.CorrecaoPonto, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			cor.id,
+			cor.id_emprego,
+			cor.id_cartao_ponto,
+			cor.acao,
+			cor.horario,
+			cor.tipo,
+			cor.justificativa,
+			cor.situacao,
+			cor.motivo_decisao,
+			cor.decidido,
+			cor.criado,
+			cor.atualizado,
+			cor.apagado,
+			pto.id,
+			pto.horario,
+			pto.tipo
+		FROM correcoes_ponto cor
+		LEFT JOIN cartao_ponto pto ON pto.id = cor.id_cartao_ponto
+		WHERE cor.apagado IS NULL
+		`+conditions+`
+		ORDER BY cor.criado, cor.id`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.CorrecaoPonto{}, err
+	}
+
+	defer rows.Close()
+
+	var correcoes []models.CorrecaoPonto
+
+	for rows.Next() {
+		var correcao = models.CorrecaoPonto{}
+		var idOriginal *int64
+		var horarioOriginal *time.Time
+		var tipoOriginal *string
+
+		err := rows.Scan(
+			&correcao.ID,
+			&correcao.IDEmprego,
+			&correcao.IDCartaoPonto,
+			&correcao.Acao,
+			&correcao.Horario,
+			&correcao.Tipo,
+			&correcao.Justificativa,
+			&correcao.Situacao,
+			&correcao.MotivoDecisao,
+			&correcao.Decidido,
+			&correcao.Criado,
+			&correcao.Atualizado,
+			&correcao.Apagado,
+			&idOriginal,
+			&horarioOriginal,
+			&tipoOriginal,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.CorrecaoPonto{}, err
+		}
+
+		if idOriginal != nil {
+			correcao.Original = &models.CartaoPonto{
+				ID:        *idOriginal,
+				IDEmprego: correcao.IDEmprego,
+				Horario:   *horarioOriginal,
+				Tipo:      *tipoOriginal,
+			}
+		}
+
+		correcoes = append(correcoes, correcao)
+	}
+
+	return correcoes, nil
+}
+
+// Decidir registra a aprovação ou a rejeição de uma correção pendente.
+func (r *repository) Decidir(ctx context.Context, correcao models.CorrecaoPonto) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE correcoes_ponto SET
+		situacao = ?,
+		motivo_decisao = ?,
+		decidido = ?,
+		atualizado = ?
+		WHERE id_emprego = ?
+		AND id = ?
+		AND situacao = ?`,
+		correcao.Situacao,
+		correcao.MotivoDecisao,
+		correcao.Decidido,
+		correcao.Atualizado,
+		correcao.IDEmprego,
+		correcao.ID,
+		models.CORRECAO_PENDENTE,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id_emprego, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE correcoes_ponto SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id_emprego = ?
+		AND id = ?`,
+		id_emprego,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
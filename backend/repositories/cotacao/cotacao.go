@@ -0,0 +1,149 @@
+package cotacao
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	FindAll(ctx context.Context, moeda, inicio, fim string) ([]models.Cotacao, error)
+	Importar(ctx context.Context, cotacoes []models.Cotacao) (int, int, error)
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+// FindAll retorna as cotações da moeda ordenadas por data, opcionalmente
+// entre as datas de início e fim, no formato YYYY-MM-DD.
+func (r *repository) FindAll(ctx context.Context, moeda, inicio, fim string) ([]models.Cotacao, error) {
+	arguments := []interface{}{moeda}
+
+	conditions := ""
+
+	if inicio != "" {
+		conditions += " AND cot.data >= STR_TO_DATE(?, '%Y-%m-%d')"
+		arguments = append(arguments, inicio)
+	}
+
+	if fim != "" {
+		conditions += " AND cot.data <= STR_TO_DATE(?, '%Y-%m-%d')"
+		arguments = append(arguments, fim)
+	}
+
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			cot.id,
+			cot.moeda,
+			cot.data,
+			cot.compra,
+			cot.venda,
+			cot.criado,
+			cot.atualizado,
+			cot.apagado
+		FROM cotacoes cot
+		WHERE cot.apagado IS NULL
+		AND cot.moeda = ?
+		`+conditions+`
+		ORDER BY cot.data`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Cotacao{}, err
+	}
+
+	defer rows.Close()
+
+	var cotacoes []models.Cotacao
+
+	for rows.Next() {
+		var cotacao = models.Cotacao{}
+
+		err := rows.Scan(
+			&cotacao.ID,
+			&cotacao.Moeda,
+			&cotacao.Data,
+			&cotacao.Compra,
+			&cotacao.Venda,
+			&cotacao.Criado,
+			&cotacao.Atualizado,
+			&cotacao.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Cotacao{}, err
+		}
+
+		cotacoes = append(cotacoes, cotacao)
+	}
+
+	return cotacoes, nil
+}
+
+// Importar insere as cotações em uma única transação, atualizando as dos dias
+// já importados. Retorna a quantidade de cotações inseridas e atualizadas.
+func (r *repository) Importar(ctx context.Context, cotacoes []models.Cotacao) (int, int, error) {
+	r.DB().BeginTransaction(ctx)
+
+	inseridas := 0
+	atualizadas := 0
+
+	for _, cotacao := range cotacoes {
+		result, err := r.DB().Write(
+			ctx,
+			`INSERT INTO cotacoes(moeda, data, compra, venda, criado)
+			VALUES(?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+			atualizado = IF(compra <> VALUES(compra) OR venda <> VALUES(venda) OR apagado IS NOT NULL, VALUES(criado), atualizado),
+			compra = VALUES(compra),
+			venda = VALUES(venda),
+			apagado = NULL`,
+			cotacao.Moeda,
+			cotacao.Data,
+			cotacao.Compra,
+			cotacao.Venda,
+			cotacao.Criado,
+		)
+
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return 0, 0, err
+		}
+
+		afetadas, err := result.RowsAffected()
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return 0, 0, err
+		}
+
+		// O MySQL informa 1 linha afetada para inserções e 2 para atualizações.
+		switch afetadas {
+		case 1:
+			inseridas++
+		case 2:
+			atualizadas++
+		}
+	}
+
+	r.DB().Commit(ctx)
+
+	return inseridas, atualizadas, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/charmbracelet/log"
 
+	"tsukuyomi/database"
 	"tsukuyomi/models"
 	"tsukuyomi/repositories"
 )
@@ -31,13 +32,31 @@ func NewRepository(repo repositories.Repository) Repository {
 func (r *repository) Create(ctx context.Context, emprego models.Emprego) (models.Emprego, error) {
 	r.DB().BeginTransaction(ctx)
 
-	result, err := r.DB().Write(
+	id, err := Inserir(ctx, r.DB(), emprego)
+	if err != nil {
+		r.DB().Rollback(ctx)
+		return models.Emprego{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	emprego.ID = id
+
+	return emprego, nil
+}
+
+// Inserir grava o emprego na transação em andamento e retorna o ID gerado,
+// para que outros repositórios criem empregos na mesma transação. Quem abriu
+// a transação a desfaz em caso de erro.
+func Inserir(ctx context.Context, db database.DatabaseService, emprego models.Emprego) (int64, error) {
+	result, err := db.Write(
 		ctx,
-		`INSERT INTO empregos(id_empresa, ocupacao, remuneracao_inicial, tipo_contrato, data_inicio, data_fim, carga_horaria, criado)
-		VALUES(?, ?, ?, ?, ?, ?, ?, ?)`,
+		`INSERT INTO empregos(id_empresa, ocupacao, remuneracao_inicial, moeda, tipo_contrato, data_inicio, data_fim, carga_horaria, criado)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		emprego.IDEmpresa,
 		emprego.Ocupacao,
 		emprego.RemuneracaoInicial,
+		emprego.Moeda,
 		emprego.TipoContrato,
 		emprego.DataInicio,
 		emprego.DataFim,
@@ -46,25 +65,17 @@ func (r *repository) Create(ctx context.Context, emprego models.Emprego) (models
 	)
 
 	if err != nil {
-		r.DB().Rollback(ctx)
-
 		log.Error(repositories.ERROR_INSERT, err)
-		return models.Emprego{}, err
+		return 0, err
 	}
 
 	id, err := result.LastInsertId()
 	if err != nil {
-		r.DB().Rollback(ctx)
-
 		log.Error(repositories.ERROR_INSERT, err)
-		return models.Emprego{}, err
+		return 0, err
 	}
 
-	r.DB().Commit(ctx)
-
-	emprego.ID = id
-
-	return emprego, nil
+	return id, nil
 }
 
 func (r *repository) FindAll(ctx context.Context, search, empresa, ocupacao, remuneracao_inicial, tipo_contrato, data_inicio, data_fim, carga_horaria string) ([]models.Emprego, error) {
@@ -80,8 +91,8 @@ func (r *repository) FindAll(ctx context.Context, search, empresa, ocupacao, rem
 	}
 
 	if empresa != "" {
-		conditions += " AND (cont.id_empresa = ? OR emp.nome = ?)"
-		arguments = append(arguments, empresa)
+		conditions += " AND (emp.id = ? OR emp.nome = ?)"
+		arguments = append(arguments, empresa, empresa)
 	}
 
 	if ocupacao != "" {
@@ -105,7 +116,7 @@ func (r *repository) FindAll(ctx context.Context, search, empresa, ocupacao, rem
 	}
 
 	if data_fim != "" {
-		conditions += " AND (job.data_fim = STR_TO_DATE(?, '%Y-%m-%d')))"
+		conditions += " AND (job.data_fim = STR_TO_DATE(?, '%Y-%m-%d'))"
 		arguments = append(arguments, data_fim)
 	}
 
@@ -126,6 +137,7 @@ func (r *repository) FindAll(ctx context.Context, search, empresa, ocupacao, rem
 			emp.apagado,
 			job.ocupacao,
 			job.remuneracao_inicial,
+			job.moeda,
 			job.tipo_contrato,
 			job.data_inicio,
 			job.data_fim,
@@ -163,6 +175,7 @@ func (r *repository) FindAll(ctx context.Context, search, empresa, ocupacao, rem
 			&emprego.Empresa.Apagado,
 			&emprego.Ocupacao,
 			&emprego.RemuneracaoInicial,
+			&emprego.Moeda,
 			&emprego.TipoContrato,
 			&emprego.DataInicio,
 			&emprego.DataFim,
@@ -201,6 +214,7 @@ func (r *repository) FindByID(ctx context.Context, id string) (models.Emprego, e
 			emp.apagado,
 			job.ocupacao,
 			job.remuneracao_inicial,
+			job.moeda,
 			job.tipo_contrato,
 			job.data_inicio,
 			job.data_fim,
@@ -230,13 +244,13 @@ func (r *repository) FindByID(ctx context.Context, id string) (models.Emprego, e
 			&emprego.ID,
 			&emprego.Empresa.ID,
 			&emprego.Empresa.Nome,
-			&emprego.Empresa.Nome,
 			&emprego.Empresa.CNPJ,
 			&emprego.Empresa.Criado,
 			&emprego.Empresa.Atualizado,
 			&emprego.Empresa.Apagado,
 			&emprego.Ocupacao,
 			&emprego.RemuneracaoInicial,
+			&emprego.Moeda,
 			&emprego.TipoContrato,
 			&emprego.DataInicio,
 			&emprego.DataFim,
@@ -264,15 +278,17 @@ func (r *repository) Update(ctx context.Context, emprego models.Emprego) error {
 		id_empresa = ?, 
 		ocupacao = ?, 
 		remuneracao_inicial = ?,
+		moeda = ?,
 		tipo_contrato = ?,
 		data_inicio = ?,
 		data_fim = ?,
 		carga_horaria = ?,
-		atualizado = ?,
+		atualizado = ?
 		WHERE id = ?`,
 		emprego.IDEmpresa,
 		emprego.Ocupacao,
 		emprego.RemuneracaoInicial,
+		emprego.Moeda,
 		emprego.TipoContrato,
 		emprego.DataInicio,
 		emprego.DataFim,
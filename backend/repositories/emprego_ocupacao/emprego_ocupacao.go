@@ -0,0 +1,224 @@
+package empregoocupacao
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, historico models.EmpregoOcupacao) (models.EmpregoOcupacao, error)
+	FindByEmprego(ctx context.Context, id_emprego string) ([]models.EmpregoOcupacao, error)
+	Delete(ctx context.Context, id_emprego, id string) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+// Create registra a mudança de ocupação e, quando informada, a nova
+// remuneração na mesma data, em uma única transação. Se a ocupação for a mais
+// recente do emprego, o nome da ocupação do emprego também é atualizado.
+func (r *repository) Create(ctx context.Context, historico models.EmpregoOcupacao) (models.EmpregoOcupacao, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO emprego_ocupacoes(id_emprego, id_ocupacao, data_inicio, observacao, criado)
+		VALUES(?, ?, ?, ?, ?)`,
+		historico.IDEmprego,
+		historico.IDOcupacao,
+		historico.DataInicio,
+		historico.Observacao,
+		historico.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.EmpregoOcupacao{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.EmpregoOcupacao{}, err
+	}
+
+	historico.ID = id
+	historico.Remuneracoes = []models.Remuneracao{}
+
+	if historico.Remuneracao != nil {
+		remuneracao := models.Remuneracao{
+			IDEmprego:   historico.IDEmprego,
+			IDOcupacao:  historico.IDOcupacao,
+			Remuneracao: *historico.Remuneracao,
+			Moeda:       historico.Moeda,
+			Data:        historico.DataInicio,
+			Criado:      historico.Criado,
+		}
+
+		result, err := r.DB().Write(
+			ctx,
+			`INSERT INTO remuneracoes(id_emprego, id_ocupacao, remuneracao, moeda, data, criado)
+			VALUES(?, ?, ?, ?, ?, ?)`,
+			remuneracao.IDEmprego,
+			remuneracao.IDOcupacao,
+			remuneracao.Remuneracao,
+			remuneracao.Moeda,
+			remuneracao.Data,
+			remuneracao.Criado,
+		)
+
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return models.EmpregoOcupacao{}, err
+		}
+
+		remuneracao.ID, err = result.LastInsertId()
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return models.EmpregoOcupacao{}, err
+		}
+
+		historico.Remuneracoes = append(historico.Remuneracoes, remuneracao)
+	}
+
+	_, err = r.DB().Write(
+		ctx,
+		`UPDATE empregos SET
+		ocupacao = ?,
+		atualizado = ?
+		WHERE id = ?
+		AND NOT EXISTS (
+			SELECT 1 FROM emprego_ocupacoes
+			WHERE apagado IS NULL
+			AND id_emprego = ?
+			AND data_inicio > ?
+		)`,
+		historico.Ocupacao.Nome,
+		historico.Criado,
+		historico.IDEmprego,
+		historico.IDEmprego,
+		historico.DataInicio,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return models.EmpregoOcupacao{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	return historico, nil
+}
+
+// FindByEmprego retorna o histórico de ocupações do emprego ordenado pela data
+// de início.
+func (r *repository) FindByEmprego(ctx context.Context, id_emprego string) ([]models.EmpregoOcupacao, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			eoc.id,
+			eoc.id_emprego,
+			eoc.id_ocupacao,
+			ocp.nome,
+			ocp.cbo,
+			ocp.criado,
+			eoc.data_inicio,
+			eoc.observacao,
+			eoc.criado,
+			eoc.atualizado,
+			eoc.apagado
+		FROM emprego_ocupacoes eoc
+		JOIN ocupacoes ocp ON ocp.id = eoc.id_ocupacao
+		WHERE eoc.apagado IS NULL
+		AND eoc.id_emprego = ?
+		ORDER BY eoc.data_inicio, eoc.id`,
+		id_emprego,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.EmpregoOcupacao{}, err
+	}
+
+	defer rows.Close()
+
+	var historico []models.EmpregoOcupacao
+
+	for rows.Next() {
+		var ocupacao = models.EmpregoOcupacao{
+			Remuneracoes: []models.Remuneracao{},
+		}
+
+		err := rows.Scan(
+			&ocupacao.ID,
+			&ocupacao.IDEmprego,
+			&ocupacao.IDOcupacao,
+			&ocupacao.Ocupacao.Nome,
+			&ocupacao.Ocupacao.CBO,
+			&ocupacao.Ocupacao.Criado,
+			&ocupacao.DataInicio,
+			&ocupacao.Observacao,
+			&ocupacao.Criado,
+			&ocupacao.Atualizado,
+			&ocupacao.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.EmpregoOcupacao{}, err
+		}
+
+		ocupacao.Ocupacao.ID = ocupacao.IDOcupacao
+
+		historico = append(historico, ocupacao)
+	}
+
+	return historico, nil
+}
+
+func (r *repository) Delete(ctx context.Context, id_emprego, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE emprego_ocupacoes SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id_emprego = ?
+		AND id = ?`,
+		id_emprego,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
@@ -3,9 +3,12 @@ package empresa
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/log"
 
+	"tsukuyomi/database"
 	"tsukuyomi/models"
 	"tsukuyomi/repositories"
 )
@@ -14,7 +17,9 @@ type Repository interface {
 	Create(ctx context.Context, empresa models.Empresa) (models.Empresa, error)
 	FindAll(ctx context.Context, search, nome, cnpj string) ([]models.Empresa, error)
 	FindByID(ctx context.Context, id string) (models.Empresa, error)
+	FindByRaizCNPJ(ctx context.Context, raiz string) ([]models.Empresa, error)
 	Update(ctx context.Context, empresa models.Empresa) error
+	Mesclar(ctx context.Context, id int64, duplicadas []int64, atualizado time.Time) error
 	Delete(ctx context.Context, id string) error
 }
 
@@ -31,7 +36,23 @@ func NewRepository(repo repositories.Repository) Repository {
 func (r *repository) Create(ctx context.Context, empresa models.Empresa) (models.Empresa, error) {
 	r.DB().BeginTransaction(ctx)
 
-	result, err := r.DB().Write(
+	id, err := Inserir(ctx, r.DB(), empresa)
+	if err != nil {
+		r.DB().Rollback(ctx)
+		return models.Empresa{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	empresa.ID = id
+
+	return empresa, nil
+}
+
+// Inserir grava a empresa na transação em andamento e retorna o ID gerado.
+// Não confirma nem desfaz a transação, que fica a cargo de quem a abriu.
+func Inserir(ctx context.Context, db database.DatabaseService, empresa models.Empresa) (int64, error) {
+	result, err := db.Write(
 		ctx,
 		`INSERT INTO empresas(nome, cnpj, criado)
 		VALUES(?, ?, ?)`,
@@ -41,25 +62,17 @@ func (r *repository) Create(ctx context.Context, empresa models.Empresa) (models
 	)
 
 	if err != nil {
-		r.DB().Rollback(ctx)
-
 		log.Error(repositories.ERROR_INSERT, err)
-		return models.Empresa{}, err
+		return 0, err
 	}
 
 	id, err := result.LastInsertId()
 	if err != nil {
-		r.DB().Rollback(ctx)
-
 		log.Error(repositories.ERROR_INSERT, err)
-		return models.Empresa{}, err
+		return 0, err
 	}
 
-	r.DB().Commit(ctx)
-
-	empresa.ID = id
-
-	return empresa, nil
+	return id, nil
 }
 
 func (r *repository) FindAll(ctx context.Context, search, nome, cnpj string) ([]models.Empresa, error) {
@@ -283,6 +296,57 @@ func (r *repository) FindByID(ctx context.Context, id string) (models.Empresa, e
 	return empresa, nil
 }
 
+// FindByRaizCNPJ retorna as empresas cujo CNPJ, com ou sem formatação, começa
+// pela raiz de oito dígitos informada, sem os endereços.
+func (r *repository) FindByRaizCNPJ(ctx context.Context, raiz string) ([]models.Empresa, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			emp.id,
+			emp.nome,
+			emp.cnpj,
+			emp.criado,
+			emp.atualizado,
+			emp.apagado
+		FROM empresas emp
+		WHERE emp.apagado IS NULL
+		AND LEFT(REGEXP_REPLACE(emp.cnpj, '[^0-9]', ''), 8) = ?
+		ORDER BY emp.cnpj`,
+		raiz,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Empresa{}, err
+	}
+
+	defer rows.Close()
+
+	var empresas []models.Empresa
+
+	for rows.Next() {
+		var empresa = models.Empresa{}
+
+		err := rows.Scan(
+			&empresa.ID,
+			&empresa.Nome,
+			&empresa.CNPJ,
+			&empresa.Criado,
+			&empresa.Atualizado,
+			&empresa.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Empresa{}, err
+		}
+
+		empresas = append(empresas, empresa)
+	}
+
+	return empresas, nil
+}
+
 func (r *repository) Update(ctx context.Context, empresa models.Empresa) error {
 	r.DB().BeginTransaction(ctx)
 
@@ -311,6 +375,185 @@ func (r *repository) Update(ctx context.Context, empresa models.Empresa) error {
 	return nil
 }
 
+// Mesclar transfere para a empresa os vínculos com endereços, as pessoas, os
+// contatos, os empregos, as candidaturas e as convenções coletivas das
+// empresas duplicadas e as apaga, em uma única transação. Vínculos com
+// endereços e contatos que ficariam repetidos são apagados, assim como as
+// convenções do mesmo sindicato e ano que a empresa já tiver.
+func (r *repository) Mesclar(ctx context.Context, id int64, duplicadas []int64, atualizado time.Time) error {
+	marcadores := strings.TrimSuffix(strings.Repeat("?, ", len(duplicadas)), ", ")
+
+	ids := make([]interface{}, 0, len(duplicadas))
+	for _, duplicada := range duplicadas {
+		ids = append(ids, duplicada)
+	}
+
+	r.DB().BeginTransaction(ctx)
+
+	for _, tabela := range []string{"endereco_empresa", "pessoas", "contato_empresa", "empregos", "candidaturas"} {
+		_, err := r.DB().Write(
+			ctx,
+			`UPDATE `+tabela+` SET
+			id_empresa = ?,
+			atualizado = ?
+			WHERE id_empresa IN (`+marcadores+`)`,
+			append([]interface{}{id, atualizado}, ids...)...,
+		)
+
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_UPDATE, err)
+			return err
+		}
+	}
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE endereco_empresa endemp
+		JOIN endereco_empresa anterior ON anterior.id_endereco = endemp.id_endereco
+			AND anterior.id_empresa = endemp.id_empresa
+			AND anterior.id < endemp.id
+			AND anterior.apagado IS NULL
+		SET
+		endemp.atualizado = ?,
+		endemp.apagado = ?
+		WHERE endemp.id_empresa = ?
+		AND endemp.apagado IS NULL`,
+		atualizado,
+		atualizado,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	_, err = r.DB().Write(
+		ctx,
+		`UPDATE contato_empresa ce
+		JOIN contato_empresa anterior ON anterior.id_empresa = ce.id_empresa
+			AND anterior.tipo = ce.tipo
+			AND anterior.contato = ce.contato
+			AND anterior.id < ce.id
+			AND anterior.apagado IS NULL
+		SET
+		ce.atualizado = ?,
+		ce.apagado = ?
+		WHERE ce.id_empresa = ?
+		AND ce.apagado IS NULL`,
+		atualizado,
+		atualizado,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	// A convenção é única por empresa, sindicato e ano, inclusive entre as
+	// apagadas. As apagadas da empresa que impediriam a transferência de uma
+	// convenção ativa são removidas.
+	_, err = r.DB().Write(
+		ctx,
+		`DELETE conv FROM convencoes_coletivas conv
+		JOIN convencoes_coletivas dup ON dup.id_sindicato = conv.id_sindicato
+			AND dup.ano = conv.ano
+			AND dup.id_empresa IN (`+marcadores+`)
+			AND dup.apagado IS NULL
+		WHERE conv.id_empresa = ?
+		AND conv.apagado IS NOT NULL`,
+		append(append([]interface{}{}, ids...), id)...,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	// Só é transferida uma convenção ativa por sindicato e ano, e apenas se a
+	// empresa ainda não tiver uma ativa; as demais ficam na duplicada e são
+	// apagadas com ela.
+	_, err = r.DB().Write(
+		ctx,
+		`UPDATE convencoes_coletivas SET
+		id_empresa = ?,
+		atualizado = ?
+		WHERE id_empresa IN (`+marcadores+`)
+		AND apagado IS NULL
+		AND id IN (
+			SELECT id FROM (
+				SELECT MIN(dup.id) AS id
+				FROM convencoes_coletivas dup
+				WHERE dup.id_empresa IN (`+marcadores+`)
+				AND dup.apagado IS NULL
+				AND NOT EXISTS (
+					SELECT 1
+					FROM convencoes_coletivas ativa
+					WHERE ativa.id_empresa = ?
+					AND ativa.id_sindicato = dup.id_sindicato
+					AND ativa.ano = dup.ano
+					AND ativa.apagado IS NULL
+				)
+				GROUP BY dup.id_sindicato, dup.ano
+			) transferiveis
+		)`,
+		append(append(append([]interface{}{id, atualizado}, ids...), ids...), id)...,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	_, err = r.DB().Write(
+		ctx,
+		`UPDATE convencoes_coletivas SET
+		atualizado = ?,
+		apagado = ?
+		WHERE id_empresa IN (`+marcadores+`)
+		AND apagado IS NULL`,
+		append([]interface{}{atualizado, atualizado}, ids...)...,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	_, err = r.DB().Write(
+		ctx,
+		`UPDATE empresas SET
+		atualizado = ?,
+		apagado = ?
+		WHERE id IN (`+marcadores+`)`,
+		append([]interface{}{atualizado, atualizado}, ids...)...,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
 func (r *repository) Delete(ctx context.Context, id string) error {
 	r.DB().BeginTransaction(ctx)
 
@@ -3,9 +3,12 @@ package endereco
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/log"
 
+	"tsukuyomi/database"
 	"tsukuyomi/models"
 	"tsukuyomi/repositories"
 )
@@ -14,7 +17,10 @@ type Repository interface {
 	Create(ctx context.Context, endereco models.Endereco) (models.Endereco, error)
 	FindAll(ctx context.Context, search, logradouro, numero, complemento, bairro, cidade, cep, estado string) ([]models.Endereco, error)
 	FindByID(ctx context.Context, id string) (models.Endereco, error)
+	FindByChave(ctx context.Context, chave string) (models.Endereco, error)
 	Update(ctx context.Context, endereco models.Endereco) error
+	Mesclar(ctx context.Context, id int64, duplicados []int64, atualizado time.Time) error
+	Normalizar(ctx context.Context, enderecos []models.Endereco, atualizado time.Time) error
 	Delete(ctx context.Context, id string) error
 }
 
@@ -31,10 +37,26 @@ func NewRepository(repo repositories.Repository) Repository {
 func (r *repository) Create(ctx context.Context, endereco models.Endereco) (models.Endereco, error) {
 	r.DB().BeginTransaction(ctx)
 
-	result, err := r.DB().Write(
+	id, err := Inserir(ctx, r.DB(), endereco)
+	if err != nil {
+		r.DB().Rollback(ctx)
+		return models.Endereco{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	endereco.ID = id
+
+	return endereco, nil
+}
+
+// Inserir grava o endereço, já normalizado, na transação em andamento e
+// retorna o ID gerado. Quem abriu a transação a confirma ou desfaz.
+func Inserir(ctx context.Context, db database.DatabaseService, endereco models.Endereco) (int64, error) {
+	result, err := db.Write(
 		ctx,
-		`INSERT INTO enderecos(logradouro, numero, complemento, bairro, cidade, cep, estado, criado)
-		VALUES(?, ?, ?, ?, ?, ?, ?, ?)`,
+		`INSERT INTO enderecos(logradouro, numero, complemento, bairro, cidade, cep, estado, chave, criado)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		endereco.Logradouro,
 		endereco.Numero,
 		endereco.Complemento,
@@ -42,29 +64,22 @@ func (r *repository) Create(ctx context.Context, endereco models.Endereco) (mode
 		endereco.Cidade,
 		endereco.CEP,
 		endereco.Estado,
+		endereco.Chave,
 		endereco.Criado,
 	)
 
 	if err != nil {
-		r.DB().Rollback(ctx)
-
 		log.Error(repositories.ERROR_INSERT, err)
-		return models.Endereco{}, err
+		return 0, err
 	}
 
 	id, err := result.LastInsertId()
 	if err != nil {
-		r.DB().Rollback(ctx)
-
 		log.Error(repositories.ERROR_INSERT, err)
-		return models.Endereco{}, err
+		return 0, err
 	}
 
-	r.DB().Commit(ctx)
-
-	endereco.ID = id
-
-	return endereco, nil
+	return id, nil
 }
 
 func (r *repository) FindAll(ctx context.Context, search, logradouro, numero, complemento, bairro, cidade, cep, estado string) ([]models.Endereco, error) {
@@ -315,6 +330,41 @@ func (r *repository) FindByID(ctx context.Context, id string) (models.Endereco,
 	return endereco, nil
 }
 
+// FindByChave retorna o endereço não apagado com a chave informada. Sem
+// resultado, o ID do endereço é zero.
+func (r *repository) FindByChave(ctx context.Context, chave string) (models.Endereco, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT id
+		FROM enderecos
+		WHERE apagado IS NULL
+		AND chave = ?`,
+		chave,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return models.Endereco{}, err
+	}
+
+	defer rows.Close()
+
+	var id int64
+
+	if rows.Next() {
+		if err := rows.Scan(&id); err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return models.Endereco{}, err
+		}
+	}
+
+	if id == 0 {
+		return models.Endereco{}, nil
+	}
+
+	return r.FindByID(ctx, fmt.Sprint(id))
+}
+
 func (r *repository) Update(ctx context.Context, endereco models.Endereco) error {
 	r.DB().BeginTransaction(ctx)
 
@@ -328,6 +378,7 @@ func (r *repository) Update(ctx context.Context, endereco models.Endereco) error
 		cidade = ?, 
 		cep = ?, 
 		estado = ?, 
+		chave = ?,
 		atualizado = ?
 		WHERE id = ?`,
 		endereco.Logradouro,
@@ -337,6 +388,7 @@ func (r *repository) Update(ctx context.Context, endereco models.Endereco) error
 		endereco.Cidade,
 		endereco.CEP,
 		endereco.Estado,
+		endereco.Chave,
 		endereco.Atualizado,
 		endereco.ID,
 	)
@@ -353,12 +405,164 @@ func (r *repository) Update(ctx context.Context, endereco models.Endereco) error
 	return nil
 }
 
+// Mesclar transfere para o endereço os vínculos com empresas e as entrevistas
+// dos endereços duplicados e os apaga, em uma única transação. Vínculos que
+// ficariam repetidos, com a mesma empresa, são apagados.
+func (r *repository) Mesclar(ctx context.Context, id int64, duplicados []int64, atualizado time.Time) error {
+	marcadores := strings.TrimSuffix(strings.Repeat("?, ", len(duplicados)), ", ")
+
+	ids := make([]interface{}, 0, len(duplicados))
+	for _, duplicado := range duplicados {
+		ids = append(ids, duplicado)
+	}
+
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE endereco_empresa SET
+		id_endereco = ?,
+		atualizado = ?
+		WHERE id_endereco IN (`+marcadores+`)`,
+		append([]interface{}{id, atualizado}, ids...)...,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	_, err = r.DB().Write(
+		ctx,
+		`UPDATE endereco_empresa endemp
+		JOIN endereco_empresa anterior ON anterior.id_empresa = endemp.id_empresa
+			AND anterior.id_endereco = endemp.id_endereco
+			AND anterior.id < endemp.id
+			AND anterior.apagado IS NULL
+		SET
+		endemp.atualizado = ?,
+		endemp.apagado = ?
+		WHERE endemp.id_endereco = ?
+		AND endemp.apagado IS NULL`,
+		atualizado,
+		atualizado,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	_, err = r.DB().Write(
+		ctx,
+		`UPDATE entrevistas SET
+		id_endereco = ?,
+		atualizado = ?
+		WHERE id_endereco IN (`+marcadores+`)`,
+		append([]interface{}{id, atualizado}, ids...)...,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	_, err = r.DB().Write(
+		ctx,
+		`UPDATE enderecos SET
+		chave = NULL,
+		atualizado = ?,
+		apagado = ?
+		WHERE id IN (`+marcadores+`)`,
+		append([]interface{}{atualizado, atualizado}, ids...)...,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+// Normalizar grava os endereços normalizados e suas chaves em uma única
+// transação. As chaves dos endereços não apagados são limpas antes, para que
+// uma chave possa passar de um endereço para outro; endereços com a chave
+// vazia ficam sem chave.
+func (r *repository) Normalizar(ctx context.Context, enderecos []models.Endereco, atualizado time.Time) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE enderecos SET
+		chave = NULL
+		WHERE apagado IS NULL`,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	for _, endereco := range enderecos {
+		_, err := r.DB().Write(
+			ctx,
+			`UPDATE enderecos SET
+			logradouro = ?,
+			numero = ?,
+			complemento = ?,
+			bairro = ?,
+			cidade = ?,
+			cep = ?,
+			estado = ?,
+			chave = NULLIF(?, ''),
+			atualizado = ?
+			WHERE id = ?`,
+			endereco.Logradouro,
+			endereco.Numero,
+			endereco.Complemento,
+			endereco.Bairro,
+			endereco.Cidade,
+			endereco.CEP,
+			endereco.Estado,
+			endereco.Chave,
+			atualizado,
+			endereco.ID,
+		)
+
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_UPDATE, err)
+			return err
+		}
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
 func (r *repository) Delete(ctx context.Context, id string) error {
 	r.DB().BeginTransaction(ctx)
 
 	_, err := r.DB().Write(
 		ctx,
 		`UPDATE enderecos SET 
+		chave = NULL,
 		atualizado = CURRENT_DATE(),
 		apagado = CURRENT_DATE()
 		WHERE id = ?`,
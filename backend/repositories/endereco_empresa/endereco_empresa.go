@@ -6,6 +6,7 @@ import (
 
 	"github.com/charmbracelet/log"
 
+	"tsukuyomi/database"
 	"tsukuyomi/models"
 	"tsukuyomi/repositories"
 )
@@ -35,7 +36,23 @@ func (r *repository) Assign(ctx context.Context, empresa models.Empresa, enderec
 
 	r.DB().BeginTransaction(ctx)
 
-	result, err := r.DB().Write(
+	id, err := Inserir(ctx, r.DB(), enderecoEmpresa)
+	if err != nil {
+		r.DB().Rollback(ctx)
+		return models.EnderecoEmpresa{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	enderecoEmpresa.ID = id
+
+	return enderecoEmpresa, nil
+}
+
+// Inserir associa o endereço à empresa na transação em andamento e retorna
+// o ID da associação, sem confirmar nem desfazer a transação.
+func Inserir(ctx context.Context, db database.DatabaseService, enderecoEmpresa models.EnderecoEmpresa) (int64, error) {
+	result, err := db.Write(
 		ctx,
 		`INSERT INTO endereco_empresa(id_empresa, id_endereco, criado)
 		VALUES(?, ?, ?)`,
@@ -45,25 +62,17 @@ func (r *repository) Assign(ctx context.Context, empresa models.Empresa, enderec
 	)
 
 	if err != nil {
-		r.DB().Rollback(ctx)
-
 		log.Error(repositories.ERROR_INSERT, err)
-		return models.EnderecoEmpresa{}, err
+		return 0, err
 	}
 
 	id, err := result.LastInsertId()
 	if err != nil {
-		r.DB().Rollback(ctx)
-
 		log.Error(repositories.ERROR_INSERT, err)
-		return models.EnderecoEmpresa{}, err
+		return 0, err
 	}
 
-	r.DB().Commit(ctx)
-
-	enderecoEmpresa.ID = id
-
-	return enderecoEmpresa, nil
+	return id, nil
 }
 
 func (r *repository) GetEmpresasByEndereco(ctx context.Context, id_endereco string) ([]models.Empresa, error) {
@@ -0,0 +1,350 @@
+package entrevista
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, entrevista models.Entrevista) (models.Entrevista, error)
+	FindByCandidatura(ctx context.Context, id_candidatura string) ([]models.Entrevista, error)
+	FindByPeriodo(ctx context.Context, inicio, fim time.Time) ([]models.Entrevista, error)
+	FindByID(ctx context.Context, id_candidatura, id string) (models.Entrevista, error)
+	Update(ctx context.Context, entrevista models.Entrevista) error
+	Delete(ctx context.Context, id_candidatura, id string) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+// Create grava a entrevista e os entrevistadores em uma única transação.
+func (r *repository) Create(ctx context.Context, entrevista models.Entrevista) (models.Entrevista, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO entrevistas(id_candidatura, data_hora, duracao, formato, id_endereco, link, anotacoes, resultado, criado)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		entrevista.IDCandidatura,
+		entrevista.DataHora,
+		entrevista.Duracao,
+		entrevista.Formato,
+		entrevista.IDEndereco,
+		entrevista.Link,
+		entrevista.Anotacoes,
+		entrevista.Resultado,
+		entrevista.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Entrevista{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Entrevista{}, err
+	}
+
+	entrevista.ID = id
+
+	if err := r.gravarEntrevistadores(ctx, entrevista); err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Entrevista{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	return entrevista, nil
+}
+
+func (r *repository) FindByCandidatura(ctx context.Context, id_candidatura string) ([]models.Entrevista, error) {
+	return r.find(ctx, " AND ent.id_candidatura = ?", id_candidatura)
+}
+
+// FindByPeriodo retorna as entrevistas, de todas as candidaturas, marcadas
+// entre os horários informados.
+func (r *repository) FindByPeriodo(ctx context.Context, inicio, fim time.Time) ([]models.Entrevista, error) {
+	return r.find(ctx, " AND ent.data_hora BETWEEN ? AND ?", inicio, fim)
+}
+
+func (r *repository) FindByID(ctx context.Context, id_candidatura, id string) (models.Entrevista, error) {
+	entrevistas, err := r.find(ctx, " AND ent.id_candidatura = ? AND ent.id = ?", id_candidatura, id)
+	if err != nil || len(entrevistas) == 0 {
+		return models.Entrevista{}, err
+	}
+
+	return entrevistas[0], nil
+}
+
+func (r *repository) find(ctx context.Context, conditions string, arguments ...interface{}) ([]models.Entrevista, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			ent.id,
+			ent.id_candidatura,
+			can.cargo,
+			can.situacao,
+			can.id_empresa,
+			emp.nome,
+			ent.data_hora,
+			ent.duracao,
+			ent.formato,
+			ent.id_endereco,
+			ent.link,
+			ent.anotacoes,
+			ent.resultado,
+			ent.criado,
+			ent.atualizado,
+			ent.apagado
+		FROM entrevistas ent
+		JOIN candidaturas can ON can.id = ent.id_candidatura
+		JOIN empresas emp ON emp.id = can.id_empresa
+		WHERE ent.apagado IS NULL
+		AND can.apagado IS NULL
+		`+conditions+`
+		ORDER BY ent.data_hora, ent.id`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Entrevista{}, err
+	}
+
+	defer rows.Close()
+
+	var entrevistas []models.Entrevista
+
+	for rows.Next() {
+		var entrevista = models.Entrevista{
+			Candidatura: &models.Candidatura{
+				Empresa: &models.Empresa{},
+			},
+			IDEntrevistadores: []int64{},
+			Entrevistadores:   []models.ContatoEmpresa{},
+		}
+
+		err := rows.Scan(
+			&entrevista.ID,
+			&entrevista.IDCandidatura,
+			&entrevista.Candidatura.Cargo,
+			&entrevista.Candidatura.Situacao,
+			&entrevista.Candidatura.IDEmpresa,
+			&entrevista.Candidatura.Empresa.Nome,
+			&entrevista.DataHora,
+			&entrevista.Duracao,
+			&entrevista.Formato,
+			&entrevista.IDEndereco,
+			&entrevista.Link,
+			&entrevista.Anotacoes,
+			&entrevista.Resultado,
+			&entrevista.Criado,
+			&entrevista.Atualizado,
+			&entrevista.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Entrevista{}, err
+		}
+
+		entrevista.Candidatura.ID = entrevista.IDCandidatura
+		entrevista.Candidatura.Empresa.ID = entrevista.Candidatura.IDEmpresa
+
+		entrevistadores, err := r.DB().Select(
+			ctx,
+			`SELECT
+				cont.id,
+				cont.id_empresa,
+				cont.id_pessoa,
+				pes.nome,
+				cont.tipo,
+				cont.contato,
+				cont.criado,
+				cont.atualizado,
+				cont.apagado
+			FROM entrevista_entrevistadores ee
+			JOIN contato_empresa cont ON cont.id = ee.id_contato
+			LEFT JOIN pessoas pes ON pes.id = cont.id_pessoa AND pes.apagado IS NULL
+			WHERE cont.apagado IS NULL
+			AND ee.id_entrevista = ?
+			ORDER BY ee.id`,
+			entrevista.ID,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT, err)
+			return []models.Entrevista{}, err
+		}
+
+		for entrevistadores.Next() {
+			var contato = models.ContatoEmpresa{}
+			var nome *string
+
+			err := entrevistadores.Scan(
+				&contato.ID,
+				&contato.IDEmpresa,
+				&contato.IDPessoa,
+				&nome,
+				&contato.Tipo,
+				&contato.Contato,
+				&contato.Criado,
+				&contato.Atualizado,
+				&contato.Apagado,
+			)
+
+			if err != nil {
+				entrevistadores.Close()
+
+				log.Error(repositories.ERROR_SELECT_SCAN, err)
+				return []models.Entrevista{}, err
+			}
+
+			if contato.IDPessoa != nil && nome != nil {
+				contato.Pessoa = &models.Pessoa{
+					ID:        *contato.IDPessoa,
+					IDEmpresa: contato.IDEmpresa,
+					Nome:      *nome,
+				}
+			}
+
+			entrevista.IDEntrevistadores = append(entrevista.IDEntrevistadores, contato.ID)
+			entrevista.Entrevistadores = append(entrevista.Entrevistadores, contato)
+		}
+
+		entrevistadores.Close()
+
+		entrevistas = append(entrevistas, entrevista)
+	}
+
+	return entrevistas, nil
+}
+
+// Update atualiza a entrevista e substitui os entrevistadores em uma única
+// transação.
+func (r *repository) Update(ctx context.Context, entrevista models.Entrevista) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE entrevistas SET
+		data_hora = ?,
+		duracao = ?,
+		formato = ?,
+		id_endereco = ?,
+		link = ?,
+		anotacoes = ?,
+		resultado = ?,
+		atualizado = ?
+		WHERE id = ?
+		AND id_candidatura = ?`,
+		entrevista.DataHora,
+		entrevista.Duracao,
+		entrevista.Formato,
+		entrevista.IDEndereco,
+		entrevista.Link,
+		entrevista.Anotacoes,
+		entrevista.Resultado,
+		entrevista.Atualizado,
+		entrevista.ID,
+		entrevista.IDCandidatura,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	_, err = r.DB().Write(
+		ctx,
+		`DELETE FROM entrevista_entrevistadores
+		WHERE id_entrevista = ?`,
+		entrevista.ID,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	if err := r.gravarEntrevistadores(ctx, entrevista); err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id_candidatura, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE entrevistas SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id = ?
+		AND id_candidatura = ?`,
+		id,
+		id_candidatura,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+// gravarEntrevistadores vincula os contatos à entrevista dentro da transação
+// aberta pelo chamador.
+func (r *repository) gravarEntrevistadores(ctx context.Context, entrevista models.Entrevista) error {
+	for _, id_contato := range entrevista.IDEntrevistadores {
+		_, err := r.DB().Write(
+			ctx,
+			`INSERT INTO entrevista_entrevistadores(id_entrevista, id_contato)
+			VALUES(?, ?)`,
+			entrevista.ID,
+			id_contato,
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
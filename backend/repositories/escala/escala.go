@@ -0,0 +1,212 @@
+package escala
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, escala models.Escala) (models.Escala, error)
+	FindByEmprego(ctx context.Context, id_emprego string) ([]models.Escala, error)
+	Delete(ctx context.Context, id_emprego, id string) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, escala models.Escala) (models.Escala, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO escalas(id_emprego, tipo, descricao, data_inicio, intervalo, criado)
+		VALUES(?, ?, ?, ?, ?, ?)`,
+		escala.IDEmprego,
+		escala.Tipo,
+		escala.Descricao,
+		escala.DataInicio,
+		escala.Intervalo,
+		escala.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Escala{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Escala{}, err
+	}
+
+	for i, dia := range escala.Dias {
+		result, err := r.DB().Write(
+			ctx,
+			`INSERT INTO escala_dias(id_escala, posicao, minutos)
+			VALUES(?, ?, ?)`,
+			id,
+			dia.Posicao,
+			dia.Minutos,
+		)
+
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return models.Escala{}, err
+		}
+
+		idDia, err := result.LastInsertId()
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return models.Escala{}, err
+		}
+
+		escala.Dias[i].ID = idDia
+	}
+
+	r.DB().Commit(ctx)
+
+	escala.ID = id
+
+	return escala, nil
+}
+
+// FindByEmprego retorna as escalas do emprego ordenadas pela data de início
+// de vigência, cada uma com os seus dias.
+func (r *repository) FindByEmprego(ctx context.Context, id_emprego string) ([]models.Escala, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			esc.id,
+			esc.id_emprego,
+			esc.tipo,
+			esc.descricao,
+			esc.data_inicio,
+			esc.intervalo,
+			esc.criado,
+			esc.atualizado,
+			esc.apagado
+		FROM escalas esc
+		WHERE esc.apagado IS NULL
+		AND esc.id_emprego = ?
+		ORDER BY esc.data_inicio, esc.id`,
+		id_emprego,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Escala{}, err
+	}
+
+	defer rows.Close()
+
+	var escalas []models.Escala
+
+	for rows.Next() {
+		var escala = models.Escala{}
+
+		err := rows.Scan(
+			&escala.ID,
+			&escala.IDEmprego,
+			&escala.Tipo,
+			&escala.Descricao,
+			&escala.DataInicio,
+			&escala.Intervalo,
+			&escala.Criado,
+			&escala.Atualizado,
+			&escala.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Escala{}, err
+		}
+
+		dias, err := r.DB().Select(
+			ctx,
+			`SELECT
+				dia.id,
+				dia.posicao,
+				dia.minutos
+			FROM escala_dias dia
+			WHERE dia.id_escala = ?
+			ORDER BY dia.posicao`,
+			escala.ID,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT, err)
+			return []models.Escala{}, err
+		}
+
+		for dias.Next() {
+			var dia = models.EscalaDia{}
+
+			err := dias.Scan(
+				&dia.ID,
+				&dia.Posicao,
+				&dia.Minutos,
+			)
+
+			if err != nil {
+				dias.Close()
+
+				log.Error(repositories.ERROR_SELECT_SCAN, err)
+				return []models.Escala{}, err
+			}
+
+			escala.Dias = append(escala.Dias, dia)
+		}
+
+		dias.Close()
+
+		escalas = append(escalas, escala)
+	}
+
+	return escalas, nil
+}
+
+func (r *repository) Delete(ctx context.Context, id_emprego, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE escalas SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id_emprego = ?
+		AND id = ?`,
+		id_emprego,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
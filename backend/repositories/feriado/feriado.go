@@ -0,0 +1,218 @@
+package feriado
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, feriado models.Feriado) (models.Feriado, error)
+	FindAll(ctx context.Context, ano int, uf, cidade string) ([]models.Feriado, error)
+	FindByID(ctx context.Context, id string) (models.Feriado, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, feriado models.Feriado) (models.Feriado, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO feriados(data, nome, abrangencia, estado, cidade, recorrente, criado)
+		VALUES(?, ?, ?, ?, ?, ?, ?)`,
+		feriado.Data,
+		feriado.Nome,
+		feriado.Abrangencia,
+		feriado.Estado,
+		feriado.Cidade,
+		feriado.Recorrente,
+		feriado.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Feriado{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Feriado{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	feriado.ID = id
+
+	return feriado, nil
+}
+
+// FindAll retorna os feriados cadastrados que valem para o ano, estado e cidade
+// informados. Feriados estaduais são retornados apenas quando o estado é
+// informado, e os municipais apenas quando estado e cidade são informados.
+func (r *repository) FindAll(ctx context.Context, ano int, uf, cidade string) ([]models.Feriado, error) {
+	if uf == "" {
+		return []models.Feriado{}, nil
+	}
+
+	arguments := []interface{}{ano, uf}
+
+	conditions := " AND (fer.abrangencia = 'estadual'"
+
+	if cidade != "" {
+		conditions += " OR (fer.abrangencia = 'municipal' AND fer.cidade = ?)"
+		arguments = append(arguments, cidade)
+	}
+
+	conditions += ")"
+
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			fer.id,
+			fer.data,
+			fer.nome,
+			fer.abrangencia,
+			fer.estado,
+			fer.cidade,
+			fer.recorrente,
+			fer.criado,
+			fer.atualizado,
+			fer.apagado
+		FROM feriados fer
+		WHERE fer.apagado IS NULL
+		AND (YEAR(fer.data) = ? OR fer.recorrente)
+		AND fer.estado = ?
+		`+conditions+`
+		ORDER BY MONTH(fer.data), DAY(fer.data)`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Feriado{}, err
+	}
+
+	defer rows.Close()
+
+	var feriados []models.Feriado
+
+	for rows.Next() {
+		var feriado = models.Feriado{}
+
+		err := rows.Scan(
+			&feriado.ID,
+			&feriado.Data,
+			&feriado.Nome,
+			&feriado.Abrangencia,
+			&feriado.Estado,
+			&feriado.Cidade,
+			&feriado.Recorrente,
+			&feriado.Criado,
+			&feriado.Atualizado,
+			&feriado.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Feriado{}, err
+		}
+
+		feriados = append(feriados, feriado)
+	}
+
+	return feriados, nil
+}
+
+func (r *repository) FindByID(ctx context.Context, id string) (models.Feriado, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			fer.id,
+			fer.data,
+			fer.nome,
+			fer.abrangencia,
+			fer.estado,
+			fer.cidade,
+			fer.recorrente,
+			fer.criado,
+			fer.atualizado,
+			fer.apagado
+		FROM feriados fer
+		WHERE fer.apagado IS NULL
+		AND fer.id = ?`,
+		id,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return models.Feriado{}, err
+	}
+
+	defer rows.Close()
+
+	var feriado = models.Feriado{}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&feriado.ID,
+			&feriado.Data,
+			&feriado.Nome,
+			&feriado.Abrangencia,
+			&feriado.Estado,
+			&feriado.Cidade,
+			&feriado.Recorrente,
+			&feriado.Criado,
+			&feriado.Atualizado,
+			&feriado.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return models.Feriado{}, err
+		}
+	}
+
+	return feriado, nil
+}
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE feriados SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id = ?`,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
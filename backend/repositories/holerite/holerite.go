@@ -0,0 +1,267 @@
+package holerite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, holerite models.Holerite) (models.Holerite, error)
+	FindByEmprego(ctx context.Context, id_emprego string) ([]models.Holerite, error)
+	FindByID(ctx context.Context, id_emprego, id string) (models.Holerite, error)
+	FindByAno(ctx context.Context, ano int) ([]models.Holerite, error)
+	Classificar(ctx context.Context, linhas []models.DetalhamentoHolerite) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+// Create grava o holerite e as suas linhas em uma única transação.
+func (r *repository) Create(ctx context.Context, holerite models.Holerite) (models.Holerite, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO holerites(id_emprego, id_remuneracao, referencia, moeda, data_pagamento)
+		VALUES(?, ?, ?, ?, ?)`,
+		holerite.IDEmprego,
+		holerite.IDRemuneracao,
+		holerite.Referencia,
+		holerite.Moeda,
+		holerite.DataPagamento,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Holerite{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Holerite{}, err
+	}
+
+	holerite.ID = id
+
+	for i, linha := range holerite.Detalhamento {
+		result, err := r.DB().Write(
+			ctx,
+			`INSERT INTO detalhamento_holerite(id_holerite, id_rubrica, tipo, valor, descricao)
+			VALUES(?, ?, ?, ?, ?)`,
+			holerite.ID,
+			linha.IDRubrica,
+			linha.Tipo,
+			linha.Valor,
+			linha.Descricao,
+		)
+
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return models.Holerite{}, err
+		}
+
+		idLinha, err := result.LastInsertId()
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return models.Holerite{}, err
+		}
+
+		holerite.Detalhamento[i].ID = idLinha
+		holerite.Detalhamento[i].IDHolerite = holerite.ID
+	}
+
+	r.DB().Commit(ctx)
+
+	return holerite, nil
+}
+
+func (r *repository) FindByEmprego(ctx context.Context, id_emprego string) ([]models.Holerite, error) {
+	return r.find(ctx, " AND hol.id_emprego = ?", id_emprego)
+}
+
+func (r *repository) FindByID(ctx context.Context, id_emprego, id string) (models.Holerite, error) {
+	holerites, err := r.find(ctx, " AND hol.id_emprego = ? AND hol.id = ?", id_emprego, id)
+	if err != nil || len(holerites) == 0 {
+		return models.Holerite{}, err
+	}
+
+	return holerites[0], nil
+}
+
+// FindByAno retorna os holerites pagos no ano, pelo regime de caixa, com a
+// empresa do emprego e as linhas de cada holerite. Sem data de pagamento,
+// vale o ano do mês de referência.
+func (r *repository) FindByAno(ctx context.Context, ano int) ([]models.Holerite, error) {
+	return r.find(ctx, " AND YEAR(COALESCE(hol.data_pagamento, hol.referencia)) = ?", ano)
+}
+
+func (r *repository) find(ctx context.Context, conditions string, arguments ...interface{}) ([]models.Holerite, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			hol.id,
+			hol.id_emprego,
+			hol.id_remuneracao,
+			hol.referencia,
+			hol.moeda,
+			hol.data_pagamento,
+			emp.id,
+			emp.nome,
+			emp.cnpj,
+			det.id,
+			det.tipo,
+			det.valor,
+			det.descricao,
+			rub.id,
+			rub.codigo,
+			rub.nome,
+			rub.tipo,
+			rub.categoria,
+			rub.incide_inss,
+			rub.incide_irrf,
+			rub.incide_fgts
+		FROM holerites hol
+		JOIN empregos job ON job.id = hol.id_emprego
+		JOIN empresas emp ON emp.id = job.id_empresa
+		LEFT JOIN detalhamento_holerite det ON det.id_holerite = hol.id
+		LEFT JOIN rubricas rub ON rub.id = det.id_rubrica
+		WHERE job.apagado IS NULL
+		`+conditions+`
+		ORDER BY emp.cnpj, hol.referencia, hol.id, det.id`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Holerite{}, err
+	}
+
+	defer rows.Close()
+
+	var holerites []models.Holerite
+
+	for rows.Next() {
+		var holerite = models.Holerite{
+			Detalhamento: []models.DetalhamentoHolerite{},
+		}
+
+		var idLinha, idRubrica sql.NullInt64
+		var tipo, descricao sql.NullString
+		var valor models.Dinheiro
+		var rubrica = models.Rubrica{}
+		var codigo, nome, tipoRubrica, categoria sql.NullString
+		var incideINSS, incideIRRF, incideFGTS sql.NullBool
+
+		err := rows.Scan(
+			&holerite.ID,
+			&holerite.IDEmprego,
+			&holerite.IDRemuneracao,
+			&holerite.Referencia,
+			&holerite.Moeda,
+			&holerite.DataPagamento,
+			&holerite.Empresa.ID,
+			&holerite.Empresa.Nome,
+			&holerite.Empresa.CNPJ,
+			&idLinha,
+			&tipo,
+			&valor,
+			&descricao,
+			&idRubrica,
+			&codigo,
+			&nome,
+			&tipoRubrica,
+			&categoria,
+			&incideINSS,
+			&incideIRRF,
+			&incideFGTS,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Holerite{}, err
+		}
+
+		if len(holerites) == 0 || holerites[len(holerites)-1].ID != holerite.ID {
+			holerites = append(holerites, holerite)
+		}
+
+		if idLinha.Valid {
+			linha := models.DetalhamentoHolerite{
+				ID:         idLinha.Int64,
+				IDHolerite: holerite.ID,
+				Tipo:       tipo.String,
+				Valor:      valor,
+				Descricao:  descricao.String,
+			}
+
+			if idRubrica.Valid {
+				rubrica.ID = idRubrica.Int64
+				rubrica.Codigo = codigo.String
+				rubrica.Nome = nome.String
+				rubrica.Tipo = tipoRubrica.String
+				rubrica.Categoria = categoria.String
+				rubrica.IncideINSS = incideINSS.Bool
+				rubrica.IncideIRRF = incideIRRF.Bool
+				rubrica.IncideFGTS = incideFGTS.Bool
+
+				linha.IDRubrica = &rubrica.ID
+				linha.Rubrica = &rubrica
+			}
+
+			atual := &holerites[len(holerites)-1]
+			atual.Detalhamento = append(atual.Detalhamento, linha)
+		}
+	}
+
+	return holerites, nil
+}
+
+// Classificar grava a rubrica de cada linha informada.
+func (r *repository) Classificar(ctx context.Context, linhas []models.DetalhamentoHolerite) error {
+	r.DB().BeginTransaction(ctx)
+
+	for _, linha := range linhas {
+		_, err := r.DB().Write(
+			ctx,
+			`UPDATE detalhamento_holerite SET
+			id_rubrica = ?
+			WHERE id_holerite = ?
+			AND id = ?`,
+			linha.IDRubrica,
+			linha.IDHolerite,
+			linha.ID,
+		)
+
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_UPDATE, err)
+			return err
+		}
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
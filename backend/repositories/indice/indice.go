@@ -0,0 +1,147 @@
+package indice
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	FindAll(ctx context.Context, indice, inicio, fim string) ([]models.IndiceEconomico, error)
+	Importar(ctx context.Context, indices []models.IndiceEconomico) (int, int, error)
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+// FindAll retorna as variações mensais do índice ordenadas pelo mês de
+// referência, opcionalmente entre os meses de início e fim, no formato
+// YYYY-MM-DD.
+func (r *repository) FindAll(ctx context.Context, indice, inicio, fim string) ([]models.IndiceEconomico, error) {
+	arguments := []interface{}{indice}
+
+	conditions := ""
+
+	if inicio != "" {
+		conditions += " AND ind.referencia >= STR_TO_DATE(?, '%Y-%m-%d')"
+		arguments = append(arguments, inicio)
+	}
+
+	if fim != "" {
+		conditions += " AND ind.referencia <= STR_TO_DATE(?, '%Y-%m-%d')"
+		arguments = append(arguments, fim)
+	}
+
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			ind.id,
+			ind.indice,
+			ind.referencia,
+			ind.variacao,
+			ind.criado,
+			ind.atualizado,
+			ind.apagado
+		FROM indices_economicos ind
+		WHERE ind.apagado IS NULL
+		AND ind.indice = ?
+		`+conditions+`
+		ORDER BY ind.referencia`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.IndiceEconomico{}, err
+	}
+
+	defer rows.Close()
+
+	var indices []models.IndiceEconomico
+
+	for rows.Next() {
+		var indice = models.IndiceEconomico{}
+
+		err := rows.Scan(
+			&indice.ID,
+			&indice.Indice,
+			&indice.Referencia,
+			&indice.Variacao,
+			&indice.Criado,
+			&indice.Atualizado,
+			&indice.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.IndiceEconomico{}, err
+		}
+
+		indices = append(indices, indice)
+	}
+
+	return indices, nil
+}
+
+// Importar insere as variações em uma única transação, atualizando as dos
+// meses já importados, já que o IGP-M e as séries revisadas podem mudar.
+// Retorna a quantidade de variações inseridas e atualizadas.
+func (r *repository) Importar(ctx context.Context, indices []models.IndiceEconomico) (int, int, error) {
+	r.DB().BeginTransaction(ctx)
+
+	inseridos := 0
+	atualizados := 0
+
+	for _, indice := range indices {
+		result, err := r.DB().Write(
+			ctx,
+			`INSERT INTO indices_economicos(indice, referencia, variacao, criado)
+			VALUES(?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+			atualizado = IF(variacao <> VALUES(variacao) OR apagado IS NOT NULL, VALUES(criado), atualizado),
+			variacao = VALUES(variacao),
+			apagado = NULL`,
+			indice.Indice,
+			indice.Referencia,
+			indice.Variacao,
+			indice.Criado,
+		)
+
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return 0, 0, err
+		}
+
+		afetadas, err := result.RowsAffected()
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return 0, 0, err
+		}
+
+		// O MySQL informa 1 linha afetada para inserções e 2 para atualizações.
+		switch afetadas {
+		case 1:
+			inseridos++
+		case 2:
+			atualizados++
+		}
+	}
+
+	r.DB().Commit(ctx)
+
+	return inseridos, atualizados, nil
+}
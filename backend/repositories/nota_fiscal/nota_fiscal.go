@@ -0,0 +1,209 @@
+package notafiscal
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, nota models.NotaFiscal) (models.NotaFiscal, error)
+	FindByEmprego(ctx context.Context, id_emprego, situacao string) ([]models.NotaFiscal, error)
+	FindByID(ctx context.Context, id_emprego, id string) (models.NotaFiscal, error)
+	Update(ctx context.Context, nota models.NotaFiscal) error
+	Delete(ctx context.Context, id_emprego, id string) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, nota models.NotaFiscal) (models.NotaFiscal, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO notas_fiscais(id_emprego, numero, data_emissao, valor, situacao, data_pagamento, descricao, criado)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?)`,
+		nota.IDEmprego,
+		nota.Numero,
+		nota.DataEmissao,
+		nota.Valor,
+		nota.Situacao,
+		nota.DataPagamento,
+		nota.Descricao,
+		nota.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.NotaFiscal{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.NotaFiscal{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	nota.ID = id
+
+	return nota, nil
+}
+
+// FindByEmprego retorna as notas do emprego em ordem de emissão, filtradas
+// pela situação quando informada.
+func (r *repository) FindByEmprego(ctx context.Context, id_emprego, situacao string) ([]models.NotaFiscal, error) {
+	if situacao != "" {
+		return r.find(ctx, " AND nf.id_emprego = ? AND nf.situacao = ?", id_emprego, situacao)
+	}
+
+	return r.find(ctx, " AND nf.id_emprego = ?", id_emprego)
+}
+
+func (r *repository) FindByID(ctx context.Context, id_emprego, id string) (models.NotaFiscal, error) {
+	notas, err := r.find(ctx, " AND nf.id_emprego = ? AND nf.id = ?", id_emprego, id)
+	if err != nil || len(notas) == 0 {
+		return models.NotaFiscal{}, err
+	}
+
+	return notas[0], nil
+}
+
+func (r *repository) find(ctx context.Context, conditions string, arguments ...interface{}) ([]models.NotaFiscal, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			nf.id,
+			nf.id_emprego,
+			nf.numero,
+			nf.data_emissao,
+			nf.valor,
+			nf.situacao,
+			nf.data_pagamento,
+			nf.descricao,
+			nf.criado,
+			nf.atualizado,
+			nf.apagado
+		FROM notas_fiscais nf
+		WHERE nf.apagado IS NULL
+		`+conditions+`
+		ORDER BY nf.data_emissao, nf.id`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.NotaFiscal{}, err
+	}
+
+	defer rows.Close()
+
+	var notas []models.NotaFiscal
+
+	for rows.Next() {
+		var nota = models.NotaFiscal{}
+
+		err := rows.Scan(
+			&nota.ID,
+			&nota.IDEmprego,
+			&nota.Numero,
+			&nota.DataEmissao,
+			&nota.Valor,
+			&nota.Situacao,
+			&nota.DataPagamento,
+			&nota.Descricao,
+			&nota.Criado,
+			&nota.Atualizado,
+			&nota.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.NotaFiscal{}, err
+		}
+
+		notas = append(notas, nota)
+	}
+
+	return notas, nil
+}
+
+func (r *repository) Update(ctx context.Context, nota models.NotaFiscal) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE notas_fiscais SET
+		numero = ?,
+		data_emissao = ?,
+		valor = ?,
+		situacao = ?,
+		data_pagamento = ?,
+		descricao = ?,
+		atualizado = ?
+		WHERE id_emprego = ?
+		AND id = ?`,
+		nota.Numero,
+		nota.DataEmissao,
+		nota.Valor,
+		nota.Situacao,
+		nota.DataPagamento,
+		nota.Descricao,
+		nota.Atualizado,
+		nota.IDEmprego,
+		nota.ID,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id_emprego, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE notas_fiscais SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id_emprego = ?
+		AND id = ?`,
+		id_emprego,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
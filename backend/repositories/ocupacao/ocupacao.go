@@ -0,0 +1,244 @@
+package ocupacao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, ocupacao models.Ocupacao) (models.Ocupacao, error)
+	FindAll(ctx context.Context, search, cbo string) ([]models.Ocupacao, error)
+	FindByID(ctx context.Context, id string) (models.Ocupacao, error)
+	Update(ctx context.Context, ocupacao models.Ocupacao) error
+	Delete(ctx context.Context, id string) error
+	Importar(ctx context.Context, ocupacoes []models.Ocupacao) (int, int, error)
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, ocupacao models.Ocupacao) (models.Ocupacao, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO ocupacoes(nome, cbo, criado)
+		VALUES(?, ?, ?)`,
+		ocupacao.Nome,
+		ocupacao.CBO,
+		ocupacao.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Ocupacao{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Ocupacao{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	ocupacao.ID = id
+
+	return ocupacao, nil
+}
+
+func (r *repository) FindAll(ctx context.Context, search, cbo string) ([]models.Ocupacao, error) {
+	arguments := []interface{}{}
+	conditions := ""
+
+	if search != "" {
+		searchLike := fmt.Sprintf("%%%s%%", search)
+		conditions += " AND (ocp.nome LIKE ? OR ocp.cbo LIKE ?)"
+		arguments = append(arguments, searchLike, searchLike)
+	}
+
+	if cbo != "" {
+		conditions += " AND (ocp.cbo = ?)"
+		arguments = append(arguments, cbo)
+	}
+
+	return r.find(ctx, conditions, arguments...)
+}
+
+func (r *repository) FindByID(ctx context.Context, id string) (models.Ocupacao, error) {
+	ocupacoes, err := r.find(ctx, " AND ocp.id = ?", id)
+	if err != nil || len(ocupacoes) == 0 {
+		return models.Ocupacao{}, err
+	}
+
+	return ocupacoes[0], nil
+}
+
+func (r *repository) find(ctx context.Context, conditions string, arguments ...interface{}) ([]models.Ocupacao, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			ocp.id,
+			ocp.nome,
+			ocp.cbo,
+			ocp.criado,
+			ocp.atualizado,
+			ocp.apagado
+		FROM ocupacoes ocp
+		WHERE ocp.apagado IS NULL
+		`+conditions+`
+		ORDER BY ocp.nome, ocp.id`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Ocupacao{}, err
+	}
+
+	defer rows.Close()
+
+	var ocupacoes []models.Ocupacao
+
+	for rows.Next() {
+		var ocupacao = models.Ocupacao{}
+
+		err := rows.Scan(
+			&ocupacao.ID,
+			&ocupacao.Nome,
+			&ocupacao.CBO,
+			&ocupacao.Criado,
+			&ocupacao.Atualizado,
+			&ocupacao.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Ocupacao{}, err
+		}
+
+		ocupacoes = append(ocupacoes, ocupacao)
+	}
+
+	return ocupacoes, nil
+}
+
+func (r *repository) Update(ctx context.Context, ocupacao models.Ocupacao) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE ocupacoes SET
+		nome = ?,
+		cbo = ?,
+		atualizado = ?
+		WHERE id = ?`,
+		ocupacao.Nome,
+		ocupacao.CBO,
+		ocupacao.Atualizado,
+		ocupacao.ID,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE ocupacoes SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id = ?`,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+// Importar insere as ocupações em uma única transação, atualizando o nome das
+// que já existem com o mesmo código CBO. Retorna a quantidade de ocupações
+// inseridas e atualizadas.
+func (r *repository) Importar(ctx context.Context, ocupacoes []models.Ocupacao) (int, int, error) {
+	r.DB().BeginTransaction(ctx)
+
+	inseridas := 0
+	atualizadas := 0
+
+	for _, ocupacao := range ocupacoes {
+		result, err := r.DB().Write(
+			ctx,
+			`INSERT INTO ocupacoes(nome, cbo, criado)
+			VALUES(?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+			atualizado = IF(nome <> VALUES(nome) OR apagado IS NOT NULL, VALUES(criado), atualizado),
+			nome = VALUES(nome),
+			apagado = NULL`,
+			ocupacao.Nome,
+			ocupacao.CBO,
+			ocupacao.Criado,
+		)
+
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return 0, 0, err
+		}
+
+		afetadas, err := result.RowsAffected()
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return 0, 0, err
+		}
+
+		// O MySQL informa 1 linha afetada para inserções e 2 para atualizações.
+		switch afetadas {
+		case 1:
+			inseridas++
+		case 2:
+			atualizadas++
+		}
+	}
+
+	r.DB().Commit(ctx)
+
+	return inseridas, atualizadas, nil
+}
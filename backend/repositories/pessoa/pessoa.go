@@ -0,0 +1,237 @@
+package pessoa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, pessoa models.Pessoa) (models.Pessoa, error)
+	FindAll(ctx context.Context, search, empresa, departamento string) ([]models.Pessoa, error)
+	FindByID(ctx context.Context, id string) (models.Pessoa, error)
+	Update(ctx context.Context, pessoa models.Pessoa) error
+	Delete(ctx context.Context, id string) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, pessoa models.Pessoa) (models.Pessoa, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO pessoas(id_empresa, nome, cargo, departamento, linkedin, criado)
+		VALUES(?, ?, ?, ?, ?, ?)`,
+		pessoa.IDEmpresa,
+		pessoa.Nome,
+		pessoa.Cargo,
+		pessoa.Departamento,
+		pessoa.LinkedIn,
+		pessoa.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Pessoa{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Pessoa{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	pessoa.ID = id
+
+	return pessoa, nil
+}
+
+func (r *repository) FindAll(ctx context.Context, search, empresa, departamento string) ([]models.Pessoa, error) {
+	arguments := []interface{}{}
+	conditions := ""
+
+	if search != "" {
+		searchLike := fmt.Sprintf("%%%s%%", search)
+		conditions += " AND (pes.nome LIKE ? OR pes.cargo LIKE ? OR pes.departamento LIKE ? OR pes.linkedin LIKE ? OR emp.nome LIKE ?)"
+		arguments = append(arguments, searchLike, searchLike, searchLike, searchLike, searchLike)
+	}
+
+	if empresa != "" {
+		conditions += " AND (emp.id = ? OR emp.nome = ?)"
+		arguments = append(arguments, empresa, empresa)
+	}
+
+	if departamento != "" {
+		conditions += " AND pes.departamento = ?"
+		arguments = append(arguments, departamento)
+	}
+
+	return r.find(ctx, conditions, arguments...)
+}
+
+func (r *repository) FindByID(ctx context.Context, id string) (models.Pessoa, error) {
+	pessoas, err := r.find(ctx, " AND pes.id = ?", id)
+	if err != nil || len(pessoas) == 0 {
+		return models.Pessoa{}, err
+	}
+
+	return pessoas[0], nil
+}
+
+func (r *repository) find(ctx context.Context, conditions string, arguments ...interface{}) ([]models.Pessoa, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			pes.id,
+			pes.id_empresa,
+			emp.nome,
+			emp.cnpj,
+			pes.nome,
+			pes.cargo,
+			pes.departamento,
+			pes.linkedin,
+			pes.criado,
+			pes.atualizado,
+			pes.apagado
+		FROM pessoas pes
+		JOIN empresas emp ON emp.id = pes.id_empresa
+		WHERE pes.apagado IS NULL
+		AND emp.apagado IS NULL
+		`+conditions+`
+		ORDER BY emp.nome, pes.nome`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Pessoa{}, err
+	}
+
+	defer rows.Close()
+
+	var pessoas []models.Pessoa
+
+	for rows.Next() {
+		var pessoa = models.Pessoa{
+			Empresa: &models.Empresa{},
+		}
+
+		err := rows.Scan(
+			&pessoa.ID,
+			&pessoa.IDEmpresa,
+			&pessoa.Empresa.Nome,
+			&pessoa.Empresa.CNPJ,
+			&pessoa.Nome,
+			&pessoa.Cargo,
+			&pessoa.Departamento,
+			&pessoa.LinkedIn,
+			&pessoa.Criado,
+			&pessoa.Atualizado,
+			&pessoa.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Pessoa{}, err
+		}
+
+		pessoa.Empresa.ID = pessoa.IDEmpresa
+
+		pessoas = append(pessoas, pessoa)
+	}
+
+	return pessoas, nil
+}
+
+func (r *repository) Update(ctx context.Context, pessoa models.Pessoa) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE pessoas SET
+		nome = ?,
+		cargo = ?,
+		departamento = ?,
+		linkedin = ?,
+		atualizado = ?
+		WHERE id = ?`,
+		pessoa.Nome,
+		pessoa.Cargo,
+		pessoa.Departamento,
+		pessoa.LinkedIn,
+		pessoa.Atualizado,
+		pessoa.ID,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+// Delete apaga a pessoa e mantém os contatos dela como contatos gerais da
+// empresa, em uma única transação.
+func (r *repository) Delete(ctx context.Context, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE pessoas SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id = ?`,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	_, err = r.DB().Write(
+		ctx,
+		`UPDATE contato_empresa SET
+		id_pessoa = NULL,
+		atualizado = CURRENT_DATE()
+		WHERE id_pessoa = ?`,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package ponto
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	CreateMany(ctx context.Context, marcacoes []models.CartaoPonto) ([]models.CartaoPonto, error)
+	FindByEmprego(ctx context.Context, id_emprego string, inicio, fim time.Time) ([]models.CartaoPonto, error)
+	FindByID(ctx context.Context, id_emprego, id string) (models.CartaoPonto, error)
+	SaldoBancoHoras(ctx context.Context, id_emprego string, ate time.Time) (int64, error)
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+// CreateMany insere as marcações em uma única transação.
+func (r *repository) CreateMany(ctx context.Context, marcacoes []models.CartaoPonto) ([]models.CartaoPonto, error) {
+	r.DB().BeginTransaction(ctx)
+
+	for i, marcacao := range marcacoes {
+		result, err := r.DB().Write(
+			ctx,
+			`INSERT INTO cartao_ponto(id_emprego, horario, tipo, saldo, criado)
+			VALUES(?, ?, ?, ?, ?)`,
+			marcacao.IDEmprego,
+			marcacao.Horario,
+			marcacao.Tipo,
+			marcacao.Saldo,
+			marcacao.Criado,
+		)
+
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return []models.CartaoPonto{}, err
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return []models.CartaoPonto{}, err
+		}
+
+		marcacoes[i].ID = id
+	}
+
+	r.DB().Commit(ctx)
+
+	return marcacoes, nil
+}
+
+// FindByEmprego retorna as marcações do emprego entre o início do dia inicial e
+// o fim do dia final, ordenadas por horário.
+func (r *repository) FindByEmprego(ctx context.Context, id_emprego string, inicio, fim time.Time) ([]models.CartaoPonto, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			pto.id,
+			pto.id_emprego,
+			pto.horario,
+			pto.tipo,
+			pto.saldo,
+			pto.criado,
+			pto.atualizado,
+			pto.apagado
+		FROM cartao_ponto pto
+		WHERE pto.apagado IS NULL
+		AND pto.id_emprego = ?
+		AND pto.horario >= ?
+		AND pto.horario < ?
+		ORDER BY pto.horario, pto.id`,
+		id_emprego,
+		time.Date(inicio.Year(), inicio.Month(), inicio.Day(), 0, 0, 0, 0, time.Local),
+		time.Date(fim.Year(), fim.Month(), fim.Day()+1, 0, 0, 0, 0, time.Local),
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.CartaoPonto{}, err
+	}
+
+	defer rows.Close()
+
+	var marcacoes []models.CartaoPonto
+
+	for rows.Next() {
+		var marcacao = models.CartaoPonto{}
+
+		err := rows.Scan(
+			&marcacao.ID,
+			&marcacao.IDEmprego,
+			&marcacao.Horario,
+			&marcacao.Tipo,
+			&marcacao.Saldo,
+			&marcacao.Criado,
+			&marcacao.Atualizado,
+			&marcacao.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.CartaoPonto{}, err
+		}
+
+		marcacoes = append(marcacoes, marcacao)
+	}
+
+	return marcacoes, nil
+}
+
+func (r *repository) FindByID(ctx context.Context, id_emprego, id string) (models.CartaoPonto, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			pto.id,
+			pto.id_emprego,
+			pto.horario,
+			pto.tipo,
+			pto.saldo,
+			pto.criado,
+			pto.atualizado,
+			pto.apagado
+		FROM cartao_ponto pto
+		WHERE pto.apagado IS NULL
+		AND pto.id_emprego = ?
+		AND pto.id = ?`,
+		id_emprego,
+		id,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return models.CartaoPonto{}, err
+	}
+
+	defer rows.Close()
+
+	var marcacao = models.CartaoPonto{}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&marcacao.ID,
+			&marcacao.IDEmprego,
+			&marcacao.Horario,
+			&marcacao.Tipo,
+			&marcacao.Saldo,
+			&marcacao.Criado,
+			&marcacao.Atualizado,
+			&marcacao.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return models.CartaoPonto{}, err
+		}
+	}
+
+	return marcacao, nil
+}
+
+// SaldoBancoHoras soma os lançamentos do banco de horas do emprego anteriores
+// à data informada.
+func (r *repository) SaldoBancoHoras(ctx context.Context, id_emprego string, ate time.Time) (int64, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			COALESCE(SUM(bh.saldo), 0)
+		FROM banco_horas bh
+		WHERE bh.apagado IS NULL
+		AND bh.id_emprego = ?
+		AND bh.data < ?`,
+		id_emprego,
+		ate,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return 0, err
+	}
+
+	defer rows.Close()
+
+	var saldo int64
+
+	for rows.Next() {
+		if err := rows.Scan(&saldo); err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return 0, err
+		}
+	}
+
+	return saldo, nil
+}
@@ -0,0 +1,155 @@
+package remuneracao
+
+import (
+	"context"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, remuneracao models.Remuneracao) (models.Remuneracao, error)
+	FindByEmprego(ctx context.Context, id_emprego string) ([]models.Remuneracao, error)
+	Delete(ctx context.Context, id_emprego, id string) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, remuneracao models.Remuneracao) (models.Remuneracao, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO remuneracoes(id_emprego, id_ocupacao, remuneracao, moeda, data, criado)
+		VALUES(?, ?, ?, ?, ?, ?)`,
+		remuneracao.IDEmprego,
+		remuneracao.IDOcupacao,
+		remuneracao.Remuneracao,
+		remuneracao.Moeda,
+		remuneracao.Data,
+		remuneracao.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Remuneracao{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Remuneracao{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	remuneracao.ID = id
+
+	return remuneracao, nil
+}
+
+// FindByEmprego retorna o histórico salarial do emprego com a ocupação de cada
+// registro, ordenado por data.
+func (r *repository) FindByEmprego(ctx context.Context, id_emprego string) ([]models.Remuneracao, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			rem.id,
+			rem.id_emprego,
+			rem.id_ocupacao,
+			ocp.nome,
+			ocp.cbo,
+			rem.remuneracao,
+			rem.moeda,
+			rem.data,
+			rem.criado,
+			rem.atualizado,
+			rem.apagado
+		FROM remuneracoes rem
+		JOIN ocupacoes ocp ON ocp.id = rem.id_ocupacao
+		WHERE rem.apagado IS NULL
+		AND rem.id_emprego = ?
+		ORDER BY rem.data, rem.id`,
+		id_emprego,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Remuneracao{}, err
+	}
+
+	defer rows.Close()
+
+	var remuneracoes []models.Remuneracao
+
+	for rows.Next() {
+		var remuneracao = models.Remuneracao{
+			Ocupacao: &models.Ocupacao{},
+		}
+
+		err := rows.Scan(
+			&remuneracao.ID,
+			&remuneracao.IDEmprego,
+			&remuneracao.IDOcupacao,
+			&remuneracao.Ocupacao.Nome,
+			&remuneracao.Ocupacao.CBO,
+			&remuneracao.Remuneracao,
+			&remuneracao.Moeda,
+			&remuneracao.Data,
+			&remuneracao.Criado,
+			&remuneracao.Atualizado,
+			&remuneracao.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Remuneracao{}, err
+		}
+
+		remuneracao.Ocupacao.ID = remuneracao.IDOcupacao
+
+		remuneracoes = append(remuneracoes, remuneracao)
+	}
+
+	return remuneracoes, nil
+}
+
+func (r *repository) Delete(ctx context.Context, id_emprego, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE remuneracoes SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id_emprego = ?
+		AND id = ?`,
+		id_emprego,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
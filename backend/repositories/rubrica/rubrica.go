@@ -0,0 +1,274 @@
+package rubrica
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, rubrica models.Rubrica) (models.Rubrica, error)
+	FindAll(ctx context.Context, search, tipo, categoria string) ([]models.Rubrica, error)
+	FindByID(ctx context.Context, id string) (models.Rubrica, error)
+	Update(ctx context.Context, rubrica models.Rubrica) error
+	Delete(ctx context.Context, id string) error
+	Importar(ctx context.Context, rubricas []models.Rubrica) (int, error)
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, rubrica models.Rubrica) (models.Rubrica, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO rubricas(codigo, nome, tipo, categoria, incide_inss, incide_irrf, incide_fgts, termos, criado)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		rubrica.Codigo,
+		rubrica.Nome,
+		rubrica.Tipo,
+		rubrica.Categoria,
+		rubrica.IncideINSS,
+		rubrica.IncideIRRF,
+		rubrica.IncideFGTS,
+		rubrica.Termos,
+		rubrica.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Rubrica{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Rubrica{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	rubrica.ID = id
+
+	return rubrica, nil
+}
+
+func (r *repository) FindAll(ctx context.Context, search, tipo, categoria string) ([]models.Rubrica, error) {
+	arguments := []interface{}{}
+	conditions := ""
+
+	if search != "" {
+		searchLike := fmt.Sprintf("%%%s%%", search)
+		conditions += " AND (rub.codigo LIKE ? OR rub.nome LIKE ? OR rub.termos LIKE ?)"
+		arguments = append(arguments, searchLike, searchLike, searchLike)
+	}
+
+	if tipo != "" {
+		conditions += " AND (rub.tipo = ?)"
+		arguments = append(arguments, tipo)
+	}
+
+	if categoria != "" {
+		conditions += " AND (rub.categoria = ?)"
+		arguments = append(arguments, categoria)
+	}
+
+	return r.find(ctx, conditions, arguments...)
+}
+
+func (r *repository) FindByID(ctx context.Context, id string) (models.Rubrica, error) {
+	rubricas, err := r.find(ctx, " AND rub.id = ?", id)
+	if err != nil || len(rubricas) == 0 {
+		return models.Rubrica{}, err
+	}
+
+	return rubricas[0], nil
+}
+
+func (r *repository) find(ctx context.Context, conditions string, arguments ...interface{}) ([]models.Rubrica, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			rub.id,
+			rub.codigo,
+			rub.nome,
+			rub.tipo,
+			rub.categoria,
+			rub.incide_inss,
+			rub.incide_irrf,
+			rub.incide_fgts,
+			rub.termos,
+			rub.criado,
+			rub.atualizado,
+			rub.apagado
+		FROM rubricas rub
+		WHERE rub.apagado IS NULL
+		`+conditions+`
+		ORDER BY rub.tipo, rub.nome, rub.id`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Rubrica{}, err
+	}
+
+	defer rows.Close()
+
+	var rubricas []models.Rubrica
+
+	for rows.Next() {
+		var rubrica = models.Rubrica{}
+
+		err := rows.Scan(
+			&rubrica.ID,
+			&rubrica.Codigo,
+			&rubrica.Nome,
+			&rubrica.Tipo,
+			&rubrica.Categoria,
+			&rubrica.IncideINSS,
+			&rubrica.IncideIRRF,
+			&rubrica.IncideFGTS,
+			&rubrica.Termos,
+			&rubrica.Criado,
+			&rubrica.Atualizado,
+			&rubrica.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Rubrica{}, err
+		}
+
+		rubricas = append(rubricas, rubrica)
+	}
+
+	return rubricas, nil
+}
+
+func (r *repository) Update(ctx context.Context, rubrica models.Rubrica) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE rubricas SET
+		codigo = ?,
+		nome = ?,
+		tipo = ?,
+		categoria = ?,
+		incide_inss = ?,
+		incide_irrf = ?,
+		incide_fgts = ?,
+		termos = ?,
+		atualizado = ?
+		WHERE id = ?`,
+		rubrica.Codigo,
+		rubrica.Nome,
+		rubrica.Tipo,
+		rubrica.Categoria,
+		rubrica.IncideINSS,
+		rubrica.IncideIRRF,
+		rubrica.IncideFGTS,
+		rubrica.Termos,
+		rubrica.Atualizado,
+		rubrica.ID,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE rubricas SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id = ?`,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+// Importar insere as rubricas em uma única transação. Rubricas com código já
+// cadastrado são mantidas como estão, para não desfazer alterações feitas no
+// catálogo. Retorna a quantidade de rubricas inseridas.
+func (r *repository) Importar(ctx context.Context, rubricas []models.Rubrica) (int, error) {
+	r.DB().BeginTransaction(ctx)
+
+	inseridas := 0
+
+	for _, rubrica := range rubricas {
+		result, err := r.DB().Write(
+			ctx,
+			`INSERT IGNORE INTO rubricas(codigo, nome, tipo, categoria, incide_inss, incide_irrf, incide_fgts, termos, criado)
+			VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rubrica.Codigo,
+			rubrica.Nome,
+			rubrica.Tipo,
+			rubrica.Categoria,
+			rubrica.IncideINSS,
+			rubrica.IncideIRRF,
+			rubrica.IncideFGTS,
+			rubrica.Termos,
+			rubrica.Criado,
+		)
+
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return 0, err
+		}
+
+		afetadas, err := result.RowsAffected()
+		if err != nil {
+			r.DB().Rollback(ctx)
+
+			log.Error(repositories.ERROR_INSERT, err)
+			return 0, err
+		}
+
+		inseridas += int(afetadas)
+	}
+
+	r.DB().Commit(ctx)
+
+	return inseridas, nil
+}
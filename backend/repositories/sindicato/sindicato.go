@@ -0,0 +1,187 @@
+package sindicato
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories"
+)
+
+type Repository interface {
+	Create(ctx context.Context, sindicato models.Sindicato) (models.Sindicato, error)
+	FindAll(ctx context.Context, search string) ([]models.Sindicato, error)
+	FindByID(ctx context.Context, id string) (models.Sindicato, error)
+	Update(ctx context.Context, sindicato models.Sindicato) error
+	Delete(ctx context.Context, id string) error
+}
+
+type repository struct {
+	repositories.Repository
+}
+
+func NewRepository(repo repositories.Repository) Repository {
+	return &repository{
+		Repository: repo,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, sindicato models.Sindicato) (models.Sindicato, error) {
+	r.DB().BeginTransaction(ctx)
+
+	result, err := r.DB().Write(
+		ctx,
+		`INSERT INTO sindicatos(nome, cnpj, mes_data_base, criado)
+		VALUES(?, ?, ?, ?)`,
+		sindicato.Nome,
+		sindicato.CNPJ,
+		sindicato.MesDataBase,
+		sindicato.Criado,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Sindicato{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_INSERT, err)
+		return models.Sindicato{}, err
+	}
+
+	r.DB().Commit(ctx)
+
+	sindicato.ID = id
+
+	return sindicato, nil
+}
+
+func (r *repository) FindAll(ctx context.Context, search string) ([]models.Sindicato, error) {
+	if search != "" {
+		searchLike := fmt.Sprintf("%%%s%%", search)
+		return r.find(ctx, " AND (sin.nome LIKE ? OR sin.cnpj LIKE ?)", searchLike, searchLike)
+	}
+
+	return r.find(ctx, "")
+}
+
+func (r *repository) FindByID(ctx context.Context, id string) (models.Sindicato, error) {
+	sindicatos, err := r.find(ctx, " AND sin.id = ?", id)
+	if err != nil || len(sindicatos) == 0 {
+		return models.Sindicato{}, err
+	}
+
+	return sindicatos[0], nil
+}
+
+func (r *repository) find(ctx context.Context, conditions string, arguments ...interface{}) ([]models.Sindicato, error) {
+	rows, err := r.DB().Select(
+		ctx,
+		`SELECT
+			sin.id,
+			sin.nome,
+			sin.cnpj,
+			sin.mes_data_base,
+			sin.criado,
+			sin.atualizado,
+			sin.apagado
+		FROM sindicatos sin
+		WHERE sin.apagado IS NULL
+		`+conditions+`
+		ORDER BY sin.nome, sin.id`,
+		arguments...,
+	)
+
+	if err != nil {
+		log.Error(repositories.ERROR_SELECT, err)
+		return []models.Sindicato{}, err
+	}
+
+	defer rows.Close()
+
+	var sindicatos []models.Sindicato
+
+	for rows.Next() {
+		var sindicato = models.Sindicato{}
+
+		err := rows.Scan(
+			&sindicato.ID,
+			&sindicato.Nome,
+			&sindicato.CNPJ,
+			&sindicato.MesDataBase,
+			&sindicato.Criado,
+			&sindicato.Atualizado,
+			&sindicato.Apagado,
+		)
+
+		if err != nil {
+			log.Error(repositories.ERROR_SELECT_SCAN, err)
+			return []models.Sindicato{}, err
+		}
+
+		sindicatos = append(sindicatos, sindicato)
+	}
+
+	return sindicatos, nil
+}
+
+func (r *repository) Update(ctx context.Context, sindicato models.Sindicato) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE sindicatos SET
+		nome = ?,
+		cnpj = ?,
+		mes_data_base = ?,
+		atualizado = ?
+		WHERE id = ?`,
+		sindicato.Nome,
+		sindicato.CNPJ,
+		sindicato.MesDataBase,
+		sindicato.Atualizado,
+		sindicato.ID,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_UPDATE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	r.DB().BeginTransaction(ctx)
+
+	_, err := r.DB().Write(
+		ctx,
+		`UPDATE sindicatos SET
+		atualizado = CURRENT_DATE(),
+		apagado = CURRENT_DATE()
+		WHERE id = ?`,
+		id,
+	)
+
+	if err != nil {
+		r.DB().Rollback(ctx)
+
+		log.Error(repositories.ERROR_DELETE, err)
+		return err
+	}
+
+	r.DB().Commit(ctx)
+
+	return nil
+}
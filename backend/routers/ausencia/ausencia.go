@@ -0,0 +1,25 @@
+package ausencia
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	ausenciaHandler "tsukuyomi/handlers/ausencia"
+	"tsukuyomi/repositories"
+	ausenciaRepository "tsukuyomi/repositories/ausencia"
+	ausenciaService "tsukuyomi/services/ausencia"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	ausenciaRepository := ausenciaRepository.NewRepository(repository)
+	ausenciaService := ausenciaService.NewService(ausenciaRepository)
+
+	handler := ausenciaHandler.NewHandler(ausenciaService)
+
+	router := app.Group("/emprego/:id/ausencias")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindByEmprego)
+	router.Get("/:id_ausencia", handler.FindByID)
+	router.Delete("/:id_ausencia", handler.Delete)
+	router.Post("/:id_ausencia/anexos", handler.CreateAnexo)
+	router.Get("/:id_ausencia/anexos/:id_anexo", handler.FindAnexo)
+}
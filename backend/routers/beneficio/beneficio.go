@@ -0,0 +1,25 @@
+package beneficio
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	beneficioHandler "tsukuyomi/handlers/beneficio"
+	"tsukuyomi/repositories"
+	beneficioRepository "tsukuyomi/repositories/beneficio"
+	"tsukuyomi/repositories/emprego"
+	beneficioService "tsukuyomi/services/beneficio"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	beneficioRepository := beneficioRepository.NewRepository(repository)
+	beneficioService := beneficioService.NewService(beneficioRepository, emprego.NewRepository(repository))
+
+	handler := beneficioHandler.NewHandler(beneficioService)
+
+	router := app.Group("/emprego/:id/beneficios")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindByEmprego)
+	router.Get("/:id_beneficio", handler.FindByID)
+	router.Put("/:id_beneficio", handler.Update)
+	router.Delete("/:id_beneficio", handler.Delete)
+}
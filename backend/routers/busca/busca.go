@@ -0,0 +1,26 @@
+package busca
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	buscaHandler "tsukuyomi/handlers/busca"
+	"tsukuyomi/repositories"
+	contatoEmpresa "tsukuyomi/repositories/contato_empresa"
+	"tsukuyomi/repositories/emprego"
+	"tsukuyomi/repositories/empresa"
+	"tsukuyomi/repositories/endereco"
+	buscaService "tsukuyomi/services/busca"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	service := buscaService.NewService(
+		empresa.NewRepository(repository),
+		endereco.NewRepository(repository),
+		contatoEmpresa.NewRepository(repository),
+		emprego.NewRepository(repository),
+	)
+
+	handler := buscaHandler.NewHandler(service)
+
+	app.Get("/busca", handler.Buscar)
+}
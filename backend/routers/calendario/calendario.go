@@ -0,0 +1,38 @@
+package calendario
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	calendarioHandler "tsukuyomi/handlers/calendario"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/ausencia"
+	"tsukuyomi/repositories/candidatura"
+	contatoEmpresa "tsukuyomi/repositories/contato_empresa"
+	"tsukuyomi/repositories/emprego"
+	"tsukuyomi/repositories/endereco"
+	"tsukuyomi/repositories/entrevista"
+	"tsukuyomi/repositories/feriado"
+	calendarioService "tsukuyomi/services/calendario"
+	entrevistaService "tsukuyomi/services/entrevista"
+	feriadoService "tsukuyomi/services/feriado"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	entrevistaService := entrevistaService.NewService(
+		entrevista.NewRepository(repository),
+		candidatura.NewRepository(repository),
+		contatoEmpresa.NewRepository(repository),
+		endereco.NewRepository(repository),
+	)
+
+	calendarioService := calendarioService.NewService(
+		entrevistaService,
+		feriadoService.NewService(feriado.NewRepository(repository)),
+		ausencia.NewRepository(repository),
+		emprego.NewRepository(repository),
+	)
+
+	handler := calendarioHandler.NewHandler(calendarioService)
+
+	app.Get("/calendario.ics", handler.ICS)
+}
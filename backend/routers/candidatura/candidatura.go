@@ -0,0 +1,27 @@
+package candidatura
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	candidaturaHandler "tsukuyomi/handlers/candidatura"
+	"tsukuyomi/repositories"
+	candidaturaRepository "tsukuyomi/repositories/candidatura"
+	"tsukuyomi/repositories/empresa"
+	candidaturaService "tsukuyomi/services/candidatura"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	candidaturaRepository := candidaturaRepository.NewRepository(repository)
+	candidaturaService := candidaturaService.NewService(candidaturaRepository, empresa.NewRepository(repository))
+
+	handler := candidaturaHandler.NewHandler(candidaturaService)
+
+	router := app.Group("/candidaturas")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindAll)
+	router.Get("/:id", handler.FindByID)
+	router.Put("/:id", handler.Update)
+	router.Delete("/:id", handler.Delete)
+	router.Post("/:id/situacao", handler.Transitar)
+	router.Post("/:id/converter", handler.Converter)
+}
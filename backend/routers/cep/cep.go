@@ -0,0 +1,16 @@
+package cep
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	cepHandler "tsukuyomi/handlers/cep"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/cep"
+	cepService "tsukuyomi/services/cep"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	handler := cepHandler.NewHandler(cepService.NewService(cep.NewRepository(repository)))
+
+	app.Get("/endereco/cep/:cep", handler.FindByCEP)
+}
@@ -0,0 +1,27 @@
+package cnpj
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	cnpjHandler "tsukuyomi/handlers/cnpj"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/cnpj"
+	"tsukuyomi/repositories/empresa"
+	"tsukuyomi/repositories/endereco"
+	cnpjService "tsukuyomi/services/cnpj"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	service := cnpjService.NewService(
+		cnpj.NewRepository(repository),
+		empresa.NewRepository(repository),
+		endereco.NewRepository(repository),
+	)
+
+	handler := cnpjHandler.NewHandler(service)
+
+	router := app.Group("/empresa/consulta-cnpj")
+
+	router.Get("/:cnpj", handler.Consultar)
+	router.Post("/:cnpj", handler.Cadastrar)
+}
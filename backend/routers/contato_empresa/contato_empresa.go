@@ -6,12 +6,13 @@ import (
 	contatoEmpresaHandler "tsukuyomi/handlers/contato_empresa"
 	"tsukuyomi/repositories"
 	contatoEmpresaRepository "tsukuyomi/repositories/contato_empresa"
+	"tsukuyomi/repositories/pessoa"
 	contatoEmpresaService "tsukuyomi/services/contato_empresa"
 )
 
 func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
 	contatoEmpresaRepository := contatoEmpresaRepository.NewRepository(repository)
-	contatoEmpresaService := contatoEmpresaService.NewService(contatoEmpresaRepository)
+	contatoEmpresaService := contatoEmpresaService.NewService(contatoEmpresaRepository, pessoa.NewRepository(repository))
 
 	handler := contatoEmpresaHandler.NewHandler(contatoEmpresaService)
 
@@ -0,0 +1,49 @@
+package convencaocoletiva
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	convencaoColetivaHandler "tsukuyomi/handlers/convencao_coletiva"
+	"tsukuyomi/repositories"
+	convencaoColetivaRepository "tsukuyomi/repositories/convencao_coletiva"
+	"tsukuyomi/repositories/emprego"
+	empregoOcupacao "tsukuyomi/repositories/emprego_ocupacao"
+	"tsukuyomi/repositories/empresa"
+	"tsukuyomi/repositories/ocupacao"
+	"tsukuyomi/repositories/remuneracao"
+	"tsukuyomi/repositories/sindicato"
+	convencaoColetivaService "tsukuyomi/services/convencao_coletiva"
+	remuneracaoService "tsukuyomi/services/remuneracao"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	convencaoColetivaRepository := convencaoColetivaRepository.NewRepository(repository)
+	empregoRepository := emprego.NewRepository(repository)
+	empregoOcupacaoRepository := empregoOcupacao.NewRepository(repository)
+	remuneracaoRepository := remuneracao.NewRepository(repository)
+
+	convencaoColetivaService := convencaoColetivaService.NewService(
+		convencaoColetivaRepository,
+		empresa.NewRepository(repository),
+		sindicato.NewRepository(repository),
+		empregoRepository,
+		empregoOcupacaoRepository,
+		remuneracaoRepository,
+		remuneracaoService.NewService(
+			remuneracaoRepository,
+			empregoRepository,
+			ocupacao.NewRepository(repository),
+			empregoOcupacaoRepository,
+		),
+	)
+
+	handler := convencaoColetivaHandler.NewHandler(convencaoColetivaService)
+
+	router := app.Group("/empresa/:id/convencoes")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindByEmpresa)
+	router.Get("/:id_convencao", handler.FindByID)
+	router.Put("/:id_convencao", handler.Update)
+	router.Delete("/:id_convencao", handler.Delete)
+	router.Post("/:id_convencao/reajuste", handler.AplicarReajuste)
+}
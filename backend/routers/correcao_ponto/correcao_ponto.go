@@ -0,0 +1,25 @@
+package correcaoponto
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	correcaoPontoHandler "tsukuyomi/handlers/correcao_ponto"
+	"tsukuyomi/repositories"
+	correcaoPontoRepository "tsukuyomi/repositories/correcao_ponto"
+	"tsukuyomi/repositories/ponto"
+	correcaoPontoService "tsukuyomi/services/correcao_ponto"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	correcaoPontoRepository := correcaoPontoRepository.NewRepository(repository)
+	correcaoPontoService := correcaoPontoService.NewService(correcaoPontoRepository, ponto.NewRepository(repository))
+
+	handler := correcaoPontoHandler.NewHandler(correcaoPontoService)
+
+	router := app.Group("/emprego/:id/ponto/correcoes")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindByEmprego)
+	router.Get("/:id_correcao", handler.FindByID)
+	router.Post("/:id_correcao/decisao", handler.Decidir)
+	router.Delete("/:id_correcao", handler.Delete)
+}
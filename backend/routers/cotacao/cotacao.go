@@ -0,0 +1,21 @@
+package cotacao
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	cotacaoHandler "tsukuyomi/handlers/cotacao"
+	"tsukuyomi/repositories"
+	cotacaoRepository "tsukuyomi/repositories/cotacao"
+	cotacaoService "tsukuyomi/services/cotacao"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	cotacaoRepository := cotacaoRepository.NewRepository(repository)
+	cotacaoService := cotacaoService.NewService(cotacaoRepository)
+
+	handler := cotacaoHandler.NewHandler(cotacaoService)
+
+	router := app.Group("/cotacoes")
+	router.Post("/importar", handler.Importar)
+	router.Get("/:moeda", handler.FindAll)
+}
@@ -5,13 +5,21 @@ import (
 
 	empregoHandler "tsukuyomi/handlers/emprego"
 	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/beneficio"
+	"tsukuyomi/repositories/cotacao"
 	empregoRepository "tsukuyomi/repositories/emprego"
+	"tsukuyomi/repositories/remuneracao"
 	empregoService "tsukuyomi/services/emprego"
 )
 
 func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
 	empregoRepository := empregoRepository.NewRepository(repository)
-	empregoService := empregoService.NewService(empregoRepository)
+	empregoService := empregoService.NewService(
+		empregoRepository,
+		remuneracao.NewRepository(repository),
+		beneficio.NewRepository(repository),
+		cotacao.NewRepository(repository),
+	)
 
 	handler := empregoHandler.NewHandler(empregoService)
 
@@ -0,0 +1,30 @@
+package empregoocupacao
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	empregoOcupacaoHandler "tsukuyomi/handlers/emprego_ocupacao"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/emprego"
+	empregoOcupacaoRepository "tsukuyomi/repositories/emprego_ocupacao"
+	"tsukuyomi/repositories/ocupacao"
+	"tsukuyomi/repositories/remuneracao"
+	empregoOcupacaoService "tsukuyomi/services/emprego_ocupacao"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	empregoOcupacaoRepository := empregoOcupacaoRepository.NewRepository(repository)
+	empregoOcupacaoService := empregoOcupacaoService.NewService(
+		empregoOcupacaoRepository,
+		emprego.NewRepository(repository),
+		ocupacao.NewRepository(repository),
+		remuneracao.NewRepository(repository),
+	)
+
+	handler := empregoOcupacaoHandler.NewHandler(empregoOcupacaoService)
+
+	router := app.Group("/emprego/:id/ocupacoes")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindByEmprego)
+	router.Delete("/:id_historico", handler.Delete)
+}
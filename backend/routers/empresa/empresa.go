@@ -21,4 +21,5 @@ func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
 	router.Get("/:id", handler.FindByID)
 	router.Put("/:id", handler.Update)
 	router.Delete("/:id", handler.Delete)
+	router.Post("/:id/mesclar", handler.Mesclar)
 }
@@ -5,21 +5,28 @@ import (
 
 	enderecoHandler "tsukuyomi/handlers/endereco"
 	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/cep"
 	enderecoRepository "tsukuyomi/repositories/endereco"
+	cepService "tsukuyomi/services/cep"
 	enderecoService "tsukuyomi/services/endereco"
 )
 
 func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
 	enderecoRepository := enderecoRepository.NewRepository(repository)
-	enderecoService := enderecoService.NewService(enderecoRepository)
+	enderecoService := enderecoService.NewService(
+		enderecoRepository,
+		cepService.NewService(cep.NewRepository(repository)),
+	)
 
 	handler := enderecoHandler.NewHandler(enderecoService)
 
 	router := app.Group("/endereco")
 	router.Post("/", handler.Create)
 	router.Get("/", handler.FindAll)
+	router.Get("/duplicados", handler.Duplicados)
 	router.Get("/:id", handler.FindByID)
 	router.Put("/:id", handler.Update)
 	router.Delete("/:id", handler.Delete)
+	router.Post("/:id/mesclar", handler.Mesclar)
 
 }
@@ -0,0 +1,32 @@
+package entrevista
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	entrevistaHandler "tsukuyomi/handlers/entrevista"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/candidatura"
+	contatoEmpresa "tsukuyomi/repositories/contato_empresa"
+	"tsukuyomi/repositories/endereco"
+	entrevistaRepository "tsukuyomi/repositories/entrevista"
+	entrevistaService "tsukuyomi/services/entrevista"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	entrevistaRepository := entrevistaRepository.NewRepository(repository)
+	entrevistaService := entrevistaService.NewService(
+		entrevistaRepository,
+		candidatura.NewRepository(repository),
+		contatoEmpresa.NewRepository(repository),
+		endereco.NewRepository(repository),
+	)
+
+	handler := entrevistaHandler.NewHandler(entrevistaService)
+
+	router := app.Group("/candidaturas/:id/entrevistas")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindByCandidatura)
+	router.Get("/:id_entrevista", handler.FindByID)
+	router.Put("/:id_entrevista", handler.Update)
+	router.Delete("/:id_entrevista", handler.Delete)
+}
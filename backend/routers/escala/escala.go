@@ -0,0 +1,32 @@
+package escala
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	escalaHandler "tsukuyomi/handlers/escala"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/ausencia"
+	"tsukuyomi/repositories/emprego"
+	"tsukuyomi/repositories/empresa"
+	escalaRepository "tsukuyomi/repositories/escala"
+	feriadoRepository "tsukuyomi/repositories/feriado"
+	escalaService "tsukuyomi/services/escala"
+	feriadoService "tsukuyomi/services/feriado"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	escalaRepository := escalaRepository.NewRepository(repository)
+	empregoRepository := emprego.NewRepository(repository)
+	empresaRepository := empresa.NewRepository(repository)
+	feriadoService := feriadoService.NewService(feriadoRepository.NewRepository(repository))
+
+	escalaService := escalaService.NewService(escalaRepository, empregoRepository, empresaRepository, ausencia.NewRepository(repository), feriadoService)
+
+	handler := escalaHandler.NewHandler(escalaService)
+
+	router := app.Group("/emprego/:id/escalas")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindByEmprego)
+	router.Get("/previsto", handler.Previsto)
+	router.Delete("/:id_escala", handler.Delete)
+}
@@ -0,0 +1,24 @@
+package feriado
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	feriadoHandler "tsukuyomi/handlers/feriado"
+	"tsukuyomi/repositories"
+	feriadoRepository "tsukuyomi/repositories/feriado"
+	feriadoService "tsukuyomi/services/feriado"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	feriadoRepository := feriadoRepository.NewRepository(repository)
+	feriadoService := feriadoService.NewService(feriadoRepository)
+
+	handler := feriadoHandler.NewHandler(feriadoService)
+
+	router := app.Group("/feriados")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindAll)
+	router.Get("/dias-uteis", handler.DiasUteis)
+	router.Get("/:id", handler.FindByID)
+	router.Delete("/:id", handler.Delete)
+}
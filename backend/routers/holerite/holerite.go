@@ -0,0 +1,32 @@
+package holerite
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	holeriteHandler "tsukuyomi/handlers/holerite"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/emprego"
+	holeriteRepository "tsukuyomi/repositories/holerite"
+	"tsukuyomi/repositories/remuneracao"
+	"tsukuyomi/repositories/rubrica"
+	holeriteService "tsukuyomi/services/holerite"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	holeriteRepository := holeriteRepository.NewRepository(repository)
+	holeriteService := holeriteService.NewService(
+		holeriteRepository,
+		emprego.NewRepository(repository),
+		remuneracao.NewRepository(repository),
+		rubrica.NewRepository(repository),
+	)
+
+	handler := holeriteHandler.NewHandler(holeriteService)
+
+	router := app.Group("/emprego/:id/holerites")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindByEmprego)
+	router.Get("/:id_holerite", handler.FindByID)
+	router.Post("/:id_holerite/classificar", handler.Classificar)
+	router.Get("/:id_holerite/conferencia", handler.Conferir)
+}
@@ -0,0 +1,31 @@
+package indice
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	indiceHandler "tsukuyomi/handlers/indice"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/cotacao"
+	"tsukuyomi/repositories/emprego"
+	indiceRepository "tsukuyomi/repositories/indice"
+	"tsukuyomi/repositories/remuneracao"
+	indiceService "tsukuyomi/services/indice"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	indiceRepository := indiceRepository.NewRepository(repository)
+	indiceService := indiceService.NewService(
+		indiceRepository,
+		emprego.NewRepository(repository),
+		remuneracao.NewRepository(repository),
+		cotacao.NewRepository(repository),
+	)
+
+	handler := indiceHandler.NewHandler(indiceService)
+
+	router := app.Group("/indices")
+	router.Get("/:indice", handler.FindAll)
+	router.Post("/:indice/importar", handler.Importar)
+
+	app.Get("/emprego/:id/evolucao-real", handler.EvolucaoReal)
+}
@@ -0,0 +1,26 @@
+package notafiscal
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	notaFiscalHandler "tsukuyomi/handlers/nota_fiscal"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/emprego"
+	notaFiscalRepository "tsukuyomi/repositories/nota_fiscal"
+	notaFiscalService "tsukuyomi/services/nota_fiscal"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	notaFiscalRepository := notaFiscalRepository.NewRepository(repository)
+	notaFiscalService := notaFiscalService.NewService(notaFiscalRepository, emprego.NewRepository(repository))
+
+	handler := notaFiscalHandler.NewHandler(notaFiscalService)
+
+	router := app.Group("/emprego/:id/notas-fiscais")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindByEmprego)
+	router.Get("/das", handler.EstimarDAS)
+	router.Get("/:id_nota_fiscal", handler.FindByID)
+	router.Put("/:id_nota_fiscal", handler.Update)
+	router.Delete("/:id_nota_fiscal", handler.Delete)
+}
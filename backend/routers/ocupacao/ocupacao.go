@@ -0,0 +1,25 @@
+package ocupacao
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	ocupacaoHandler "tsukuyomi/handlers/ocupacao"
+	"tsukuyomi/repositories"
+	ocupacaoRepository "tsukuyomi/repositories/ocupacao"
+	ocupacaoService "tsukuyomi/services/ocupacao"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	ocupacaoRepository := ocupacaoRepository.NewRepository(repository)
+	ocupacaoService := ocupacaoService.NewService(ocupacaoRepository)
+
+	handler := ocupacaoHandler.NewHandler(ocupacaoService)
+
+	router := app.Group("/ocupacoes")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindAll)
+	router.Post("/importar-cbo", handler.ImportarCBO)
+	router.Get("/:id", handler.FindByID)
+	router.Put("/:id", handler.Update)
+	router.Delete("/:id", handler.Delete)
+}
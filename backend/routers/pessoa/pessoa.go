@@ -0,0 +1,32 @@
+package pessoa
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	pessoaHandler "tsukuyomi/handlers/pessoa"
+	"tsukuyomi/repositories"
+	contatoEmpresa "tsukuyomi/repositories/contato_empresa"
+	"tsukuyomi/repositories/empresa"
+	pessoaRepository "tsukuyomi/repositories/pessoa"
+	pessoaService "tsukuyomi/services/pessoa"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	pessoaRepository := pessoaRepository.NewRepository(repository)
+	pessoaService := pessoaService.NewService(
+		pessoaRepository,
+		empresa.NewRepository(repository),
+		contatoEmpresa.NewRepository(repository),
+	)
+
+	handler := pessoaHandler.NewHandler(pessoaService)
+
+	app.Get("/empresa/:id/pessoas", handler.FindByEmpresa)
+
+	router := app.Group("/pessoas")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindAll)
+	router.Get("/:id", handler.FindByID)
+	router.Put("/:id", handler.Update)
+	router.Delete("/:id", handler.Delete)
+}
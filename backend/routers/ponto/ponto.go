@@ -0,0 +1,40 @@
+package ponto
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	pontoHandler "tsukuyomi/handlers/ponto"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/ausencia"
+	correcaoPonto "tsukuyomi/repositories/correcao_ponto"
+	"tsukuyomi/repositories/emprego"
+	"tsukuyomi/repositories/empresa"
+	"tsukuyomi/repositories/escala"
+	"tsukuyomi/repositories/feriado"
+	pontoRepository "tsukuyomi/repositories/ponto"
+	escalaService "tsukuyomi/services/escala"
+	feriadoService "tsukuyomi/services/feriado"
+	pontoService "tsukuyomi/services/ponto"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	pontoRepository := pontoRepository.NewRepository(repository)
+	feriadoService := feriadoService.NewService(feriado.NewRepository(repository))
+	empregoRepository := emprego.NewRepository(repository)
+	escalaService := escalaService.NewService(
+		escala.NewRepository(repository),
+		empregoRepository,
+		empresa.NewRepository(repository),
+		ausencia.NewRepository(repository),
+		feriadoService,
+	)
+
+	pontoService := pontoService.NewService(pontoRepository, empregoRepository, correcaoPonto.NewRepository(repository), escalaService)
+
+	handler := pontoHandler.NewHandler(pontoService)
+
+	router := app.Group("/emprego/:id")
+	router.Get("/conformidade", handler.Conformidade)
+	router.Get("/espelho-ponto", handler.Espelho)
+	router.Post("/ponto/importar", handler.ImportarAFD)
+}
@@ -0,0 +1,23 @@
+package relatorio
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	relatorioHandler "tsukuyomi/handlers/relatorio"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/cotacao"
+	"tsukuyomi/repositories/holerite"
+	relatorioService "tsukuyomi/services/relatorio"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	relatorioService := relatorioService.NewService(
+		holerite.NewRepository(repository),
+		cotacao.NewRepository(repository),
+	)
+
+	handler := relatorioHandler.NewHandler(relatorioService)
+
+	router := app.Group("/relatorios")
+	router.Get("/rendimentos", handler.Rendimentos)
+}
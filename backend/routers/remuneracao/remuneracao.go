@@ -0,0 +1,30 @@
+package remuneracao
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	remuneracaoHandler "tsukuyomi/handlers/remuneracao"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/emprego"
+	empregoOcupacao "tsukuyomi/repositories/emprego_ocupacao"
+	"tsukuyomi/repositories/ocupacao"
+	remuneracaoRepository "tsukuyomi/repositories/remuneracao"
+	remuneracaoService "tsukuyomi/services/remuneracao"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	remuneracaoRepository := remuneracaoRepository.NewRepository(repository)
+	remuneracaoService := remuneracaoService.NewService(
+		remuneracaoRepository,
+		emprego.NewRepository(repository),
+		ocupacao.NewRepository(repository),
+		empregoOcupacao.NewRepository(repository),
+	)
+
+	handler := remuneracaoHandler.NewHandler(remuneracaoService)
+
+	router := app.Group("/emprego/:id/remuneracoes")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindByEmprego)
+	router.Delete("/:id_remuneracao", handler.Delete)
+}
@@ -7,11 +7,36 @@ import (
 	"tsukuyomi/config"
 	_ "tsukuyomi/docs"
 	"tsukuyomi/repositories"
+	"tsukuyomi/routers/ausencia"
+	"tsukuyomi/routers/beneficio"
+	"tsukuyomi/routers/busca"
+	"tsukuyomi/routers/calendario"
+	"tsukuyomi/routers/candidatura"
+	"tsukuyomi/routers/cep"
+	"tsukuyomi/routers/cnpj"
 	contatoEmpresa "tsukuyomi/routers/contato_empresa"
+	convencaoColetiva "tsukuyomi/routers/convencao_coletiva"
+	correcaoPonto "tsukuyomi/routers/correcao_ponto"
+	"tsukuyomi/routers/cotacao"
 	"tsukuyomi/routers/emprego"
+	empregoOcupacao "tsukuyomi/routers/emprego_ocupacao"
 	"tsukuyomi/routers/empresa"
 	"tsukuyomi/routers/endereco"
 	enderecoEmpresa "tsukuyomi/routers/endereco_empresa"
+	"tsukuyomi/routers/entrevista"
+	"tsukuyomi/routers/escala"
+	"tsukuyomi/routers/feriado"
+	"tsukuyomi/routers/holerite"
+	"tsukuyomi/routers/indice"
+	notaFiscal "tsukuyomi/routers/nota_fiscal"
+	"tsukuyomi/routers/ocupacao"
+	"tsukuyomi/routers/pessoa"
+	"tsukuyomi/routers/ponto"
+	"tsukuyomi/routers/relatorio"
+	"tsukuyomi/routers/remuneracao"
+	"tsukuyomi/routers/rubrica"
+	"tsukuyomi/routers/simulacao"
+	"tsukuyomi/routers/sindicato"
 )
 
 func SetupRouter(app *fiber.App, config *config.Config) {
@@ -21,8 +46,33 @@ func SetupRouter(app *fiber.App, config *config.Config) {
 	repository := repositories.NewRepository(config)
 
 	empresa.RegisterRoutes(app, repository)
+	cnpj.RegisterRoutes(app, repository)
 	endereco.RegisterRoutes(app, repository)
+	cep.RegisterRoutes(app, repository)
+	pessoa.RegisterRoutes(app, repository)
 	contatoEmpresa.RegisterRoutes(app, repository)
 	enderecoEmpresa.RegisterRoutes(app, repository)
 	emprego.RegisterRoutes(app, repository)
+	feriado.RegisterRoutes(app, repository)
+	escala.RegisterRoutes(app, repository)
+	ponto.RegisterRoutes(app, repository)
+	ausencia.RegisterRoutes(app, repository)
+	correcaoPonto.RegisterRoutes(app, repository)
+	ocupacao.RegisterRoutes(app, repository)
+	empregoOcupacao.RegisterRoutes(app, repository)
+	remuneracao.RegisterRoutes(app, repository)
+	beneficio.RegisterRoutes(app, repository)
+	rubrica.RegisterRoutes(app, repository)
+	holerite.RegisterRoutes(app, repository)
+	relatorio.RegisterRoutes(app, repository)
+	sindicato.RegisterRoutes(app, repository)
+	convencaoColetiva.RegisterRoutes(app, repository)
+	indice.RegisterRoutes(app, repository)
+	cotacao.RegisterRoutes(app, repository)
+	notaFiscal.RegisterRoutes(app, repository)
+	simulacao.RegisterRoutes(app, repository)
+	candidatura.RegisterRoutes(app, repository)
+	entrevista.RegisterRoutes(app, repository)
+	calendario.RegisterRoutes(app, repository)
+	busca.RegisterRoutes(app, repository)
 }
@@ -0,0 +1,25 @@
+package rubrica
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	rubricaHandler "tsukuyomi/handlers/rubrica"
+	"tsukuyomi/repositories"
+	rubricaRepository "tsukuyomi/repositories/rubrica"
+	rubricaService "tsukuyomi/services/rubrica"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	rubricaRepository := rubricaRepository.NewRepository(repository)
+	rubricaService := rubricaService.NewService(rubricaRepository)
+
+	handler := rubricaHandler.NewHandler(rubricaService)
+
+	router := app.Group("/rubricas")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindAll)
+	router.Post("/padrao", handler.ImportarPadrao)
+	router.Get("/:id", handler.FindByID)
+	router.Put("/:id", handler.Update)
+	router.Delete("/:id", handler.Delete)
+}
@@ -0,0 +1,29 @@
+package simulacao
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	simulacaoHandler "tsukuyomi/handlers/simulacao"
+	"tsukuyomi/repositories"
+	"tsukuyomi/repositories/beneficio"
+	"tsukuyomi/repositories/cotacao"
+	"tsukuyomi/repositories/emprego"
+	"tsukuyomi/repositories/remuneracao"
+	empregoService "tsukuyomi/services/emprego"
+	simulacaoService "tsukuyomi/services/simulacao"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	empregoService := empregoService.NewService(
+		emprego.NewRepository(repository),
+		remuneracao.NewRepository(repository),
+		beneficio.NewRepository(repository),
+		cotacao.NewRepository(repository),
+	)
+	simulacaoService := simulacaoService.NewService(empregoService)
+
+	handler := simulacaoHandler.NewHandler(simulacaoService)
+
+	router := app.Group("/simulacoes")
+	router.Post("/comparar-ofertas", handler.CompararOfertas)
+}
@@ -0,0 +1,24 @@
+package sindicato
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	sindicatoHandler "tsukuyomi/handlers/sindicato"
+	"tsukuyomi/repositories"
+	sindicatoRepository "tsukuyomi/repositories/sindicato"
+	sindicatoService "tsukuyomi/services/sindicato"
+)
+
+func RegisterRoutes(app *fiber.App, repository repositories.Repository) {
+	sindicatoRepository := sindicatoRepository.NewRepository(repository)
+	sindicatoService := sindicatoService.NewService(sindicatoRepository)
+
+	handler := sindicatoHandler.NewHandler(sindicatoService)
+
+	router := app.Group("/sindicatos")
+	router.Post("/", handler.Create)
+	router.Get("/", handler.FindAll)
+	router.Get("/:id", handler.FindByID)
+	router.Put("/:id", handler.Update)
+	router.Delete("/:id", handler.Delete)
+}
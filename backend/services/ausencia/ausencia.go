@@ -0,0 +1,76 @@
+package ausencia
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/ausencia"
+)
+
+const (
+	ERROR_AUSENCIA_NOT_FOUND = "ausência não encontrada"
+)
+
+type Service interface {
+	Create(ctx context.Context, ausencia models.Ausencia) (models.Ausencia, error)
+	FindByEmprego(ctx context.Context, id_emprego string) ([]models.Ausencia, error)
+	FindByID(ctx context.Context, id_emprego, id string) (models.Ausencia, error)
+	Delete(ctx context.Context, id_emprego, id string) error
+	CreateAnexo(ctx context.Context, id_emprego string, anexo models.AusenciaAnexo) (models.AusenciaAnexo, error)
+	FindAnexo(ctx context.Context, id_emprego, id_ausencia, id string) (models.AusenciaAnexo, error)
+}
+
+type service struct {
+	repository ausencia.Repository
+}
+
+func NewService(repository ausencia.Repository) Service {
+	return &service{
+		repository: repository,
+	}
+}
+
+func (s *service) Create(ctx context.Context, ausencia models.Ausencia) (models.Ausencia, error) {
+	return s.repository.Create(ctx, ausencia)
+}
+
+func (s *service) FindByEmprego(ctx context.Context, id_emprego string) ([]models.Ausencia, error) {
+	return s.repository.FindByEmprego(ctx, id_emprego)
+}
+
+func (s *service) FindByID(ctx context.Context, id_emprego, id string) (models.Ausencia, error) {
+	return s.repository.FindByID(ctx, id_emprego, id)
+}
+
+func (s *service) Delete(ctx context.Context, id_emprego, id string) error {
+	return s.repository.Delete(ctx, id_emprego, id)
+}
+
+// CreateAnexo anexa um documento, como um atestado, a uma ausência do emprego.
+func (s *service) CreateAnexo(ctx context.Context, id_emprego string, anexo models.AusenciaAnexo) (models.AusenciaAnexo, error) {
+	ausencia, err := s.repository.FindByID(ctx, id_emprego, fmt.Sprint(anexo.IDAusencia))
+	if err != nil {
+		return models.AusenciaAnexo{}, err
+	}
+
+	if ausencia.ID == 0 {
+		return models.AusenciaAnexo{}, errors.New(ERROR_AUSENCIA_NOT_FOUND)
+	}
+
+	return s.repository.CreateAnexo(ctx, anexo)
+}
+
+func (s *service) FindAnexo(ctx context.Context, id_emprego, id_ausencia, id string) (models.AusenciaAnexo, error) {
+	ausencia, err := s.repository.FindByID(ctx, id_emprego, id_ausencia)
+	if err != nil {
+		return models.AusenciaAnexo{}, err
+	}
+
+	if ausencia.ID == 0 {
+		return models.AusenciaAnexo{}, errors.New(ERROR_AUSENCIA_NOT_FOUND)
+	}
+
+	return s.repository.FindAnexo(ctx, id_ausencia, id)
+}
@@ -0,0 +1,64 @@
+package beneficio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/beneficio"
+	"tsukuyomi/repositories/emprego"
+)
+
+const (
+	ERROR_EMPREGO_NOT_FOUND = "emprego não encontrado"
+)
+
+type Service interface {
+	Create(ctx context.Context, beneficio models.Beneficio) (models.Beneficio, error)
+	FindByEmprego(ctx context.Context, id_emprego string) ([]models.Beneficio, error)
+	FindByID(ctx context.Context, id_emprego, id string) (models.Beneficio, error)
+	Update(ctx context.Context, beneficio models.Beneficio) error
+	Delete(ctx context.Context, id_emprego, id string) error
+}
+
+type service struct {
+	repository        beneficio.Repository
+	EmpregoRepository emprego.Repository
+}
+
+func NewService(repository beneficio.Repository, empregoRepository emprego.Repository) Service {
+	return &service{
+		repository:        repository,
+		EmpregoRepository: empregoRepository,
+	}
+}
+
+func (s *service) Create(ctx context.Context, beneficio models.Beneficio) (models.Beneficio, error) {
+	emprego, err := s.EmpregoRepository.FindByID(ctx, fmt.Sprint(beneficio.IDEmprego))
+	if err != nil {
+		return models.Beneficio{}, err
+	}
+
+	if emprego.ID == 0 {
+		return models.Beneficio{}, errors.New(ERROR_EMPREGO_NOT_FOUND)
+	}
+
+	return s.repository.Create(ctx, beneficio)
+}
+
+func (s *service) FindByEmprego(ctx context.Context, id_emprego string) ([]models.Beneficio, error) {
+	return s.repository.FindByEmprego(ctx, id_emprego)
+}
+
+func (s *service) FindByID(ctx context.Context, id_emprego, id string) (models.Beneficio, error) {
+	return s.repository.FindByID(ctx, id_emprego, id)
+}
+
+func (s *service) Update(ctx context.Context, beneficio models.Beneficio) error {
+	return s.repository.Update(ctx, beneficio)
+}
+
+func (s *service) Delete(ctx context.Context, id_emprego, id string) error {
+	return s.repository.Delete(ctx, id_emprego, id)
+}
@@ -0,0 +1,161 @@
+package beneficio
+
+import (
+	"strings"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/datas"
+	"tsukuyomi/services/texto"
+	"tsukuyomi/services/tributos"
+)
+
+// DIAS_UTEIS_MES é a média de dias úteis no mês usada para converter
+// benefícios diários, como o vale-refeição, em valores mensais.
+const DIAS_UTEIS_MES = 22
+
+// Compensacao soma a remuneração e os benefícios vigentes no dia. Para
+// empregos encerrados, considera o último dia do emprego, que DiaCompensacao
+// retorna. O salário é a remuneração mais recente do histórico salarial ou,
+// sem histórico, a remuneração inicial. No regime CLT, o salário anual inclui
+// o 13º salário e o terço constitucional de férias. O salário em moeda
+// estrangeira é convertido para reais pela conversão informada; sem ela, os
+// totais não são somados, já que os benefícios são pagos em reais.
+func Compensacao(emprego models.Emprego, remuneracoes []models.Remuneracao, beneficios []models.Beneficio, dia time.Time, conversao *models.Conversao) models.CompensacaoTotal {
+	dia = DiaCompensacao(emprego, dia)
+
+	salario := Salario(emprego, remuneracoes, dia)
+	moeda := MoedaSalario(emprego, remuneracoes, dia)
+
+	var original *models.Dinheiro
+
+	if moeda != models.MOEDA_BRL && conversao != nil {
+		original = &salario
+		salario = salario.Multiplicar(conversao.Taxa)
+		moeda = models.MOEDA_BRL
+	} else {
+		conversao = nil
+	}
+
+	salarioAnual := salario * 12
+	if CLT(emprego.TipoContrato) {
+		salarioAnual = salario*(12+1) + salario.Dividir(3)
+	}
+
+	compensacao := models.CompensacaoTotal{
+		Data:            dia,
+		Moeda:           moeda,
+		Salario:         tributos.Arredondar(salario),
+		SalarioOriginal: original,
+		Conversao:       conversao,
+		SalarioAnual:    tributos.Arredondar(salarioAnual),
+		Beneficios:      []models.BeneficioCompensacao{},
+	}
+
+	for _, beneficio := range beneficios {
+		if beneficio.DataInicio.After(dia) || (beneficio.DataFim != nil && beneficio.DataFim.Before(dia)) {
+			continue
+		}
+
+		valor := beneficio.Valor
+		if beneficio.MultiploSalario != nil {
+			valor = salario.Multiplicar(*beneficio.MultiploSalario)
+		}
+
+		vezes := ocorrenciasAno(beneficio.Periodicidade)
+		anual := (valor - beneficio.Coparticipacao) * models.Dinheiro(vezes)
+
+		compensacao.Beneficios = append(compensacao.Beneficios, models.BeneficioCompensacao{
+			IDBeneficio:    beneficio.ID,
+			Tipo:           beneficio.Tipo,
+			Valor:          tributos.Arredondar(valor),
+			Coparticipacao: beneficio.Coparticipacao,
+			Mensal:         tributos.Arredondar(anual.Dividir(12)),
+			Anual:          tributos.Arredondar(anual),
+		})
+
+		compensacao.BeneficiosAnual += anual
+		compensacao.CoparticipacaoAnual += beneficio.Coparticipacao * models.Dinheiro(vezes)
+	}
+
+	compensacao.BeneficiosMensal = tributos.Arredondar(compensacao.BeneficiosAnual.Dividir(12))
+	compensacao.BeneficiosAnual = tributos.Arredondar(compensacao.BeneficiosAnual)
+	compensacao.CoparticipacaoAnual = tributos.Arredondar(compensacao.CoparticipacaoAnual)
+
+	if moeda == models.MOEDA_BRL {
+		totalAnual := tributos.Arredondar(salarioAnual + compensacao.BeneficiosAnual)
+		totalMensal := tributos.Arredondar(totalAnual.Dividir(12))
+
+		compensacao.TotalAnual = &totalAnual
+		compensacao.TotalMensal = &totalMensal
+	}
+
+	return compensacao
+}
+
+// DiaCompensacao retorna o dia considerado na compensação: o próprio dia ou,
+// para empregos encerrados antes dele, o último dia do emprego.
+func DiaCompensacao(emprego models.Emprego, dia time.Time) time.Time {
+	dia = datas.Dia(dia)
+
+	if emprego.DataFim != nil && emprego.DataFim.Before(dia) {
+		dia = datas.Dia(*emprego.DataFim)
+	}
+
+	return dia
+}
+
+// Salario retorna a remuneração vigente no dia, a partir do histórico
+// salarial ordenado por data, ou a remuneração inicial do emprego.
+func Salario(emprego models.Emprego, remuneracoes []models.Remuneracao, dia time.Time) models.Dinheiro {
+	salario := emprego.RemuneracaoInicial
+
+	for _, remuneracao := range remuneracoes {
+		if !remuneracao.Data.After(dia) {
+			salario = remuneracao.Remuneracao
+		}
+	}
+
+	return salario
+}
+
+// MoedaSalario retorna a moeda da remuneração vigente no dia. Remunerações
+// sem moeda, e empregos sem moeda, são em reais.
+func MoedaSalario(emprego models.Emprego, remuneracoes []models.Remuneracao, dia time.Time) string {
+	moeda := emprego.Moeda
+
+	for _, remuneracao := range remuneracoes {
+		if !remuneracao.Data.After(dia) {
+			moeda = remuneracao.Moeda
+			if moeda == "" {
+				moeda = emprego.Moeda
+			}
+		}
+	}
+
+	if moeda == "" {
+		return models.MOEDA_BRL
+	}
+
+	return moeda
+}
+
+// CLT informa se o tipo de contratação, que é texto livre, é o regime CLT.
+func CLT(tipoContrato string) bool {
+	return strings.Contains(" "+texto.Normalizar(tipoContrato)+" ", " clt ")
+}
+
+func ocorrenciasAno(periodicidade string) int64 {
+	switch periodicidade {
+	case models.PERIODICIDADE_DIARIA:
+		return DIAS_UTEIS_MES * 12
+	case models.PERIODICIDADE_TRIMESTRAL:
+		return 4
+	case models.PERIODICIDADE_SEMESTRAL:
+		return 2
+	case models.PERIODICIDADE_ANUAL:
+		return 1
+	}
+
+	return 12
+}
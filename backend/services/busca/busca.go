@@ -0,0 +1,236 @@
+package busca
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"tsukuyomi/models"
+	contatoEmpresa "tsukuyomi/repositories/contato_empresa"
+	"tsukuyomi/repositories/emprego"
+	"tsukuyomi/repositories/empresa"
+	"tsukuyomi/repositories/endereco"
+	"tsukuyomi/services/texto"
+)
+
+const (
+	ERROR_BUSCA_CURTA = "informe ao menos dois caracteres para a busca"
+
+	// PONTUACAO_MINIMA é a relevância mínima para o registro ser retornado.
+	PONTUACAO_MINIMA = 0.5
+	// LIMITE_PADRAO é a quantidade de resultados de cada tipo quando não
+	// informada.
+	LIMITE_PADRAO = 10
+)
+
+type Service interface {
+	Buscar(ctx context.Context, termo string, limite int) (models.Busca, error)
+}
+
+type service struct {
+	EmpresaRepository  empresa.Repository
+	EnderecoRepository endereco.Repository
+	ContatoRepository  contatoEmpresa.Repository
+	EmpregoRepository  emprego.Repository
+}
+
+func NewService(
+	empresaRepository empresa.Repository,
+	enderecoRepository endereco.Repository,
+	contatoRepository contatoEmpresa.Repository,
+	empregoRepository emprego.Repository,
+) Service {
+	return &service{
+		EmpresaRepository:  empresaRepository,
+		EnderecoRepository: enderecoRepository,
+		ContatoRepository:  contatoRepository,
+		EmpregoRepository:  empregoRepository,
+	}
+}
+
+// campo é um campo do registro comparado com a busca. O peso reduz a
+// relevância de campos secundários, como o nome da empresa de um contato.
+// Campos numéricos, como CNPJ e CEP, são comparados só pelos dígitos.
+type campo struct {
+	nome     string
+	valor    string
+	peso     float64
+	numerico bool
+}
+
+// Buscar procura o termo nas empresas, endereços, contatos e empregos não
+// apagados, sem diferenciar maiúsculas e acentos e tolerando erros de
+// digitação. Os registros são pontuados em memória, o que atende ao volume de
+// um cadastro pessoal, e cada tipo retorna até o limite de resultados.
+func (s *service) Buscar(ctx context.Context, termo string, limite int) (models.Busca, error) {
+	termo = strings.TrimSpace(termo)
+	if len([]rune(texto.Normalizar(termo))) < 2 {
+		return models.Busca{}, errors.New(ERROR_BUSCA_CURTA)
+	}
+
+	if limite <= 0 {
+		limite = LIMITE_PADRAO
+	}
+
+	busca := models.Busca{
+		Termo:     termo,
+		Empresas:  []models.ResultadoBusca{},
+		Enderecos: []models.ResultadoBusca{},
+		Contatos:  []models.ResultadoBusca{},
+		Empregos:  []models.ResultadoBusca{},
+	}
+
+	empresas, err := s.EmpresaRepository.FindAll(ctx, "", "", "")
+	if err != nil {
+		return models.Busca{}, err
+	}
+
+	for _, empresa := range empresas {
+		busca.Empresas = adicionar(busca.Empresas, termo, models.ResultadoBusca{
+			Tipo:      models.BUSCA_EMPRESA,
+			ID:        empresa.ID,
+			Titulo:    empresa.Nome,
+			Descricao: empresa.CNPJ,
+		},
+			campo{nome: "nome", valor: empresa.Nome, peso: 1},
+			campo{nome: "cnpj", valor: empresa.CNPJ, peso: 1, numerico: true},
+		)
+	}
+
+	enderecos, err := s.EnderecoRepository.FindAll(ctx, "", "", "", "", "", "", "", "")
+	if err != nil {
+		return models.Busca{}, err
+	}
+
+	for _, endereco := range enderecos {
+		nomes := []string{}
+		for _, empresa := range endereco.Empresas {
+			nomes = append(nomes, empresa.Nome)
+		}
+
+		busca.Enderecos = adicionar(busca.Enderecos, termo, models.ResultadoBusca{
+			Tipo:      models.BUSCA_ENDERECO,
+			ID:        endereco.ID,
+			Titulo:    fmt.Sprintf("%s, %s - %s, %s/%s", endereco.Logradouro, endereco.Numero, endereco.Bairro, endereco.Cidade, endereco.Estado),
+			Descricao: strings.Join(nomes, ", "),
+		},
+			campo{nome: "logradouro", valor: endereco.Logradouro + " " + endereco.Numero, peso: 1},
+			campo{nome: "bairro", valor: endereco.Bairro, peso: 0.9},
+			campo{nome: "cidade", valor: endereco.Cidade, peso: 0.8},
+			campo{nome: "cep", valor: endereco.CEP, peso: 1, numerico: true},
+			campo{nome: "empresa", valor: strings.Join(nomes, " "), peso: 0.7},
+		)
+	}
+
+	contatos, err := s.ContatoRepository.FindAll(ctx, "", "", "", "", "")
+	if err != nil {
+		return models.Busca{}, err
+	}
+
+	for _, contato := range contatos {
+		pessoa := ""
+		if contato.Pessoa != nil {
+			pessoa = contato.Pessoa.Nome
+		}
+
+		descricao := contato.Tipo + " - " + contato.Empresa.Nome
+		if pessoa != "" {
+			descricao = contato.Tipo + " - " + pessoa + " - " + contato.Empresa.Nome
+		}
+
+		busca.Contatos = adicionar(busca.Contatos, termo, models.ResultadoBusca{
+			Tipo:      models.BUSCA_CONTATO,
+			ID:        contato.ID,
+			Titulo:    contato.Contato,
+			Descricao: descricao,
+		},
+			campo{nome: "contato", valor: contato.Contato, peso: 1},
+			campo{nome: "pessoa", valor: pessoa, peso: 1},
+			campo{nome: "empresa", valor: contato.Empresa.Nome, peso: 0.7},
+		)
+	}
+
+	empregos, err := s.EmpregoRepository.FindAll(ctx, "", "", "", "", "", "", "", "")
+	if err != nil {
+		return models.Busca{}, err
+	}
+
+	for _, emprego := range empregos {
+		busca.Empregos = adicionar(busca.Empregos, termo, models.ResultadoBusca{
+			Tipo:      models.BUSCA_EMPREGO,
+			ID:        emprego.ID,
+			Titulo:    emprego.Ocupacao,
+			Descricao: emprego.Empresa.Nome + " - " + emprego.TipoContrato,
+		},
+			campo{nome: "ocupacao", valor: emprego.Ocupacao, peso: 1},
+			campo{nome: "empresa", valor: emprego.Empresa.Nome, peso: 0.8},
+			campo{nome: "tipo_contrato", valor: emprego.TipoContrato, peso: 0.6},
+		)
+	}
+
+	for _, grupo := range []*[]models.ResultadoBusca{&busca.Empresas, &busca.Enderecos, &busca.Contatos, &busca.Empregos} {
+		slices.SortStableFunc(*grupo, func(a, b models.ResultadoBusca) int {
+			return cmp.Compare(b.Pontuacao, a.Pontuacao)
+		})
+
+		if len(*grupo) > limite {
+			*grupo = (*grupo)[:limite]
+		}
+
+		busca.Total += len(*grupo)
+	}
+
+	return busca, nil
+}
+
+// adicionar pontua o registro pelo campo que mais corresponde à busca e o
+// inclui nos resultados se atingir a pontuação mínima.
+func adicionar(resultados []models.ResultadoBusca, termo string, resultado models.ResultadoBusca, campos ...campo) []models.ResultadoBusca {
+	for _, campo := range campos {
+		pontuacao := campo.peso * pontuar(termo, campo)
+
+		if pontuacao > resultado.Pontuacao {
+			resultado.Pontuacao = pontuacao
+			resultado.Campo = campo.nome
+		}
+	}
+
+	if resultado.Pontuacao < PONTUACAO_MINIMA {
+		return resultados
+	}
+
+	resultado.Pontuacao = math.Round(resultado.Pontuacao*1000) / 1000
+
+	return append(resultados, resultado)
+}
+
+func pontuar(termo string, campo campo) float64 {
+	if !campo.numerico {
+		return texto.Similaridade(termo, campo.valor)
+	}
+
+	busca, valor := texto.Digitos(termo), texto.Digitos(campo.valor)
+	if len(busca) < 3 || busca != strings.Map(semFormatacao, termo) {
+		return 0
+	}
+
+	if strings.Contains(valor, busca) {
+		return 1
+	}
+
+	return 0
+}
+
+// semFormatacao remove a pontuação usada em CNPJ e CEP, para identificar
+// buscas só de números.
+func semFormatacao(r rune) rune {
+	if strings.ContainsRune(".-/ ", r) {
+		return -1
+	}
+
+	return r
+}
@@ -0,0 +1,197 @@
+package calendario
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/ausencia"
+	"tsukuyomi/repositories/emprego"
+	entrevistaService "tsukuyomi/services/entrevista"
+	feriadoService "tsukuyomi/services/feriado"
+	"tsukuyomi/services/texto"
+)
+
+type Service interface {
+	Eventos(ctx context.Context, inicio, fim time.Time, uf, cidade string) ([]models.EventoCalendario, error)
+}
+
+type service struct {
+	EntrevistaService  entrevistaService.Service
+	FeriadoService     feriadoService.Service
+	AusenciaRepository ausencia.Repository
+	EmpregoRepository  emprego.Repository
+}
+
+func NewService(entrevistaService entrevistaService.Service, feriadoService feriadoService.Service, ausenciaRepository ausencia.Repository, empregoRepository emprego.Repository) Service {
+	return &service{
+		EntrevistaService:  entrevistaService,
+		FeriadoService:     feriadoService,
+		AusenciaRepository: ausenciaRepository,
+		EmpregoRepository:  empregoRepository,
+	}
+}
+
+// Eventos reúne as entrevistas, os feriados da localidade e as férias de
+// todos os empregos entre as datas informadas, inclusive, ordenados pelo
+// início.
+func (s *service) Eventos(ctx context.Context, inicio, fim time.Time, uf, cidade string) ([]models.EventoCalendario, error) {
+	eventos := []models.EventoCalendario{}
+
+	entrevistas, err := s.EntrevistaService.FindByPeriodo(ctx, inicio, fim.AddDate(0, 0, 1).Add(-time.Second))
+	if err != nil {
+		return []models.EventoCalendario{}, err
+	}
+
+	for _, entrevista := range entrevistas {
+		eventos = append(eventos, eventoEntrevista(entrevista))
+	}
+
+	feriados, err := s.FeriadoService.FindByPeriodo(ctx, inicio, fim, uf, cidade)
+	if err != nil {
+		return []models.EventoCalendario{}, err
+	}
+
+	for _, feriado := range feriados {
+		eventos = append(eventos, eventoFeriado(feriado))
+	}
+
+	ferias, err := s.AusenciaRepository.FindByTipo(ctx, models.AUSENCIA_FERIAS, inicio, fim)
+	if err != nil {
+		return []models.EventoCalendario{}, err
+	}
+
+	empregos := map[int64]models.Emprego{}
+
+	for _, periodo := range ferias {
+		emprego, ok := empregos[periodo.IDEmprego]
+		if !ok {
+			emprego, err = s.EmpregoRepository.FindByID(ctx, fmt.Sprint(periodo.IDEmprego))
+			if err != nil {
+				return []models.EventoCalendario{}, err
+			}
+
+			empregos[periodo.IDEmprego] = emprego
+		}
+
+		eventos = append(eventos, eventoFerias(periodo, emprego))
+	}
+
+	sort.SliceStable(eventos, func(i, j int) bool {
+		return eventos[i].Inicio.Before(eventos[j].Inicio)
+	})
+
+	return eventos, nil
+}
+
+func eventoEntrevista(entrevista models.Entrevista) models.EventoCalendario {
+	evento := models.EventoCalendario{
+		UID:    fmt.Sprintf("entrevista-%d", entrevista.ID),
+		Tipo:   models.EVENTO_ENTREVISTA,
+		Titulo: "Entrevista",
+		Inicio: entrevista.DataHora,
+		Fim:    entrevista.Fim(),
+	}
+
+	if entrevista.Candidatura != nil {
+		evento.Titulo = fmt.Sprintf("Entrevista: %s", entrevista.Candidatura.Cargo)
+
+		if entrevista.Candidatura.Empresa != nil {
+			evento.Titulo += fmt.Sprintf(" - %s", entrevista.Candidatura.Empresa.Nome)
+		}
+	}
+
+	descricao := []string{fmt.Sprintf("Formato: %s", entrevista.Formato)}
+
+	if entrevista.Endereco != nil {
+		evento.Local = formatarEndereco(*entrevista.Endereco)
+	}
+
+	if entrevista.Link != nil {
+		descricao = append(descricao, fmt.Sprintf("Link: %s", *entrevista.Link))
+
+		if evento.Local == "" {
+			evento.Local = *entrevista.Link
+		}
+	}
+
+	if len(entrevista.Entrevistadores) > 0 {
+		contatos := make([]string, 0, len(entrevista.Entrevistadores))
+		for _, contato := range entrevista.Entrevistadores {
+			if contato.Pessoa != nil {
+				contatos = append(contatos, fmt.Sprintf("%s (%s)", contato.Pessoa.Nome, contato.Contato))
+				continue
+			}
+
+			contatos = append(contatos, contato.Contato)
+		}
+
+		descricao = append(descricao, fmt.Sprintf("Entrevistadores: %s", strings.Join(contatos, ", ")))
+	}
+
+	if entrevista.Resultado != nil {
+		descricao = append(descricao, fmt.Sprintf("Resultado: %s", *entrevista.Resultado))
+	}
+
+	if entrevista.Anotacoes != nil {
+		descricao = append(descricao, *entrevista.Anotacoes)
+	}
+
+	evento.Descricao = strings.Join(descricao, "\n")
+
+	return evento
+}
+
+// eventoFeriado identifica o feriado pela data e pelo nome, já que os
+// feriados nacionais não são cadastrados.
+func eventoFeriado(feriado models.Feriado) models.EventoCalendario {
+	evento := models.EventoCalendario{
+		UID:        fmt.Sprintf("feriado-%s-%s", feriado.Data.Format("20060102"), strings.ReplaceAll(texto.Normalizar(feriado.Nome), " ", "-")),
+		Tipo:       models.EVENTO_FERIADO,
+		Titulo:     feriado.Nome,
+		Descricao:  fmt.Sprintf("Feriado %s", feriado.Abrangencia),
+		Inicio:     feriado.Data,
+		Fim:        feriado.Data,
+		DiaInteiro: true,
+	}
+
+	if feriado.Facultativo {
+		evento.Titulo += " (ponto facultativo)"
+	}
+
+	return evento
+}
+
+func eventoFerias(ferias models.Ausencia, emprego models.Emprego) models.EventoCalendario {
+	evento := models.EventoCalendario{
+		UID:        fmt.Sprintf("ferias-%d", ferias.ID),
+		Tipo:       models.EVENTO_FERIAS,
+		Titulo:     "Férias",
+		Inicio:     ferias.DataInicio,
+		Fim:        ferias.DataFim,
+		DiaInteiro: true,
+	}
+
+	if emprego.Empresa.Nome != "" {
+		evento.Titulo += fmt.Sprintf(" - %s", emprego.Empresa.Nome)
+	}
+
+	if ferias.Observacao != nil {
+		evento.Descricao = *ferias.Observacao
+	}
+
+	return evento
+}
+
+func formatarEndereco(endereco models.Endereco) string {
+	local := fmt.Sprintf("%s, %s", endereco.Logradouro, endereco.Numero)
+
+	if endereco.Complemento != nil {
+		local += fmt.Sprintf(", %s", *endereco.Complemento)
+	}
+
+	return fmt.Sprintf("%s - %s, %s - %s, %s", local, endereco.Bairro, endereco.Cidade, endereco.Estado, endereco.CEP)
+}
@@ -0,0 +1,99 @@
+package calendario
+
+import (
+	"bytes"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"tsukuyomi/models"
+)
+
+// LIMITE_LINHA é o tamanho máximo, em bytes, de uma linha do iCalendar antes
+// da quebra (RFC 5545, seção 3.1).
+const LIMITE_LINHA = 75
+
+const DOMINIO_UID = "tsukuyomi"
+
+var escapeTexto = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// ICS gera o calendário no formato iCalendar (RFC 5545). Os eventos com
+// horário são gravados em UTC e os de dia inteiro como datas, com o fim no
+// dia seguinte ao último, que é exclusivo no formato.
+func ICS(nome string, eventos []models.EventoCalendario, geradoEm time.Time) []byte {
+	var saida bytes.Buffer
+
+	linha := func(conteudo string) {
+		dobrarLinha(&saida, conteudo)
+	}
+
+	linha("BEGIN:VCALENDAR")
+	linha("VERSION:2.0")
+	linha("PRODID:-//Tsukuyomi//Calendario//PT-BR")
+	linha("CALSCALE:GREGORIAN")
+	linha("METHOD:PUBLISH")
+	linha("X-WR-CALNAME:" + escapeTexto.Replace(nome))
+
+	carimbo := geradoEm.UTC().Format("20060102T150405Z")
+
+	for _, evento := range eventos {
+		linha("BEGIN:VEVENT")
+		linha("UID:" + evento.UID + "@" + DOMINIO_UID)
+		linha("DTSTAMP:" + carimbo)
+
+		if evento.DiaInteiro {
+			linha("DTSTART;VALUE=DATE:" + evento.Inicio.Format("20060102"))
+			linha("DTEND;VALUE=DATE:" + evento.Fim.AddDate(0, 0, 1).Format("20060102"))
+			linha("TRANSP:TRANSPARENT")
+		} else {
+			linha("DTSTART:" + evento.Inicio.UTC().Format("20060102T150405Z"))
+			linha("DTEND:" + evento.Fim.UTC().Format("20060102T150405Z"))
+		}
+
+		linha("SUMMARY:" + escapeTexto.Replace(evento.Titulo))
+
+		if evento.Descricao != "" {
+			linha("DESCRIPTION:" + escapeTexto.Replace(evento.Descricao))
+		}
+
+		if evento.Local != "" {
+			linha("LOCATION:" + escapeTexto.Replace(evento.Local))
+		}
+
+		linha("CATEGORIES:" + evento.Tipo)
+		linha("END:VEVENT")
+	}
+
+	linha("END:VCALENDAR")
+
+	return saida.Bytes()
+}
+
+// dobrarLinha grava a linha terminada em CRLF, quebrando-a a cada
+// LIMITE_LINHA bytes sem dividir caracteres UTF-8. As linhas de continuação
+// começam com um espaço, que conta no limite.
+func dobrarLinha(saida *bytes.Buffer, conteudo string) {
+	limite := LIMITE_LINHA
+
+	for len(conteudo) > limite {
+		corte := limite
+		for corte > 0 && !utf8.RuneStart(conteudo[corte]) {
+			corte--
+		}
+
+		saida.WriteString(conteudo[:corte])
+		saida.WriteString("\r\n ")
+
+		conteudo = conteudo[corte:]
+		limite = LIMITE_LINHA - 1
+	}
+
+	saida.WriteString(conteudo)
+	saida.WriteString("\r\n")
+}
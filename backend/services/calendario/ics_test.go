@@ -0,0 +1,122 @@
+package calendario
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"tsukuyomi/models"
+)
+
+func TestDobrarLinha(t *testing.T) {
+	a := func(n int) string {
+		return strings.Repeat("a", n)
+	}
+
+	casos := []struct {
+		nome     string
+		conteudo string
+		esperado string
+	}{
+		{"linha curta", "VERSION:2.0", "VERSION:2.0\r\n"},
+		{"no limite", a(75), a(75) + "\r\n"},
+		{"um byte acima do limite", a(76), a(75) + "\r\n a\r\n"},
+		{"continuações contam o espaço", a(150), a(75) + "\r\n " + a(74) + "\r\n a\r\n"},
+		{"não divide caracteres UTF-8", a(74) + "ção", a(74) + "\r\n ção\r\n"},
+		{"caractere que termina no limite", a(73) + "çã", a(73) + "ç\r\n ã\r\n"},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			var saida bytes.Buffer
+			dobrarLinha(&saida, caso.conteudo)
+
+			if obtido := saida.String(); obtido != caso.esperado {
+				t.Errorf("dobrarLinha(%q) = %q, esperado %q", caso.conteudo, obtido, caso.esperado)
+			}
+		})
+	}
+}
+
+func TestICS(t *testing.T) {
+	sp, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skipf("fuso America/Sao_Paulo indisponível: %v", err)
+	}
+
+	casos := []struct {
+		nome     string
+		evento   models.EventoCalendario
+		esperado []string
+	}{
+		{
+			nome: "evento de dia inteiro",
+			evento: models.EventoCalendario{
+				UID:        "ferias-1",
+				Tipo:       "ferias",
+				Titulo:     "Férias",
+				Inicio:     time.Date(2026, time.July, 1, 0, 0, 0, 0, sp),
+				Fim:        time.Date(2026, time.July, 30, 0, 0, 0, 0, sp),
+				DiaInteiro: true,
+			},
+			esperado: []string{
+				"BEGIN:VEVENT",
+				"UID:ferias-1@tsukuyomi",
+				"DTSTAMP:20260315T120000Z",
+				"DTSTART;VALUE=DATE:20260701",
+				"DTEND;VALUE=DATE:20260731",
+				"TRANSP:TRANSPARENT",
+				"SUMMARY:Férias",
+				"CATEGORIES:ferias",
+				"END:VEVENT",
+			},
+		},
+		{
+			nome: "evento com horário e texto escapado",
+			evento: models.EventoCalendario{
+				UID:       "entrevista-2",
+				Tipo:      "entrevista",
+				Titulo:    "Entrevista; ACME, Inc.",
+				Descricao: "Levar documentos\nC:\\curriculo.pdf",
+				Local:     "Av. Paulista, 1000",
+				Inicio:    time.Date(2026, time.March, 20, 14, 0, 0, 0, sp),
+				Fim:       time.Date(2026, time.March, 20, 15, 30, 0, 0, sp),
+			},
+			esperado: []string{
+				"BEGIN:VEVENT",
+				"UID:entrevista-2@tsukuyomi",
+				"DTSTAMP:20260315T120000Z",
+				"DTSTART:20260320T170000Z",
+				"DTEND:20260320T183000Z",
+				`SUMMARY:Entrevista\; ACME\, Inc.`,
+				`DESCRIPTION:Levar documentos\nC:\\curriculo.pdf`,
+				`LOCATION:Av. Paulista\, 1000`,
+				"CATEGORIES:entrevista",
+				"END:VEVENT",
+			},
+		},
+	}
+
+	cabecalho := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Tsukuyomi//Calendario//PT-BR",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		`X-WR-CALNAME:Agenda\, 2026`,
+	}
+
+	geradoEm := time.Date(2026, time.March, 15, 9, 0, 0, 0, sp)
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			linhas := append(append(append([]string{}, cabecalho...), caso.esperado...), "END:VCALENDAR")
+			esperado := strings.Join(linhas, "\r\n") + "\r\n"
+
+			if obtido := string(ICS("Agenda, 2026", []models.EventoCalendario{caso.evento}, geradoEm)); obtido != esperado {
+				t.Errorf("ICS = %q, esperado %q", obtido, esperado)
+			}
+		})
+	}
+}
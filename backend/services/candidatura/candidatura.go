@@ -0,0 +1,224 @@
+package candidatura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/candidatura"
+	"tsukuyomi/repositories/empresa"
+)
+
+const (
+	ERROR_EMPRESA_NOT_FOUND     = "empresa não encontrada"
+	ERROR_CANDIDATURA_NOT_FOUND = "candidatura não encontrada"
+	ERROR_TRANSICAO_INVALIDA    = "a candidatura não pode passar de %s para %s"
+	ERROR_TRANSICAO_CONCORRENTE = "a situação da candidatura mudou durante a transição, tente novamente"
+	ERROR_NAO_ACEITA            = "apenas candidaturas aceitas podem ser convertidas em emprego"
+	ERROR_JA_CONVERTIDA         = "a candidatura já foi convertida em emprego"
+	ERROR_SEM_REMUNERACAO       = "informe a remuneração inicial ou o salário oferecido na candidatura"
+	ERROR_SEM_TIPO_CONTRATO     = "informe o tipo de contratação ou registre-o na candidatura"
+)
+
+type Service interface {
+	Create(ctx context.Context, candidatura models.Candidatura) (models.Candidatura, error)
+	FindAll(ctx context.Context, search, empresa, situacao string) ([]models.Candidatura, error)
+	FindByID(ctx context.Context, id string) (models.Candidatura, error)
+	Update(ctx context.Context, candidatura models.Candidatura) error
+	Delete(ctx context.Context, id string) error
+	Transitar(ctx context.Context, id string, transicao models.TransicaoCandidatura) (models.Candidatura, error)
+	Converter(ctx context.Context, id string, conversao models.ConversaoCandidatura) (models.Emprego, error)
+}
+
+type service struct {
+	repository        candidatura.Repository
+	EmpresaRepository empresa.Repository
+}
+
+func NewService(repository candidatura.Repository, empresaRepository empresa.Repository) Service {
+	return &service{
+		repository:        repository,
+		EmpresaRepository: empresaRepository,
+	}
+}
+
+// Create registra a candidatura na situação aplicado, com a transição
+// inicial no histórico.
+func (s *service) Create(ctx context.Context, candidatura models.Candidatura) (models.Candidatura, error) {
+	if err := s.validarEmpresa(ctx, candidatura.IDEmpresa); err != nil {
+		return models.Candidatura{}, err
+	}
+
+	if candidatura.Moeda == "" {
+		candidatura.Moeda = models.MOEDA_BRL
+	}
+
+	candidatura.Situacao = models.CANDIDATURA_APLICADO
+	candidatura.Historico = []models.TransicaoCandidatura{{
+		Situacao: models.CANDIDATURA_APLICADO,
+		Data:     candidatura.Criado,
+	}}
+
+	return s.repository.Create(ctx, candidatura)
+}
+
+func (s *service) FindAll(ctx context.Context, search, empresa, situacao string) ([]models.Candidatura, error) {
+	return s.repository.FindAll(ctx, search, empresa, situacao)
+}
+
+// FindByID retorna a candidatura com o histórico de transições.
+func (s *service) FindByID(ctx context.Context, id string) (models.Candidatura, error) {
+	candidatura, err := s.repository.FindByID(ctx, id)
+	if err != nil || candidatura.ID == 0 {
+		return candidatura, err
+	}
+
+	candidatura.Historico, err = s.repository.FindHistorico(ctx, id)
+	if err != nil {
+		return models.Candidatura{}, err
+	}
+
+	return candidatura, nil
+}
+
+func (s *service) Update(ctx context.Context, candidatura models.Candidatura) error {
+	if err := s.validarEmpresa(ctx, candidatura.IDEmpresa); err != nil {
+		return err
+	}
+
+	return s.repository.Update(ctx, candidatura)
+}
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	return s.repository.Delete(ctx, id)
+}
+
+// Transitar muda a situação da candidatura, registrando a transição no
+// histórico, e retorna a candidatura atualizada.
+func (s *service) Transitar(ctx context.Context, id string, transicao models.TransicaoCandidatura) (models.Candidatura, error) {
+	candidatura, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.Candidatura{}, err
+	}
+
+	if candidatura.ID == 0 {
+		return models.Candidatura{}, errors.New(ERROR_CANDIDATURA_NOT_FOUND)
+	}
+
+	if !PodeTransitar(candidatura.Situacao, transicao.Situacao) {
+		return models.Candidatura{}, fmt.Errorf(ERROR_TRANSICAO_INVALIDA, candidatura.Situacao, transicao.Situacao)
+	}
+
+	anterior := candidatura.Situacao
+
+	transicao.IDCandidatura = candidatura.ID
+	transicao.SituacaoAnterior = &anterior
+
+	transitada, err := s.repository.Transitar(ctx, transicao)
+	if err != nil {
+		return models.Candidatura{}, err
+	}
+
+	if !transitada {
+		return models.Candidatura{}, errors.New(ERROR_TRANSICAO_CONCORRENTE)
+	}
+
+	return s.FindByID(ctx, id)
+}
+
+// PodeTransitar informa se a candidatura pode passar de uma situação para a
+// outra. O processo só avança, podendo pular etapas; a candidatura pode ser
+// recusada em qualquer etapa, mas só é aceita após a proposta. Aceito e
+// recusado são situações finais.
+func PodeTransitar(de, para string) bool {
+	switch {
+	case de == models.CANDIDATURA_ACEITO || de == models.CANDIDATURA_RECUSADO:
+		return false
+	case para == models.CANDIDATURA_RECUSADO:
+		return true
+	case para == models.CANDIDATURA_ACEITO:
+		return de == models.CANDIDATURA_PROPOSTA
+	}
+
+	return slices.Index(models.SituacoesCandidatura, para) > slices.Index(models.SituacoesCandidatura, de)
+}
+
+// Converter cria o emprego da candidatura aceita, na empresa e no cargo da
+// candidatura, e o vincula a ela.
+func (s *service) Converter(ctx context.Context, id string, conversao models.ConversaoCandidatura) (models.Emprego, error) {
+	candidatura, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.Emprego{}, err
+	}
+
+	if candidatura.ID == 0 {
+		return models.Emprego{}, errors.New(ERROR_CANDIDATURA_NOT_FOUND)
+	}
+
+	if candidatura.Situacao != models.CANDIDATURA_ACEITO {
+		return models.Emprego{}, errors.New(ERROR_NAO_ACEITA)
+	}
+
+	if candidatura.IDEmprego != nil {
+		return models.Emprego{}, errors.New(ERROR_JA_CONVERTIDA)
+	}
+
+	emprego := models.Emprego{
+		IDEmpresa:    candidatura.IDEmpresa,
+		Empresa:      *candidatura.Empresa,
+		Ocupacao:     candidatura.Cargo,
+		Moeda:        candidatura.Moeda,
+		TipoContrato: conversao.TipoContrato,
+		DataInicio:   conversao.DataInicio,
+		CargaHoraria: conversao.CargaHoraria,
+		Criado:       time.Now(),
+	}
+
+	switch {
+	case conversao.RemuneracaoInicial != nil:
+		emprego.RemuneracaoInicial = *conversao.RemuneracaoInicial
+	case candidatura.SalarioOferecido != nil:
+		emprego.RemuneracaoInicial = *candidatura.SalarioOferecido
+	default:
+		return models.Emprego{}, errors.New(ERROR_SEM_REMUNERACAO)
+	}
+
+	if emprego.TipoContrato == "" {
+		if candidatura.TipoContrato == nil {
+			return models.Emprego{}, errors.New(ERROR_SEM_TIPO_CONTRATO)
+		}
+
+		emprego.TipoContrato = *candidatura.TipoContrato
+	}
+
+	if err := emprego.Validate(); err != nil {
+		return models.Emprego{}, err
+	}
+
+	emprego, err = s.repository.Converter(ctx, candidatura.ID, emprego)
+	if err != nil {
+		return models.Emprego{}, err
+	}
+
+	if emprego.ID == 0 {
+		return models.Emprego{}, errors.New(ERROR_JA_CONVERTIDA)
+	}
+
+	return emprego, nil
+}
+
+func (s *service) validarEmpresa(ctx context.Context, id_empresa int64) error {
+	empresa, err := s.EmpresaRepository.FindByID(ctx, fmt.Sprint(id_empresa))
+	if err != nil {
+		return err
+	}
+
+	if empresa.ID == 0 {
+		return errors.New(ERROR_EMPRESA_NOT_FOUND)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package candidatura
+
+import (
+	"testing"
+
+	"tsukuyomi/models"
+)
+
+func TestPodeTransitar(t *testing.T) {
+	casos := []struct {
+		de, para string
+		pode     bool
+	}{
+		{models.CANDIDATURA_APLICADO, models.CANDIDATURA_TRIAGEM, true},
+		{models.CANDIDATURA_APLICADO, models.CANDIDATURA_PROPOSTA, true},
+		{models.CANDIDATURA_TRIAGEM, models.CANDIDATURA_ENTREVISTA, true},
+		{models.CANDIDATURA_ENTREVISTA, models.CANDIDATURA_TRIAGEM, false},
+		{models.CANDIDATURA_TRIAGEM, models.CANDIDATURA_TRIAGEM, false},
+		{models.CANDIDATURA_PROPOSTA, models.CANDIDATURA_ACEITO, true},
+		{models.CANDIDATURA_ENTREVISTA, models.CANDIDATURA_ACEITO, false},
+		{models.CANDIDATURA_APLICADO, models.CANDIDATURA_ACEITO, false},
+		{models.CANDIDATURA_APLICADO, models.CANDIDATURA_RECUSADO, true},
+		{models.CANDIDATURA_PROPOSTA, models.CANDIDATURA_RECUSADO, true},
+		{models.CANDIDATURA_ACEITO, models.CANDIDATURA_RECUSADO, false},
+		{models.CANDIDATURA_RECUSADO, models.CANDIDATURA_RECUSADO, false},
+		{models.CANDIDATURA_RECUSADO, models.CANDIDATURA_TRIAGEM, false},
+		{models.CANDIDATURA_APLICADO, "contratado", false},
+	}
+
+	for _, caso := range casos {
+		if obtido := PodeTransitar(caso.de, caso.para); obtido != caso.pode {
+			t.Errorf("PodeTransitar(%s, %s) = %t, esperado %t", caso.de, caso.para, obtido, caso.pode)
+		}
+	}
+}
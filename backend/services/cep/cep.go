@@ -0,0 +1,135 @@
+package cep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/cep"
+	"tsukuyomi/services/texto"
+)
+
+const (
+	ERROR_CEP_INVALIDO       = "CEP inválido, informe os oito dígitos"
+	ERROR_CEP_NAO_ENCONTRADO = "CEP não encontrado na base de CEPs"
+
+	AVISO_CEP_NAO_ENCONTRADO = "O CEP não foi encontrado na base de CEPs; o endereço não foi conferido."
+	AVISO_DIVERGENCIA        = "O campo %s informado (%s) difere do CEP (%s)."
+)
+
+// Estados são os nomes dos estados pela sigla, para conferir estados
+// informados por extenso.
+var Estados = map[string]string{
+	"AC": "Acre", "AL": "Alagoas", "AP": "Amapá", "AM": "Amazonas", "BA": "Bahia",
+	"CE": "Ceará", "DF": "Distrito Federal", "ES": "Espírito Santo", "GO": "Goiás",
+	"MA": "Maranhão", "MT": "Mato Grosso", "MS": "Mato Grosso do Sul", "MG": "Minas Gerais",
+	"PA": "Pará", "PB": "Paraíba", "PR": "Paraná", "PE": "Pernambuco", "PI": "Piauí",
+	"RJ": "Rio de Janeiro", "RN": "Rio Grande do Norte", "RS": "Rio Grande do Sul",
+	"RO": "Rondônia", "RR": "Roraima", "SC": "Santa Catarina", "SP": "São Paulo",
+	"SE": "Sergipe", "TO": "Tocantins",
+}
+
+type Service interface {
+	FindByCEP(ctx context.Context, cep string) (models.CEP, error)
+	Preencher(ctx context.Context, endereco models.Endereco) (models.Endereco, error)
+	Importar(ctx context.Context, conteudo io.Reader) (models.ImportacaoCEP, error)
+}
+
+type service struct {
+	repository cep.Repository
+}
+
+func NewService(repository cep.Repository) Service {
+	return &service{
+		repository: repository,
+	}
+}
+
+// FindByCEP busca o CEP, com ou sem hífen, na base de CEPs.
+func (s *service) FindByCEP(ctx context.Context, valor string) (models.CEP, error) {
+	numero := texto.Digitos(valor)
+	if len(numero) != 8 {
+		return models.CEP{}, errors.New(ERROR_CEP_INVALIDO)
+	}
+
+	resultado, err := s.repository.FindByCEP(ctx, numero)
+	if err != nil {
+		return models.CEP{}, err
+	}
+
+	if resultado.CEP == "" {
+		return models.CEP{}, errors.New(ERROR_CEP_NAO_ENCONTRADO)
+	}
+
+	resultado.CEP = Formatar(resultado.CEP)
+
+	return resultado, nil
+}
+
+// Preencher completa os campos vazios do endereço com os dados do CEP e
+// registra nos avisos os campos informados que divergem dele. Um CEP fora da
+// base também é apenas um aviso, já que a base pode estar desatualizada.
+func (s *service) Preencher(ctx context.Context, endereco models.Endereco) (models.Endereco, error) {
+	resultado, err := s.FindByCEP(ctx, endereco.CEP)
+	if err != nil && err.Error() == ERROR_CEP_NAO_ENCONTRADO {
+		endereco.Avisos = append(endereco.Avisos, AVISO_CEP_NAO_ENCONTRADO)
+		return endereco, nil
+	}
+
+	if err != nil {
+		return models.Endereco{}, err
+	}
+
+	endereco.CEP = resultado.CEP
+
+	campos := []struct {
+		nome      string
+		informado *string
+		cep       string
+	}{
+		{"logradouro", &endereco.Logradouro, resultado.Logradouro},
+		{"bairro", &endereco.Bairro, resultado.Bairro},
+		{"cidade", &endereco.Cidade, resultado.Cidade},
+		{"estado", &endereco.Estado, resultado.Estado},
+	}
+
+	for _, campo := range campos {
+		informado := strings.TrimSpace(*campo.informado)
+
+		switch {
+		case campo.cep == "":
+			continue
+		case informado == "":
+			*campo.informado = campo.cep
+		case !equivalentes(campo.nome, informado, campo.cep):
+			endereco.Avisos = append(endereco.Avisos, fmt.Sprintf(AVISO_DIVERGENCIA, campo.nome, informado, campo.cep))
+		}
+	}
+
+	return endereco, nil
+}
+
+// equivalentes compara os textos sem diferenciar maiúsculas, acentos e
+// pontuação. O estado pode ser informado pela sigla ou por extenso.
+func equivalentes(campo, informado, cep string) bool {
+	informado = texto.Normalizar(informado)
+
+	if informado == texto.Normalizar(cep) {
+		return true
+	}
+
+	return campo == "estado" && informado == texto.Normalizar(Estados[strings.ToUpper(cep)])
+}
+
+// Formatar formata o CEP no padrão 00000-000.
+func Formatar(cep string) string {
+	d := texto.Digitos(cep)
+	if len(d) != 8 {
+		return cep
+	}
+
+	return d[:5] + "-" + d[5:]
+}
@@ -0,0 +1,169 @@
+package cep
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/lote"
+	"tsukuyomi/services/texto"
+)
+
+const (
+	ERROR_CABECALHO = "o arquivo deve ter cabeçalho com as colunas cep, cidade e estado"
+)
+
+// colunas associa os nomes aceitos no cabeçalho, normalizados, ao campo do
+// CEP. Os nomes seguem as bases mais comuns, como a do ViaCEP.
+var colunas = map[string]string{
+	"cep":         "cep",
+	"logradouro":  "logradouro",
+	"endereco":    "logradouro",
+	"rua":         "logradouro",
+	"complemento": "complemento",
+	"bairro":      "bairro",
+	"cidade":      "cidade",
+	"localidade":  "cidade",
+	"municipio":   "cidade",
+	"estado":      "estado",
+	"uf":          "estado",
+}
+
+// Importar carrega a base de CEPs de um arquivo CSV com cabeçalho, separado
+// por ponto e vírgula ou vírgula, em UTF-8 ou ISO-8859-1. As colunas cep,
+// cidade e estado são obrigatórias; colunas desconhecidas são ignoradas. O
+// arquivo é lido linha a linha e gravado em lotes, e os CEPs já importados são
+// atualizados.
+func (s *service) Importar(ctx context.Context, conteudo io.Reader) (models.ImportacaoCEP, error) {
+	importacao := models.ImportacaoCEP{
+		Erros: []string{},
+	}
+
+	leitorLinhas := bufio.NewReader(texto.NovoLeitorUTF8(conteudo))
+
+	cabecalho, err := leitorLinhas.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return importacao, err
+	}
+
+	separador := ';'
+	if !strings.ContainsRune(cabecalho, ';') && strings.ContainsRune(cabecalho, ',') {
+		separador = ','
+	}
+
+	leitor := csv.NewReader(io.MultiReader(strings.NewReader(cabecalho), leitorLinhas))
+	leitor.Comma = separador
+	leitor.FieldsPerRecord = -1
+	leitor.LazyQuotes = true
+
+	nomes, err := leitor.Read()
+	if err != nil && err != io.EOF {
+		return importacao, err
+	}
+
+	indices := map[string]int{}
+
+	for i, nome := range nomes {
+		if campo, ok := colunas[texto.Normalizar(nome)]; ok {
+			if _, repetido := indices[campo]; !repetido {
+				indices[campo] = i
+			}
+		}
+	}
+
+	for _, obrigatorio := range []string{"cep", "cidade", "estado"} {
+		if _, ok := indices[obrigatorio]; !ok {
+			return importacao, errors.New(ERROR_CABECALHO)
+		}
+	}
+
+	campo := func(registro []string, nome string) string {
+		i, ok := indices[nome]
+		if !ok || i >= len(registro) {
+			return ""
+		}
+
+		return strings.TrimSpace(registro[i])
+	}
+
+	var ceps []models.CEP
+
+	numero := 1
+
+	for {
+		registro, err := leitor.Read()
+		if err == io.EOF {
+			break
+		}
+
+		numero++
+
+		if err != nil {
+			importacao.Linhas++
+			erroImportacao(&importacao, fmt.Sprintf("linha %d: %s", numero, err.Error()))
+			continue
+		}
+
+		if len(registro) == 1 && strings.TrimSpace(registro[0]) == "" {
+			continue
+		}
+
+		importacao.Linhas++
+
+		cep := models.CEP{
+			CEP:        texto.Digitos(campo(registro, "cep")),
+			Logradouro: campo(registro, "logradouro"),
+			Bairro:     campo(registro, "bairro"),
+			Cidade:     campo(registro, "cidade"),
+			Estado:     strings.ToUpper(campo(registro, "estado")),
+		}
+
+		if complemento := campo(registro, "complemento"); complemento != "" {
+			cep.Complemento = &complemento
+		}
+
+		if len(cep.CEP) != 8 {
+			erroImportacao(&importacao, fmt.Sprintf("linha %d: CEP inválido: %q", numero, campo(registro, "cep")))
+			continue
+		}
+
+		if cep.Cidade == "" {
+			erroImportacao(&importacao, fmt.Sprintf("linha %d: cidade não informada", numero))
+			continue
+		}
+
+		if _, ok := Estados[cep.Estado]; !ok {
+			erroImportacao(&importacao, fmt.Sprintf("linha %d: estado inválido, informe a sigla: %q", numero, cep.Estado))
+			continue
+		}
+
+		ceps = append(ceps, cep)
+
+		if len(ceps) >= lote.TAMANHO {
+			if err := s.repository.Importar(ctx, ceps); err != nil {
+				return importacao, err
+			}
+
+			importacao.Importados += len(ceps)
+			ceps = ceps[:0]
+		}
+	}
+
+	if err := s.repository.Importar(ctx, ceps); err != nil {
+		return importacao, err
+	}
+
+	importacao.Importados += len(ceps)
+
+	return importacao, nil
+}
+
+func erroImportacao(importacao *models.ImportacaoCEP, erro string) {
+	importacao.Ignorados++
+	importacao.Erros = lote.Erro(importacao.Erros, erro)
+}
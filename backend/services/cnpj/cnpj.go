@@ -0,0 +1,201 @@
+package cnpj
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/cnpj"
+	"tsukuyomi/repositories/empresa"
+	"tsukuyomi/repositories/endereco"
+	enderecoService "tsukuyomi/services/endereco"
+	"tsukuyomi/services/texto"
+)
+
+const (
+	ERROR_CNPJ_INVALIDO       = "CNPJ inválido"
+	ERROR_CNPJ_NAO_ENCONTRADO = "CNPJ não encontrado nos dados importados da Receita Federal"
+	ERROR_EMPRESA_CADASTRADA  = "já existe uma empresa cadastrada com o CNPJ informado"
+)
+
+type Service interface {
+	Consultar(ctx context.Context, cnpj string) (models.ConsultaCNPJ, error)
+	Cadastrar(ctx context.Context, cnpj string) (models.EnderecoEmpresa, error)
+	Importar(ctx context.Context, diretorio string) (models.ImportacaoCNPJ, error)
+}
+
+type service struct {
+	repository         cnpj.Repository
+	EmpresaRepository  empresa.Repository
+	EnderecoRepository endereco.Repository
+}
+
+func NewService(repository cnpj.Repository, empresaRepository empresa.Repository, enderecoRepository endereco.Repository) Service {
+	return &service{
+		repository:         repository,
+		EmpresaRepository:  empresaRepository,
+		EnderecoRepository: enderecoRepository,
+	}
+}
+
+// Consultar busca o CNPJ, com ou sem formatação, nos dados abertos
+// importados e informa se a empresa já está cadastrada.
+func (s *service) Consultar(ctx context.Context, valor string) (models.ConsultaCNPJ, error) {
+	numero := texto.Digitos(valor)
+	if !Valido(numero) {
+		return models.ConsultaCNPJ{}, errors.New(ERROR_CNPJ_INVALIDO)
+	}
+
+	estabelecimento, empresa, err := s.repository.FindByCNPJ(ctx, numero)
+	if err != nil {
+		return models.ConsultaCNPJ{}, err
+	}
+
+	if estabelecimento.CNPJ == "" {
+		return models.ConsultaCNPJ{}, errors.New(ERROR_CNPJ_NAO_ENCONTRADO)
+	}
+
+	consulta := models.ConsultaCNPJ{
+		CNPJ:                Formatar(numero),
+		RazaoSocial:         empresa.RazaoSocial,
+		NomeFantasia:        estabelecimento.NomeFantasia,
+		Matriz:              estabelecimento.Matriz,
+		SituacaoCadastral:   estabelecimento.SituacaoCadastral,
+		DataInicioAtividade: estabelecimento.DataInicioAtividade,
+		CNAEPrincipal:       estabelecimento.CNAEPrincipal,
+		Telefone:            estabelecimento.Telefone,
+		Email:               estabelecimento.Email,
+		Endereco:            enderecoEstabelecimento(estabelecimento),
+	}
+
+	if situacao, ok := models.SituacoesCadastraisCNPJ[estabelecimento.SituacaoCadastral]; ok {
+		consulta.SituacaoCadastral = situacao
+	}
+
+	cadastrada, err := s.repository.FindEmpresaCadastrada(ctx, numero)
+	if err != nil {
+		return models.ConsultaCNPJ{}, err
+	}
+
+	if cadastrada.ID != 0 {
+		consulta.Empresa = &cadastrada
+	}
+
+	return consulta, nil
+}
+
+// Cadastrar cadastra a empresa do CNPJ com o endereço do estabelecimento. O
+// nome da empresa é a razão social; se já houver outra empresa com ela, como
+// a matriz de uma filial, o CNPJ é acrescentado ao nome. O endereço é
+// normalizado e, se já estiver cadastrado, reaproveitado.
+func (s *service) Cadastrar(ctx context.Context, valor string) (models.EnderecoEmpresa, error) {
+	consulta, err := s.Consultar(ctx, valor)
+	if err != nil {
+		return models.EnderecoEmpresa{}, err
+	}
+
+	if consulta.Empresa != nil {
+		return models.EnderecoEmpresa{}, errors.New(ERROR_EMPRESA_CADASTRADA)
+	}
+
+	agora := time.Now()
+
+	empresa := models.Empresa{
+		Nome:   consulta.RazaoSocial,
+		CNPJ:   consulta.CNPJ,
+		Criado: agora,
+	}
+
+	homonimas, err := s.EmpresaRepository.FindAll(ctx, "", empresa.Nome, "")
+	if err != nil {
+		return models.EnderecoEmpresa{}, err
+	}
+
+	if len(homonimas) > 0 {
+		empresa.Nome = fmt.Sprintf("%s (%s)", empresa.Nome, empresa.CNPJ)
+	}
+
+	endereco := enderecoService.Normalizar(consulta.Endereco)
+	endereco.Criado = agora
+
+	existente, err := s.EnderecoRepository.FindByChave(ctx, endereco.Chave)
+	if err != nil {
+		return models.EnderecoEmpresa{}, err
+	}
+
+	if existente.ID != 0 {
+		endereco = existente
+	}
+
+	return s.repository.Cadastrar(ctx, empresa, endereco)
+}
+
+// enderecoEstabelecimento converte o endereço do estabelecimento para o
+// formato do cadastro, com o tipo de logradouro junto ao nome e o CEP
+// formatado.
+func enderecoEstabelecimento(estabelecimento models.CNPJEstabelecimento) models.Endereco {
+	endereco := models.Endereco{
+		Logradouro:  strings.TrimSpace(estabelecimento.TipoLogradouro + " " + estabelecimento.Logradouro),
+		Numero:      estabelecimento.Numero,
+		Complemento: estabelecimento.Complemento,
+		Bairro:      estabelecimento.Bairro,
+		Cidade:      estabelecimento.Municipio,
+		CEP:         estabelecimento.CEP,
+		Estado:      estabelecimento.UF,
+	}
+
+	if endereco.Numero == "" {
+		endereco.Numero = "S/N"
+	}
+
+	if len(endereco.CEP) == 8 {
+		endereco.CEP = endereco.CEP[:5] + "-" + endereco.CEP[5:]
+	}
+
+	return endereco
+}
+
+// Formatar formata o CNPJ no padrão 00.000.000/0000-00.
+func Formatar(cnpj string) string {
+	d := texto.Digitos(cnpj)
+	if len(d) != 14 {
+		return cnpj
+	}
+
+	return fmt.Sprintf("%s.%s.%s/%s-%s", d[0:2], d[2:5], d[5:8], d[8:12], d[12:14])
+}
+
+// Valido confere os dois dígitos verificadores do CNPJ sem formatação.
+func Valido(cnpj string) bool {
+	if len(cnpj) != 14 || strings.Count(cnpj, cnpj[:1]) == 14 {
+		return false
+	}
+
+	for _, tamanho := range []int{12, 13} {
+		soma := 0
+		peso := tamanho - 7
+
+		for i := 0; i < tamanho; i++ {
+			soma += int(cnpj[i]-'0') * peso
+
+			peso--
+			if peso < 2 {
+				peso = 9
+			}
+		}
+
+		digito := 11 - soma%11
+		if digito >= 10 {
+			digito = 0
+		}
+
+		if int(cnpj[tamanho]-'0') != digito {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,44 @@
+package cnpj
+
+import "testing"
+
+func TestValido(t *testing.T) {
+	casos := []struct {
+		cnpj   string
+		valido bool
+	}{
+		{"11222333000181", true},
+		{"33000167000101", true},
+		{"00000000000191", true},
+		{"11222333000182", false},
+		{"11222333000191", false},
+		{"00000000000000", false},
+		{"11111111111111", false},
+		{"1122233300018", false},
+		{"112223330001810", false},
+		{"", false},
+	}
+
+	for _, caso := range casos {
+		if obtido := Valido(caso.cnpj); obtido != caso.valido {
+			t.Errorf("Valido(%q) = %t, esperado %t", caso.cnpj, obtido, caso.valido)
+		}
+	}
+}
+
+func TestFormatar(t *testing.T) {
+	casos := []struct {
+		cnpj     string
+		esperado string
+	}{
+		{"11222333000181", "11.222.333/0001-81"},
+		{"11.222.333/0001-81", "11.222.333/0001-81"},
+		{"1122233300018", "1122233300018"},
+	}
+
+	for _, caso := range casos {
+		if obtido := Formatar(caso.cnpj); obtido != caso.esperado {
+			t.Errorf("Formatar(%q) = %q, esperado %q", caso.cnpj, obtido, caso.esperado)
+		}
+	}
+}
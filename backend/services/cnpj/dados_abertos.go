@@ -0,0 +1,323 @@
+package cnpj
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/lote"
+	"tsukuyomi/services/texto"
+)
+
+// Tipos de arquivo dos dados abertos do CNPJ, identificados pelo nome, como
+// K3241.K03200Y0.D40914.ESTABELE ou Empresas0.zip.
+const (
+	ARQUIVO_EMPRESAS         = "empresas"
+	ARQUIVO_ESTABELECIMENTOS = "estabelecimentos"
+	ARQUIVO_MUNICIPIOS       = "municipios"
+)
+
+// Importar carrega os arquivos de dados abertos do CNPJ do diretório, como
+// publicados pela Receita Federal: CSV separados por ponto e vírgula, sem
+// cabeçalho e em ISO-8859-1, compactados ou não. Arquivos de outras tabelas
+// são ignorados. Os registros já importados são atualizados.
+func (s *service) Importar(ctx context.Context, diretorio string) (models.ImportacaoCNPJ, error) {
+	importacao := models.ImportacaoCNPJ{
+		Arquivos: []string{},
+		Erros:    []string{},
+	}
+
+	entradas, err := os.ReadDir(diretorio)
+	if err != nil {
+		return importacao, err
+	}
+
+	for _, entrada := range entradas {
+		if entrada.IsDir() {
+			continue
+		}
+
+		caminho := filepath.Join(diretorio, entrada.Name())
+
+		if strings.EqualFold(filepath.Ext(caminho), ".zip") {
+			if err := s.importarZip(ctx, caminho, &importacao); err != nil {
+				return importacao, err
+			}
+
+			continue
+		}
+
+		tipo := tipoArquivo(entrada.Name())
+		if tipo == "" {
+			continue
+		}
+
+		arquivo, err := os.Open(caminho)
+		if err != nil {
+			return importacao, err
+		}
+
+		err = s.importarArquivo(ctx, entrada.Name(), tipo, arquivo, &importacao)
+		arquivo.Close()
+
+		if err != nil {
+			return importacao, err
+		}
+	}
+
+	return importacao, nil
+}
+
+// importarZip importa os arquivos reconhecidos dentro do arquivo compactado.
+// O tipo vem do nome do arquivo interno ou, se ele não o indicar, do nome do
+// arquivo compactado.
+func (s *service) importarZip(ctx context.Context, caminho string, importacao *models.ImportacaoCNPJ) error {
+	compactado, err := zip.OpenReader(caminho)
+	if err != nil {
+		return err
+	}
+
+	defer compactado.Close()
+
+	for _, interno := range compactado.File {
+		if interno.FileInfo().IsDir() {
+			continue
+		}
+
+		tipo := tipoArquivo(interno.Name)
+		if tipo == "" {
+			tipo = tipoArquivo(filepath.Base(caminho))
+		}
+
+		if tipo == "" {
+			continue
+		}
+
+		arquivo, err := interno.Open()
+		if err != nil {
+			return err
+		}
+
+		err = s.importarArquivo(ctx, filepath.Base(caminho)+"/"+interno.Name, tipo, arquivo, importacao)
+		arquivo.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importarArquivo lê o arquivo linha a linha, gravando em lotes para não
+// carregar na memória os arquivos de estabelecimentos, com milhões de linhas.
+func (s *service) importarArquivo(ctx context.Context, nome, tipo string, conteudo io.Reader, importacao *models.ImportacaoCNPJ) error {
+	log.Info("Importando arquivo do CNPJ", "arquivo", nome, "tipo", tipo)
+
+	importacao.Arquivos = append(importacao.Arquivos, nome)
+
+	leitor := csv.NewReader(texto.NovoLeitorUTF8(conteudo))
+	leitor.Comma = ';'
+	leitor.FieldsPerRecord = -1
+	leitor.LazyQuotes = true
+	leitor.ReuseRecord = true
+
+	var (
+		empresas         []models.CNPJEmpresa
+		estabelecimentos []models.CNPJEstabelecimento
+		municipios       []models.CNPJMunicipio
+	)
+
+	gravar := func() error {
+		if err := s.repository.ImportarEmpresas(ctx, empresas); err != nil {
+			return err
+		}
+
+		if err := s.repository.ImportarEstabelecimentos(ctx, estabelecimentos); err != nil {
+			return err
+		}
+
+		if err := s.repository.ImportarMunicipios(ctx, municipios); err != nil {
+			return err
+		}
+
+		importacao.Empresas += len(empresas)
+		importacao.Estabelecimentos += len(estabelecimentos)
+		importacao.Municipios += len(municipios)
+
+		empresas = empresas[:0]
+		estabelecimentos = estabelecimentos[:0]
+		municipios = municipios[:0]
+
+		return nil
+	}
+
+	numero := 0
+
+	for {
+		registro, err := leitor.Read()
+		if err == io.EOF {
+			break
+		}
+
+		numero++
+
+		if err != nil {
+			erroImportacao(importacao, fmt.Sprintf("%s, linha %d: %s", nome, numero, err.Error()))
+			continue
+		}
+
+		switch tipo {
+		case ARQUIVO_EMPRESAS:
+			empresa, err := lerEmpresa(registro)
+			if err != nil {
+				erroImportacao(importacao, fmt.Sprintf("%s, linha %d: %s", nome, numero, err.Error()))
+				continue
+			}
+
+			empresas = append(empresas, empresa)
+		case ARQUIVO_ESTABELECIMENTOS:
+			estabelecimento, err := lerEstabelecimento(registro)
+			if err != nil {
+				erroImportacao(importacao, fmt.Sprintf("%s, linha %d: %s", nome, numero, err.Error()))
+				continue
+			}
+
+			estabelecimentos = append(estabelecimentos, estabelecimento)
+		case ARQUIVO_MUNICIPIOS:
+			if len(registro) < 2 {
+				erroImportacao(importacao, fmt.Sprintf("%s, linha %d: esperadas as colunas código e nome", nome, numero))
+				continue
+			}
+
+			municipios = append(municipios, models.CNPJMunicipio{
+				Codigo: strings.TrimSpace(registro[0]),
+				Nome:   strings.TrimSpace(registro[1]),
+			})
+		}
+
+		if len(empresas)+len(estabelecimentos)+len(municipios) >= lote.TAMANHO {
+			if err := gravar(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return gravar()
+}
+
+// lerEmpresa interpreta a linha do arquivo de empresas: CNPJ básico, razão
+// social, natureza jurídica, qualificação do responsável, capital social,
+// porte e ente federativo.
+func lerEmpresa(registro []string) (models.CNPJEmpresa, error) {
+	if len(registro) < 6 {
+		return models.CNPJEmpresa{}, fmt.Errorf("esperadas 7 colunas, encontradas %d", len(registro))
+	}
+
+	empresa := models.CNPJEmpresa{
+		CNPJBasico:       texto.Digitos(registro[0]),
+		RazaoSocial:      strings.TrimSpace(registro[1]),
+		NaturezaJuridica: strings.TrimSpace(registro[2]),
+		Porte:            strings.TrimSpace(registro[5]),
+	}
+
+	if len(empresa.CNPJBasico) != 8 {
+		return models.CNPJEmpresa{}, fmt.Errorf("CNPJ básico inválido: %q", registro[0])
+	}
+
+	if capital := strings.TrimSpace(registro[4]); capital != "" {
+		valor, err := models.ParseDinheiro(capital)
+		if err != nil {
+			return models.CNPJEmpresa{}, fmt.Errorf("capital social inválido: %q", capital)
+		}
+
+		empresa.CapitalSocial = valor
+	}
+
+	return empresa, nil
+}
+
+// lerEstabelecimento interpreta a linha do arquivo de estabelecimentos, com
+// o CNPJ dividido em básico, ordem e dígitos verificadores e o telefone em
+// DDD e número.
+func lerEstabelecimento(registro []string) (models.CNPJEstabelecimento, error) {
+	if len(registro) < 28 {
+		return models.CNPJEstabelecimento{}, fmt.Errorf("esperadas 30 colunas, encontradas %d", len(registro))
+	}
+
+	estabelecimento := models.CNPJEstabelecimento{
+		CNPJ:              texto.Digitos(registro[0] + registro[1] + registro[2]),
+		CNPJBasico:        texto.Digitos(registro[0]),
+		Matriz:            strings.TrimSpace(registro[3]) == "1",
+		NomeFantasia:      opcional(registro[4]),
+		SituacaoCadastral: fmt.Sprintf("%02s", strings.TrimSpace(registro[5])),
+		CNAEPrincipal:     strings.TrimSpace(registro[11]),
+		TipoLogradouro:    strings.TrimSpace(registro[13]),
+		Logradouro:        strings.TrimSpace(registro[14]),
+		Numero:            strings.TrimSpace(registro[15]),
+		Complemento:       opcional(registro[16]),
+		Bairro:            strings.TrimSpace(registro[17]),
+		CEP:               texto.Digitos(registro[18]),
+		UF:                strings.TrimSpace(registro[19]),
+		Municipio:         strings.TrimSpace(registro[20]),
+		Telefone:          opcional(strings.TrimSpace(registro[21]) + strings.TrimSpace(registro[22])),
+		Email:             opcional(strings.ToLower(registro[27])),
+	}
+
+	if len(estabelecimento.CNPJ) != 14 {
+		return models.CNPJEstabelecimento{}, fmt.Errorf("CNPJ inválido: %q", registro[0]+registro[1]+registro[2])
+	}
+
+	if data := strings.TrimSpace(registro[10]); data != "" && data != "0" && data != "00000000" {
+		inicio, err := time.ParseInLocation("20060102", data, time.Local)
+		if err != nil {
+			return models.CNPJEstabelecimento{}, fmt.Errorf("data de início de atividade inválida: %q", data)
+		}
+
+		estabelecimento.DataInicioAtividade = &inicio
+	}
+
+	if estabelecimento.Numero == "SN" {
+		estabelecimento.Numero = "S/N"
+	}
+
+	return estabelecimento, nil
+}
+
+func tipoArquivo(nome string) string {
+	nome = strings.ToUpper(filepath.Base(nome))
+
+	switch {
+	case strings.Contains(nome, "ESTABELE"):
+		return ARQUIVO_ESTABELECIMENTOS
+	case strings.Contains(nome, "EMPRE"):
+		return ARQUIVO_EMPRESAS
+	case strings.Contains(nome, "MUNIC"):
+		return ARQUIVO_MUNICIPIOS
+	}
+
+	return ""
+}
+
+func erroImportacao(importacao *models.ImportacaoCNPJ, erro string) {
+	importacao.Ignorados++
+	importacao.Erros = lote.Erro(importacao.Erros, erro)
+}
+
+func opcional(valor string) *string {
+	valor = strings.TrimSpace(valor)
+	if valor == "" {
+		return nil
+	}
+
+	return &valor
+}
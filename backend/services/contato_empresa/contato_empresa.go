@@ -2,35 +2,50 @@ package contatoempresa
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"tsukuyomi/models"
 	contatoempresa "tsukuyomi/repositories/contato_empresa"
+	"tsukuyomi/repositories/pessoa"
+)
+
+const (
+	ERROR_PESSOA_NOT_FOUND     = "pessoa não encontrada"
+	ERROR_PESSOA_OUTRA_EMPRESA = "a pessoa não pertence à empresa do contato"
+	ERROR_CONTATO_DUPLICADO    = "a empresa já possui esse contato com o mesmo tipo"
 )
 
 type Service interface {
 	Create(ctx context.Context, contato models.ContatoEmpresa) (models.ContatoEmpresa, error)
-	FindAll(ctx context.Context, search, empresa, tipo, contato string) ([]models.ContatoEmpresa, error)
+	FindAll(ctx context.Context, search, empresa, pessoa, tipo, contato string) ([]models.ContatoEmpresa, error)
 	FindByID(ctx context.Context, id string) (models.ContatoEmpresa, error)
 	Update(ctx context.Context, contato models.ContatoEmpresa) error
 	Delete(ctx context.Context, id string) error
 }
 
 type service struct {
-	repository contatoempresa.Repository
+	repository       contatoempresa.Repository
+	PessoaRepository pessoa.Repository
 }
 
-func NewService(repository contatoempresa.Repository) Service {
+func NewService(repository contatoempresa.Repository, pessoaRepository pessoa.Repository) Service {
 	return &service{
-		repository: repository,
+		repository:       repository,
+		PessoaRepository: pessoaRepository,
 	}
 }
 
 func (s *service) Create(ctx context.Context, contato models.ContatoEmpresa) (models.ContatoEmpresa, error) {
+	if err := s.validar(ctx, contato); err != nil {
+		return models.ContatoEmpresa{}, err
+	}
+
 	return s.repository.Create(ctx, contato)
 }
 
-func (s *service) FindAll(ctx context.Context, search, empresa, tipo, contato string) ([]models.ContatoEmpresa, error) {
-	return s.repository.FindAll(ctx, search, empresa, tipo, contato)
+func (s *service) FindAll(ctx context.Context, search, empresa, pessoa, tipo, contato string) ([]models.ContatoEmpresa, error) {
+	return s.repository.FindAll(ctx, search, empresa, pessoa, tipo, contato)
 }
 
 func (s *service) FindByID(ctx context.Context, id string) (models.ContatoEmpresa, error) {
@@ -38,9 +53,47 @@ func (s *service) FindByID(ctx context.Context, id string) (models.ContatoEmpres
 }
 
 func (s *service) Update(ctx context.Context, contato models.ContatoEmpresa) error {
+	if err := s.validar(ctx, contato); err != nil {
+		return err
+	}
+
 	return s.repository.Update(ctx, contato)
 }
 
 func (s *service) Delete(ctx context.Context, id string) error {
 	return s.repository.Delete(ctx, id)
 }
+
+// validar confere que a pessoa do contato, quando informada, é da mesma
+// empresa, e que a empresa não tem o mesmo contato com o mesmo tipo. O mesmo
+// contato pode existir em empresas diferentes, como uma central telefônica
+// compartilhada.
+func (s *service) validar(ctx context.Context, contato models.ContatoEmpresa) error {
+	if contato.IDPessoa != nil {
+		pessoa, err := s.PessoaRepository.FindByID(ctx, fmt.Sprint(*contato.IDPessoa))
+		if err != nil {
+			return err
+		}
+
+		if pessoa.ID == 0 {
+			return errors.New(ERROR_PESSOA_NOT_FOUND)
+		}
+
+		if pessoa.IDEmpresa != contato.IDEmpresa {
+			return errors.New(ERROR_PESSOA_OUTRA_EMPRESA)
+		}
+	}
+
+	existentes, err := s.repository.FindAll(ctx, "", fmt.Sprint(contato.IDEmpresa), "", contato.Tipo, contato.Contato)
+	if err != nil {
+		return err
+	}
+
+	for _, existente := range existentes {
+		if existente.ID != contato.ID {
+			return errors.New(ERROR_CONTATO_DUPLICADO)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,266 @@
+package convencaocoletiva
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"tsukuyomi/models"
+	convencaoColetiva "tsukuyomi/repositories/convencao_coletiva"
+	"tsukuyomi/repositories/emprego"
+	empregoOcupacao "tsukuyomi/repositories/emprego_ocupacao"
+	"tsukuyomi/repositories/empresa"
+	"tsukuyomi/repositories/remuneracao"
+	"tsukuyomi/repositories/sindicato"
+	"tsukuyomi/services/beneficio"
+	"tsukuyomi/services/datas"
+	empregoOcupacaoService "tsukuyomi/services/emprego_ocupacao"
+	remuneracaoService "tsukuyomi/services/remuneracao"
+	"tsukuyomi/services/tributos"
+)
+
+const (
+	ERROR_EMPRESA_NOT_FOUND   = "empresa não encontrada"
+	ERROR_SINDICATO_NOT_FOUND = "sindicato não encontrado"
+	ERROR_CONVENCAO_NOT_FOUND = "convenção coletiva não encontrada"
+
+	MOTIVO_ADMITIDO        = "admitido na data-base ou depois dela"
+	MOTIVO_ENCERRADO       = "emprego encerrado antes da data-base"
+	MOTIVO_JA_REAJUSTADO   = "já existe remuneração registrada na data-base"
+	MOTIVO_SEM_OCUPACAO    = "nenhuma ocupação vigente no emprego na data-base"
+	MOTIVO_SEM_REMUNERACAO = "nenhuma remuneração vigente antes da data-base"
+)
+
+type Service interface {
+	Create(ctx context.Context, convencao models.ConvencaoColetiva) (models.ConvencaoColetiva, error)
+	FindByEmpresa(ctx context.Context, id_empresa string) ([]models.ConvencaoColetiva, error)
+	FindByID(ctx context.Context, id_empresa, id string) (models.ConvencaoColetiva, error)
+	Update(ctx context.Context, convencao models.ConvencaoColetiva) error
+	Delete(ctx context.Context, id_empresa, id string) error
+	AplicarReajuste(ctx context.Context, id_empresa, id string, simulacao bool) (models.AplicacaoReajuste, error)
+}
+
+type service struct {
+	repository                convencaoColetiva.Repository
+	EmpresaRepository         empresa.Repository
+	SindicatoRepository       sindicato.Repository
+	EmpregoRepository         emprego.Repository
+	EmpregoOcupacaoRepository empregoOcupacao.Repository
+	RemuneracaoRepository     remuneracao.Repository
+	RemuneracaoService        remuneracaoService.Service
+}
+
+func NewService(repository convencaoColetiva.Repository, empresaRepository empresa.Repository, sindicatoRepository sindicato.Repository, empregoRepository emprego.Repository, empregoOcupacaoRepository empregoOcupacao.Repository, remuneracaoRepository remuneracao.Repository, remuneracaoService remuneracaoService.Service) Service {
+	return &service{
+		repository:                repository,
+		EmpresaRepository:         empresaRepository,
+		SindicatoRepository:       sindicatoRepository,
+		EmpregoRepository:         empregoRepository,
+		EmpregoOcupacaoRepository: empregoOcupacaoRepository,
+		RemuneracaoRepository:     remuneracaoRepository,
+		RemuneracaoService:        remuneracaoService,
+	}
+}
+
+func (s *service) Create(ctx context.Context, convencao models.ConvencaoColetiva) (models.ConvencaoColetiva, error) {
+	empresa, err := s.EmpresaRepository.FindByID(ctx, fmt.Sprint(convencao.IDEmpresa))
+	if err != nil {
+		return models.ConvencaoColetiva{}, err
+	}
+
+	if empresa.ID == 0 {
+		return models.ConvencaoColetiva{}, errors.New(ERROR_EMPRESA_NOT_FOUND)
+	}
+
+	sindicato, err := s.buscarSindicato(ctx, convencao.IDSindicato)
+	if err != nil {
+		return models.ConvencaoColetiva{}, err
+	}
+
+	convencao, err = s.repository.Create(ctx, convencao)
+	if err != nil {
+		return models.ConvencaoColetiva{}, err
+	}
+
+	convencao.Sindicato = &sindicato
+
+	return convencao, nil
+}
+
+func (s *service) FindByEmpresa(ctx context.Context, id_empresa string) ([]models.ConvencaoColetiva, error) {
+	return s.repository.FindByEmpresa(ctx, id_empresa)
+}
+
+func (s *service) FindByID(ctx context.Context, id_empresa, id string) (models.ConvencaoColetiva, error) {
+	return s.repository.FindByID(ctx, id_empresa, id)
+}
+
+func (s *service) Update(ctx context.Context, convencao models.ConvencaoColetiva) error {
+	sindicato, err := s.buscarSindicato(ctx, convencao.IDSindicato)
+	if err != nil {
+		return err
+	}
+
+	convencao.Sindicato = &sindicato
+
+	return s.repository.Update(ctx, convencao)
+}
+
+func (s *service) Delete(ctx context.Context, id_empresa, id string) error {
+	return s.repository.Delete(ctx, id_empresa, id)
+}
+
+// AplicarReajuste reajusta, no primeiro dia do mês da data-base do
+// sindicato, o salário de cada emprego da empresa ativo na data-base pelo
+// percentual da convenção, registrando uma nova remuneração. O salário
+// reajustado é o vigente na véspera da data-base. Empregos iniciados na
+// data-base ou depois dela, encerrados antes dela ou que já têm remuneração
+// na data-base são ignorados, o que permite aplicar a convenção de novo sem
+// duplicar os reajustes. Na simulação, o resultado é o mesmo, mas nenhuma
+// remuneração é registrada.
+func (s *service) AplicarReajuste(ctx context.Context, id_empresa, id string, simulacao bool) (models.AplicacaoReajuste, error) {
+	convencao, err := s.repository.FindByID(ctx, id_empresa, id)
+	if err != nil {
+		return models.AplicacaoReajuste{}, err
+	}
+
+	if convencao.ID == 0 {
+		return models.AplicacaoReajuste{}, errors.New(ERROR_CONVENCAO_NOT_FOUND)
+	}
+
+	dataBase := time.Date(convencao.Ano, time.Month(convencao.Sindicato.MesDataBase), 1, 0, 0, 0, 0, time.Local)
+
+	aplicacao := models.AplicacaoReajuste{
+		IDConvencao: convencao.ID,
+		DataBase:    dataBase,
+		Percentual:  convencao.Percentual,
+		Simulacao:   simulacao,
+		Reajustes:   []models.ReajusteEmprego{},
+	}
+
+	empregos, err := s.EmpregoRepository.FindAll(ctx, "", id_empresa, "", "", "", "", "", "")
+	if err != nil {
+		return models.AplicacaoReajuste{}, err
+	}
+
+	for _, emprego := range empregos {
+		reajuste := s.reajustar(ctx, emprego, convencao, dataBase, simulacao)
+
+		switch reajuste.Situacao {
+		case models.REAJUSTE_IGNORADO:
+			aplicacao.Ignorados++
+		case models.REAJUSTE_ERRO:
+			aplicacao.Erros++
+		default:
+			aplicacao.Aplicados++
+		}
+
+		aplicacao.Reajustes = append(aplicacao.Reajustes, reajuste)
+	}
+
+	return aplicacao, nil
+}
+
+func (s *service) reajustar(ctx context.Context, emprego models.Emprego, convencao models.ConvencaoColetiva, dataBase time.Time, simulacao bool) models.ReajusteEmprego {
+	reajuste := models.ReajusteEmprego{
+		IDEmprego: emprego.ID,
+		Ocupacao:  emprego.Ocupacao,
+	}
+
+	ignorar := func(motivo string) models.ReajusteEmprego {
+		reajuste.Situacao = models.REAJUSTE_IGNORADO
+		reajuste.Motivo = &motivo
+		return reajuste
+	}
+
+	falhar := func(motivo string) models.ReajusteEmprego {
+		reajuste.Situacao = models.REAJUSTE_ERRO
+		reajuste.Motivo = &motivo
+		return reajuste
+	}
+
+	if !emprego.DataInicio.Before(dataBase) {
+		return ignorar(MOTIVO_ADMITIDO)
+	}
+
+	if emprego.DataFim != nil && emprego.DataFim.Before(dataBase) {
+		return ignorar(MOTIVO_ENCERRADO)
+	}
+
+	id_emprego := fmt.Sprint(emprego.ID)
+
+	remuneracoes, err := s.RemuneracaoRepository.FindByEmprego(ctx, id_emprego)
+	if err != nil {
+		return falhar(err.Error())
+	}
+
+	var idOcupacao int64
+
+	for _, remuneracao := range remuneracoes {
+		data := datas.Dia(remuneracao.Data)
+
+		if data.Equal(dataBase) {
+			return ignorar(MOTIVO_JA_REAJUSTADO)
+		}
+
+		if data.Before(dataBase) {
+			idOcupacao = remuneracao.IDOcupacao
+		}
+	}
+
+	historico, err := s.EmpregoOcupacaoRepository.FindByEmprego(ctx, id_emprego)
+	if err != nil {
+		return falhar(err.Error())
+	}
+
+	if i := empregoOcupacaoService.Vigente(historico, dataBase); i >= 0 {
+		idOcupacao = historico[i].IDOcupacao
+	}
+
+	reajuste.Anterior = tributos.Arredondar(beneficio.Salario(emprego, remuneracoes, dataBase.AddDate(0, 0, -1)))
+	if reajuste.Anterior <= 0 {
+		return falhar(MOTIVO_SEM_REMUNERACAO)
+	}
+
+	reajuste.Novo = tributos.Arredondar(reajuste.Anterior + reajuste.Anterior.Percentual(convencao.Percentual))
+	reajuste.Diferenca = reajuste.Novo - reajuste.Anterior
+
+	if idOcupacao == 0 {
+		return falhar(MOTIVO_SEM_OCUPACAO)
+	}
+
+	if simulacao {
+		reajuste.Situacao = models.REAJUSTE_SIMULADO
+		return reajuste
+	}
+
+	remuneracao, err := s.RemuneracaoService.Create(ctx, models.Remuneracao{
+		IDEmprego:   emprego.ID,
+		IDOcupacao:  idOcupacao,
+		Remuneracao: reajuste.Novo,
+		Data:        dataBase,
+		Criado:      time.Now(),
+	})
+	if err != nil {
+		return falhar(err.Error())
+	}
+
+	reajuste.Situacao = models.REAJUSTE_APLICADO
+	reajuste.IDRemuneracao = &remuneracao.ID
+
+	return reajuste
+}
+
+func (s *service) buscarSindicato(ctx context.Context, id int64) (models.Sindicato, error) {
+	sindicato, err := s.SindicatoRepository.FindByID(ctx, fmt.Sprint(id))
+	if err != nil {
+		return models.Sindicato{}, err
+	}
+
+	if sindicato.ID == 0 {
+		return models.Sindicato{}, errors.New(ERROR_SINDICATO_NOT_FOUND)
+	}
+
+	return sindicato, nil
+}
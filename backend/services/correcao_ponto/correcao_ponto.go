@@ -0,0 +1,131 @@
+package correcaoponto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"tsukuyomi/models"
+	correcaoPonto "tsukuyomi/repositories/correcao_ponto"
+	"tsukuyomi/repositories/ponto"
+)
+
+const (
+	ERROR_MARCACAO_NOT_FOUND = "marcação não encontrada no cartão de ponto do emprego"
+	ERROR_MARCACAO_CORRIGIDA = "a marcação já possui uma correção pendente ou aprovada"
+	ERROR_CORRECAO_NOT_FOUND = "correção não encontrada"
+	ERROR_CORRECAO_DECIDIDA  = "apenas correções pendentes podem ser aprovadas, rejeitadas ou apagadas"
+)
+
+type Service interface {
+	Create(ctx context.Context, correcao models.CorrecaoPonto) (models.CorrecaoPonto, error)
+	FindByEmprego(ctx context.Context, id_emprego, situacao string) ([]models.CorrecaoPonto, error)
+	FindByID(ctx context.Context, id_emprego, id string) (models.CorrecaoPonto, error)
+	Decidir(ctx context.Context, id_emprego, id string, decisao models.DecisaoCorrecao) (models.CorrecaoPonto, error)
+	Delete(ctx context.Context, id_emprego, id string) error
+}
+
+type service struct {
+	repository      correcaoPonto.Repository
+	PontoRepository ponto.Repository
+}
+
+func NewService(repository correcaoPonto.Repository, pontoRepository ponto.Repository) Service {
+	return &service{
+		repository:      repository,
+		PontoRepository: pontoRepository,
+	}
+}
+
+// Create registra uma correção pendente. Correções que alteram ou removem uma
+// marcação exigem que ela pertença ao emprego e não tenha outra correção
+// pendente ou aprovada.
+func (s *service) Create(ctx context.Context, correcao models.CorrecaoPonto) (models.CorrecaoPonto, error) {
+	if correcao.IDCartaoPonto != nil {
+		id_emprego := fmt.Sprint(correcao.IDEmprego)
+
+		original, err := s.PontoRepository.FindByID(ctx, id_emprego, fmt.Sprint(*correcao.IDCartaoPonto))
+		if err != nil {
+			return models.CorrecaoPonto{}, err
+		}
+
+		if original.ID == 0 {
+			return models.CorrecaoPonto{}, errors.New(ERROR_MARCACAO_NOT_FOUND)
+		}
+
+		existentes, err := s.repository.FindByEmprego(ctx, id_emprego, "")
+		if err != nil {
+			return models.CorrecaoPonto{}, err
+		}
+
+		for _, existente := range existentes {
+			if existente.IDCartaoPonto != nil && *existente.IDCartaoPonto == original.ID && existente.Situacao != models.CORRECAO_REJEITADA {
+				return models.CorrecaoPonto{}, errors.New(ERROR_MARCACAO_CORRIGIDA)
+			}
+		}
+
+		correcao.Original = &original
+	}
+
+	correcao.Situacao = models.CORRECAO_PENDENTE
+
+	return s.repository.Create(ctx, correcao)
+}
+
+func (s *service) FindByEmprego(ctx context.Context, id_emprego, situacao string) ([]models.CorrecaoPonto, error) {
+	return s.repository.FindByEmprego(ctx, id_emprego, situacao)
+}
+
+func (s *service) FindByID(ctx context.Context, id_emprego, id string) (models.CorrecaoPonto, error) {
+	return s.repository.FindByID(ctx, id_emprego, id)
+}
+
+// Decidir aprova ou rejeita uma correção pendente. Correções aprovadas passam a
+// ser aplicadas na apuração do ponto.
+func (s *service) Decidir(ctx context.Context, id_emprego, id string, decisao models.DecisaoCorrecao) (models.CorrecaoPonto, error) {
+	correcao, err := s.repository.FindByID(ctx, id_emprego, id)
+	if err != nil {
+		return models.CorrecaoPonto{}, err
+	}
+
+	if correcao.ID == 0 {
+		return models.CorrecaoPonto{}, errors.New(ERROR_CORRECAO_NOT_FOUND)
+	}
+
+	if correcao.Situacao != models.CORRECAO_PENDENTE {
+		return models.CorrecaoPonto{}, errors.New(ERROR_CORRECAO_DECIDIDA)
+	}
+
+	agora := time.Now()
+
+	correcao.Situacao = decisao.Situacao
+	correcao.MotivoDecisao = decisao.Motivo
+	correcao.Decidido = &agora
+	correcao.Atualizado = &agora
+
+	if err := s.repository.Decidir(ctx, correcao); err != nil {
+		return models.CorrecaoPonto{}, err
+	}
+
+	return correcao, nil
+}
+
+// Delete apaga uma correção ainda pendente. Correções decididas fazem parte do
+// histórico do cartão de ponto e não podem ser apagadas.
+func (s *service) Delete(ctx context.Context, id_emprego, id string) error {
+	correcao, err := s.repository.FindByID(ctx, id_emprego, id)
+	if err != nil {
+		return err
+	}
+
+	if correcao.ID == 0 {
+		return errors.New(ERROR_CORRECAO_NOT_FOUND)
+	}
+
+	if correcao.Situacao != models.CORRECAO_PENDENTE {
+		return errors.New(ERROR_CORRECAO_DECIDIDA)
+	}
+
+	return s.repository.Delete(ctx, id_emprego, id)
+}
@@ -0,0 +1,131 @@
+package cotacao
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/cotacao"
+	"tsukuyomi/services/datas"
+)
+
+// MAX_DIAS_SEM_COTACAO é o maior intervalo aceito entre a data de pagamento e
+// a última cotação anterior a ela, o que cobre fins de semana e feriados
+// prolongados. Intervalos maiores indicam cotações não importadas.
+const MAX_DIAS_SEM_COTACAO = 7
+
+const (
+	ERROR_SEM_COTACAO = "cotação PTAX do %s em %s não importada"
+)
+
+type Service interface {
+	FindAll(ctx context.Context, moeda, inicio, fim string) ([]models.Cotacao, error)
+	Importar(ctx context.Context, conteudo io.Reader, moedas []string) (models.ImportacaoCotacoes, error)
+}
+
+type service struct {
+	repository cotacao.Repository
+}
+
+func NewService(repository cotacao.Repository) Service {
+	return &service{
+		repository: repository,
+	}
+}
+
+func (s *service) FindAll(ctx context.Context, moeda, inicio, fim string) ([]models.Cotacao, error) {
+	return s.repository.FindAll(ctx, strings.ToUpper(moeda), inicio, fim)
+}
+
+// Importar importa as cotações de fechamento da PTAX. Dias já importados têm
+// as taxas atualizadas.
+func (s *service) Importar(ctx context.Context, conteudo io.Reader, moedas []string) (models.ImportacaoCotacoes, error) {
+	cotacoes, relatorio, err := lerPTAX(conteudo, moedas)
+	if err != nil {
+		return models.ImportacaoCotacoes{}, err
+	}
+
+	criado := time.Now()
+
+	for i := range cotacoes {
+		cotacoes[i].Criado = criado
+	}
+
+	if len(cotacoes) == 0 {
+		return relatorio, nil
+	}
+
+	inseridas, atualizadas, err := s.repository.Importar(ctx, cotacoes)
+	if err != nil {
+		return models.ImportacaoCotacoes{}, err
+	}
+
+	relatorio.Importadas = inseridas
+	relatorio.Atualizadas = atualizadas
+	relatorio.Ignoradas += len(cotacoes) - inseridas - atualizadas
+
+	return relatorio, nil
+}
+
+// Conversor converte valores em moeda estrangeira para reais pela taxa PTAX
+// de compra da data de pagamento, carregando as cotações de cada moeda uma
+// única vez.
+type Conversor struct {
+	repository cotacao.Repository
+	cotacoes   map[string][]models.Cotacao
+}
+
+func NovoConversor(repository cotacao.Repository) *Conversor {
+	return &Conversor{
+		repository: repository,
+		cotacoes:   make(map[string][]models.Cotacao),
+	}
+}
+
+// Converter retorna a cotação da moeda na data: a do próprio dia ou, em dias
+// sem cotação, a do último dia útil anterior. Valores em reais e sem moeda
+// têm taxa 1.
+func (c *Conversor) Converter(ctx context.Context, moeda string, data time.Time) (models.Conversao, error) {
+	data = datas.Dia(data)
+
+	conversao := models.Conversao{
+		Moeda: moeda,
+		Data:  data,
+		Taxa:  1,
+	}
+
+	if moeda == "" || moeda == models.MOEDA_BRL {
+		conversao.Moeda = models.MOEDA_BRL
+		conversao.Cotacao = data
+		return conversao, nil
+	}
+
+	cotacoes, ok := c.cotacoes[moeda]
+	if !ok {
+		var err error
+
+		cotacoes, err = c.repository.FindAll(ctx, moeda, "", "")
+		if err != nil {
+			return models.Conversao{}, err
+		}
+
+		c.cotacoes[moeda] = cotacoes
+	}
+
+	i := sort.Search(len(cotacoes), func(i int) bool {
+		return cotacoes[i].Data.After(data)
+	}) - 1
+
+	if i < 0 || data.Sub(cotacoes[i].Data) > MAX_DIAS_SEM_COTACAO*24*time.Hour {
+		return models.Conversao{}, fmt.Errorf(ERROR_SEM_COTACAO, moeda, data.Format("02/01/2006"))
+	}
+
+	conversao.Cotacao = cotacoes[i].Data
+	conversao.Taxa = cotacoes[i].Compra
+
+	return conversao, nil
+}
@@ -0,0 +1,129 @@
+package cotacao
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"tsukuyomi/models"
+)
+
+// lerPTAX interpreta os arquivos CSV de fechamento da PTAX publicados pelo
+// Banco Central, tanto o arquivo diário com todas as moedas quanto o
+// histórico de uma moeda, separados por ponto e vírgula e sem cabeçalho. As
+// colunas são a data (DDMMAAAA ou DD/MM/AAAA), o código e o tipo da moeda, a
+// sigla, as taxas de compra e de venda em reais e as paridades com o dólar.
+// Quando as moedas são informadas, as demais são desconsideradas.
+func lerPTAX(conteudo io.Reader, moedas []string) ([]models.Cotacao, models.ImportacaoCotacoes, error) {
+	relatorio := models.ImportacaoCotacoes{
+		Erros: []string{},
+	}
+
+	filtro := make(map[string]bool)
+	for _, moeda := range moedas {
+		filtro[strings.ToUpper(strings.TrimSpace(moeda))] = true
+	}
+
+	leitor := csv.NewReader(conteudo)
+	leitor.Comma = ';'
+	leitor.FieldsPerRecord = -1
+	leitor.LazyQuotes = true
+
+	var cotacoes []models.Cotacao
+	vistas := make(map[string]bool)
+	numero := 0
+
+	for {
+		registro, err := leitor.Read()
+		if err == io.EOF {
+			break
+		}
+
+		numero++
+
+		if err != nil {
+			relatorio.Linhas++
+			relatorio.Erros = append(relatorio.Erros, fmt.Sprintf("linha %d: %s", numero, err.Error()))
+			continue
+		}
+
+		if len(registro) == 0 || (len(registro) == 1 && strings.TrimSpace(registro[0]) == "") {
+			continue
+		}
+
+		data, ok := lerData(registro[0])
+		if !ok && numero == 1 {
+			continue
+		}
+
+		relatorio.Linhas++
+
+		if !ok || len(registro) < 6 {
+			relatorio.Erros = append(relatorio.Erros, fmt.Sprintf("linha %d: esperadas as colunas data, código, tipo, moeda, compra e venda", numero))
+			continue
+		}
+
+		moeda := strings.ToUpper(strings.TrimSpace(registro[3]))
+
+		if len(filtro) > 0 && !filtro[moeda] {
+			relatorio.Ignoradas++
+			continue
+		}
+
+		compra, errCompra := lerTaxa(registro[4])
+		venda, errVenda := lerTaxa(registro[5])
+		if errCompra != nil || errVenda != nil {
+			relatorio.Erros = append(relatorio.Erros, fmt.Sprintf("linha %d: taxas de compra e venda inválidas", numero))
+			continue
+		}
+
+		cotacao := models.Cotacao{
+			Moeda:  moeda,
+			Data:   data,
+			Compra: compra,
+			Venda:  venda,
+		}
+
+		if err := cotacao.Validate(); err != nil {
+			relatorio.Erros = append(relatorio.Erros, fmt.Sprintf("linha %d: %s", numero, err.Error()))
+			continue
+		}
+
+		chave := moeda + data.Format("20060102")
+		if vistas[chave] {
+			relatorio.Ignoradas++
+			continue
+		}
+
+		vistas[chave] = true
+		cotacoes = append(cotacoes, cotacao)
+	}
+
+	return cotacoes, relatorio, nil
+}
+
+func lerData(campo string) (time.Time, bool) {
+	campo = strings.TrimSpace(campo)
+
+	for _, formato := range []string{"02012006", "02/01/2006", "2006-01-02"} {
+		if data, err := time.ParseInLocation(formato, campo, time.Local); err == nil {
+			return data, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func lerTaxa(campo string) (float64, error) {
+	campo = strings.TrimSpace(campo)
+
+	if strings.Contains(campo, ",") {
+		campo = strings.ReplaceAll(campo, ".", "")
+		campo = strings.ReplaceAll(campo, ",", ".")
+	}
+
+	return strconv.ParseFloat(campo, 64)
+}
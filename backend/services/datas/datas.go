@@ -0,0 +1,9 @@
+package datas
+
+import "time"
+
+// Dia retorna a data à meia-noite, no fuso local, para comparar datas sem o
+// horário.
+func Dia(data time.Time) time.Time {
+	return time.Date(data.Year(), data.Month(), data.Day(), 0, 0, 0, 0, time.Local)
+}
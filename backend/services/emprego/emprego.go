@@ -2,9 +2,15 @@ package emprego
 
 import (
 	"context"
+	"time"
 
 	"tsukuyomi/models"
+	"tsukuyomi/repositories/beneficio"
+	"tsukuyomi/repositories/cotacao"
 	"tsukuyomi/repositories/emprego"
+	"tsukuyomi/repositories/remuneracao"
+	beneficioService "tsukuyomi/services/beneficio"
+	cotacaoService "tsukuyomi/services/cotacao"
 )
 
 type Service interface {
@@ -16,16 +22,26 @@ type Service interface {
 }
 
 type service struct {
-	repository emprego.Repository
+	repository            emprego.Repository
+	RemuneracaoRepository remuneracao.Repository
+	BeneficioRepository   beneficio.Repository
+	CotacaoRepository     cotacao.Repository
 }
 
-func NewService(repository emprego.Repository) Service {
+func NewService(repository emprego.Repository, remuneracaoRepository remuneracao.Repository, beneficioRepository beneficio.Repository, cotacaoRepository cotacao.Repository) Service {
 	return &service{
-		repository: repository,
+		repository:            repository,
+		RemuneracaoRepository: remuneracaoRepository,
+		BeneficioRepository:   beneficioRepository,
+		CotacaoRepository:     cotacaoRepository,
 	}
 }
 
 func (s *service) Create(ctx context.Context, emprego models.Emprego) (models.Emprego, error) {
+	if emprego.Moeda == "" {
+		emprego.Moeda = models.MOEDA_BRL
+	}
+
 	return s.repository.Create(ctx, emprego)
 }
 
@@ -33,8 +49,40 @@ func (s *service) FindAll(ctx context.Context, search, empresa, ocupacao, remune
 	return s.repository.FindAll(ctx, search, empresa, ocupacao, remuneracao_inicial, tipo_contrato, data_inicio, data_fim, carga_horaria)
 }
 
+// FindByID retorna o emprego com a compensação total vigente, somando a
+// remuneração e os benefícios. O salário em moeda estrangeira é convertido
+// pela PTAX do dia; sem cotação importada, a compensação não é somada.
 func (s *service) FindByID(ctx context.Context, id string) (models.Emprego, error) {
-	return s.repository.FindByID(ctx, id)
+	emprego, err := s.repository.FindByID(ctx, id)
+	if err != nil || emprego.ID == 0 {
+		return emprego, err
+	}
+
+	remuneracoes, err := s.RemuneracaoRepository.FindByEmprego(ctx, id)
+	if err != nil {
+		return models.Emprego{}, err
+	}
+
+	beneficios, err := s.BeneficioRepository.FindByEmprego(ctx, id)
+	if err != nil {
+		return models.Emprego{}, err
+	}
+
+	dia := beneficioService.DiaCompensacao(emprego, time.Now())
+
+	var conversao *models.Conversao
+
+	if moeda := beneficioService.MoedaSalario(emprego, remuneracoes, dia); moeda != models.MOEDA_BRL {
+		cotacao, err := cotacaoService.NovoConversor(s.CotacaoRepository).Converter(ctx, moeda, dia)
+		if err == nil {
+			conversao = &cotacao
+		}
+	}
+
+	compensacao := beneficioService.Compensacao(emprego, remuneracoes, beneficios, dia, conversao)
+	emprego.Compensacao = &compensacao
+
+	return emprego, nil
 }
 
 func (s *service) Update(ctx context.Context, emprego models.Emprego) error {
@@ -0,0 +1,148 @@
+package empregoocupacao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/emprego"
+	empregoOcupacao "tsukuyomi/repositories/emprego_ocupacao"
+	"tsukuyomi/repositories/ocupacao"
+	"tsukuyomi/repositories/remuneracao"
+	"tsukuyomi/services/datas"
+)
+
+const (
+	ERROR_EMPREGO_NOT_FOUND  = "emprego não encontrado"
+	ERROR_OCUPACAO_NOT_FOUND = "ocupação não encontrada"
+	ERROR_DATA_ANTERIOR      = "a ocupação não pode começar antes do início do emprego"
+	ERROR_DATA_REPETIDA      = "o emprego já possui uma ocupação iniciada nesta data"
+)
+
+type Service interface {
+	Create(ctx context.Context, historico models.EmpregoOcupacao) (models.EmpregoOcupacao, error)
+	FindByEmprego(ctx context.Context, id_emprego string) ([]models.EmpregoOcupacao, error)
+	Delete(ctx context.Context, id_emprego, id string) error
+}
+
+type service struct {
+	repository            empregoOcupacao.Repository
+	EmpregoRepository     emprego.Repository
+	OcupacaoRepository    ocupacao.Repository
+	RemuneracaoRepository remuneracao.Repository
+}
+
+func NewService(repository empregoOcupacao.Repository, empregoRepository emprego.Repository, ocupacaoRepository ocupacao.Repository, remuneracaoRepository remuneracao.Repository) Service {
+	return &service{
+		repository:            repository,
+		EmpregoRepository:     empregoRepository,
+		OcupacaoRepository:    ocupacaoRepository,
+		RemuneracaoRepository: remuneracaoRepository,
+	}
+}
+
+// Create registra uma mudança de ocupação no emprego, como uma promoção.
+func (s *service) Create(ctx context.Context, historico models.EmpregoOcupacao) (models.EmpregoOcupacao, error) {
+	id_emprego := fmt.Sprint(historico.IDEmprego)
+
+	emprego, err := s.EmpregoRepository.FindByID(ctx, id_emprego)
+	if err != nil {
+		return models.EmpregoOcupacao{}, err
+	}
+
+	if emprego.ID == 0 {
+		return models.EmpregoOcupacao{}, errors.New(ERROR_EMPREGO_NOT_FOUND)
+	}
+
+	if historico.DataInicio.Before(datas.Dia(emprego.DataInicio)) {
+		return models.EmpregoOcupacao{}, errors.New(ERROR_DATA_ANTERIOR)
+	}
+
+	ocupacao, err := s.OcupacaoRepository.FindByID(ctx, fmt.Sprint(historico.IDOcupacao))
+	if err != nil {
+		return models.EmpregoOcupacao{}, err
+	}
+
+	if ocupacao.ID == 0 {
+		return models.EmpregoOcupacao{}, errors.New(ERROR_OCUPACAO_NOT_FOUND)
+	}
+
+	existentes, err := s.repository.FindByEmprego(ctx, id_emprego)
+	if err != nil {
+		return models.EmpregoOcupacao{}, err
+	}
+
+	for _, existente := range existentes {
+		if datas.Dia(existente.DataInicio).Equal(datas.Dia(historico.DataInicio)) {
+			return models.EmpregoOcupacao{}, errors.New(ERROR_DATA_REPETIDA)
+		}
+	}
+
+	historico.Ocupacao = ocupacao
+
+	if historico.Remuneracao != nil && historico.Moeda == "" {
+		historico.Moeda = emprego.Moeda
+	}
+
+	return s.repository.Create(ctx, historico)
+}
+
+// FindByEmprego retorna o histórico de ocupações do emprego, com o fim de cada
+// período e as remunerações recebidas nele.
+func (s *service) FindByEmprego(ctx context.Context, id_emprego string) ([]models.EmpregoOcupacao, error) {
+	historico, err := s.repository.FindByEmprego(ctx, id_emprego)
+	if err != nil {
+		return []models.EmpregoOcupacao{}, err
+	}
+
+	remuneracoes, err := s.RemuneracaoRepository.FindByEmprego(ctx, id_emprego)
+	if err != nil {
+		return []models.EmpregoOcupacao{}, err
+	}
+
+	return Historico(historico, remuneracoes), nil
+}
+
+func (s *service) Delete(ctx context.Context, id_emprego, id string) error {
+	return s.repository.Delete(ctx, id_emprego, id)
+}
+
+// Historico preenche o fim de cada período, a véspera do início do período
+// seguinte, e associa cada remuneração ao período que contém a sua data. O
+// histórico deve estar ordenado pela data de início.
+func Historico(historico []models.EmpregoOcupacao, remuneracoes []models.Remuneracao) []models.EmpregoOcupacao {
+	for i := range historico {
+		historico[i].Remuneracoes = []models.Remuneracao{}
+
+		if i+1 < len(historico) {
+			fim := datas.Dia(historico[i+1].DataInicio).AddDate(0, 0, -1)
+			historico[i].DataFim = &fim
+		}
+	}
+
+	for _, remuneracao := range remuneracoes {
+		if i := Vigente(historico, remuneracao.Data); i >= 0 {
+			historico[i].Remuneracoes = append(historico[i].Remuneracoes, remuneracao)
+		}
+	}
+
+	return historico
+}
+
+// Vigente retorna o índice do período do histórico em vigor no dia, ou -1 se
+// nenhum período havia começado.
+func Vigente(historico []models.EmpregoOcupacao, dia time.Time) int {
+	vigente := -1
+
+	for i := range historico {
+		if datas.Dia(historico[i].DataInicio).After(datas.Dia(dia)) {
+			break
+		}
+
+		vigente = i
+	}
+
+	return vigente
+}
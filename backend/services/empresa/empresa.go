@@ -2,9 +2,21 @@ package empresa
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 
 	"tsukuyomi/models"
 	"tsukuyomi/repositories/empresa"
+	"tsukuyomi/services/cnpj"
+	"tsukuyomi/services/texto"
+)
+
+const (
+	ERROR_EMPRESA_NOT_FOUND   = "empresa não encontrada"
+	ERROR_DUPLICADA_NOT_FOUND = "empresa duplicada %d não encontrada"
+	ERROR_MESCLAR_NELA_MESMA  = "a empresa não pode ser mesclada nela mesma"
 )
 
 type Service interface {
@@ -13,6 +25,7 @@ type Service interface {
 	FindByID(ctx context.Context, id string) (models.Empresa, error)
 	Update(ctx context.Context, empresa models.Empresa) error
 	Delete(ctx context.Context, id string) error
+	Mesclar(ctx context.Context, id string, mesclagem models.MesclagemEmpresa) (models.Empresa, error)
 }
 
 type service struct {
@@ -33,8 +46,41 @@ func (s *service) FindAll(ctx context.Context, search, nome, cnpj string) ([]mod
 	return s.repository.FindAll(ctx, search, nome, cnpj)
 }
 
+// FindByID retorna a empresa com os endereços e, quando o CNPJ é válido, se
+// ela é matriz ou filial e as outras empresas com a mesma raiz de CNPJ.
 func (s *service) FindByID(ctx context.Context, id string) (models.Empresa, error) {
-	return s.repository.FindByID(ctx, id)
+	empresa, err := s.repository.FindByID(ctx, id)
+	if err != nil || empresa.ID == 0 {
+		return empresa, err
+	}
+
+	numero := texto.Digitos(empresa.CNPJ)
+	if !cnpj.Valido(numero) {
+		return empresa, nil
+	}
+
+	matriz := Matriz(numero)
+	empresa.Matriz = &matriz
+
+	relacionadas, err := s.repository.FindByRaizCNPJ(ctx, numero[:8])
+	if err != nil {
+		return models.Empresa{}, err
+	}
+
+	for _, relacionada := range relacionadas {
+		if relacionada.ID == empresa.ID {
+			continue
+		}
+
+		empresa.Estabelecimentos = append(empresa.Estabelecimentos, models.EstabelecimentoEmpresa{
+			ID:     relacionada.ID,
+			Nome:   relacionada.Nome,
+			CNPJ:   relacionada.CNPJ,
+			Matriz: Matriz(relacionada.CNPJ),
+		})
+	}
+
+	return empresa, nil
 }
 
 func (s *service) Update(ctx context.Context, empresa models.Empresa) error {
@@ -44,3 +90,49 @@ func (s *service) Update(ctx context.Context, empresa models.Empresa) error {
 func (s *service) Delete(ctx context.Context, id string) error {
 	return s.repository.Delete(ctx, id)
 }
+
+// Mesclar mescla as empresas duplicadas na empresa informada: os vínculos com
+// endereços, as pessoas, os contatos, os empregos, as candidaturas e as
+// convenções coletivas passam para ela e as duplicadas são apagadas.
+func (s *service) Mesclar(ctx context.Context, id string, mesclagem models.MesclagemEmpresa) (models.Empresa, error) {
+	empresa, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.Empresa{}, err
+	}
+
+	if empresa.ID == 0 {
+		return models.Empresa{}, errors.New(ERROR_EMPRESA_NOT_FOUND)
+	}
+
+	slices.Sort(mesclagem.Duplicadas)
+	mesclagem.Duplicadas = slices.Compact(mesclagem.Duplicadas)
+
+	for _, duplicada := range mesclagem.Duplicadas {
+		if duplicada == empresa.ID {
+			return models.Empresa{}, errors.New(ERROR_MESCLAR_NELA_MESMA)
+		}
+
+		existente, err := s.repository.FindByID(ctx, fmt.Sprint(duplicada))
+		if err != nil {
+			return models.Empresa{}, err
+		}
+
+		if existente.ID == 0 {
+			return models.Empresa{}, fmt.Errorf(ERROR_DUPLICADA_NOT_FOUND, duplicada)
+		}
+	}
+
+	if err := s.repository.Mesclar(ctx, empresa.ID, mesclagem.Duplicadas, time.Now()); err != nil {
+		return models.Empresa{}, err
+	}
+
+	return s.FindByID(ctx, id)
+}
+
+// Matriz informa se o CNPJ, com ou sem formatação, é o da matriz, de número
+// de ordem 0001. As filiais são numeradas a partir de 0002.
+func Matriz(valor string) bool {
+	numero := texto.Digitos(valor)
+
+	return len(numero) == 14 && numero[8:12] == "0001"
+}
@@ -1,31 +1,70 @@
 package endereco
 
 import (
+	"cmp"
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"tsukuyomi/models"
 	"tsukuyomi/repositories/endereco"
+	"tsukuyomi/services/cep"
+)
+
+const (
+	ERROR_ENDERECO_CADASTRADO = "o endereço já está cadastrado com o ID %d"
+	ERROR_ENDERECO_NOT_FOUND  = "endereço não encontrado"
+	ERROR_DUPLICADO_NOT_FOUND = "endereço duplicado %d não encontrado"
+	ERROR_MESCLAR_NELE_MESMO  = "o endereço não pode ser mesclado nele mesmo"
+
+	AVISO_COLISAO_CHAVE = "endereço %d ficou sem chave por repetir o endereço %d; mescle-os pelo relatório de duplicados"
 )
 
 type Service interface {
-	Create(ctx context.Context, endereco models.Endereco) (models.Endereco, error)
+	Create(ctx context.Context, endereco models.Endereco, preencher bool) (models.Endereco, error)
 	FindAll(ctx context.Context, search, logradouro, numero, complemento, bairro, cidade, cep, estado string) ([]models.Endereco, error)
 	FindByID(ctx context.Context, id string) (models.Endereco, error)
-	Update(ctx context.Context, endereco models.Endereco) error
+	Update(ctx context.Context, endereco models.Endereco) (models.Endereco, error)
 	Delete(ctx context.Context, id string) error
+	Duplicados(ctx context.Context) ([]models.DuplicidadeEndereco, error)
+	Mesclar(ctx context.Context, id string, mesclagem models.MesclagemEndereco) (models.Endereco, error)
+	NormalizarCadastrados(ctx context.Context) (models.NormalizacaoEnderecos, error)
 }
 
 type service struct {
 	repository endereco.Repository
+	CEPService cep.Service
 }
 
-func NewService(repository endereco.Repository) Service {
+func NewService(repository endereco.Repository, cepService cep.Service) Service {
 	return &service{
 		repository: repository,
+		CEPService: cepService,
 	}
 }
 
-func (s *service) Create(ctx context.Context, endereco models.Endereco) (models.Endereco, error) {
+// Create cadastra o endereço normalizado, recusando endereços já
+// cadastrados. Com preencher, os campos vazios são completados pela base de
+// CEPs e as divergências retornam nos avisos do endereço.
+func (s *service) Create(ctx context.Context, endereco models.Endereco, preencher bool) (models.Endereco, error) {
+	if preencher {
+		var err error
+
+		endereco, err = s.CEPService.Preencher(ctx, endereco)
+		if err != nil {
+			return models.Endereco{}, err
+		}
+	}
+
+	endereco = Normalizar(endereco)
+
+	if err := s.validarChave(ctx, endereco); err != nil {
+		return models.Endereco{}, err
+	}
+
 	return s.repository.Create(ctx, endereco)
 }
 
@@ -37,10 +76,152 @@ func (s *service) FindByID(ctx context.Context, id string) (models.Endereco, err
 	return s.repository.FindByID(ctx, id)
 }
 
-func (s *service) Update(ctx context.Context, endereco models.Endereco) error {
-	return s.repository.Update(ctx, endereco)
+// Update atualiza o endereço normalizado, recusando a alteração que o torne
+// igual a outro endereço cadastrado.
+func (s *service) Update(ctx context.Context, endereco models.Endereco) (models.Endereco, error) {
+	endereco = Normalizar(endereco)
+
+	if err := s.validarChave(ctx, endereco); err != nil {
+		return models.Endereco{}, err
+	}
+
+	return endereco, s.repository.Update(ctx, endereco)
 }
 
 func (s *service) Delete(ctx context.Context, id string) error {
 	return s.repository.Delete(ctx, id)
 }
+
+// Duplicados retorna os grupos de endereços possivelmente duplicados, com o
+// mesmo logradouro, número, cidade e estado depois de normalizados, cada um
+// com as empresas vinculadas.
+func (s *service) Duplicados(ctx context.Context) ([]models.DuplicidadeEndereco, error) {
+	enderecos, err := s.repository.FindAll(ctx, "", "", "", "", "", "", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	grupos := map[string][]models.Endereco{}
+
+	for _, endereco := range enderecos {
+		chave := chaveDuplicidade(endereco)
+		grupos[chave] = append(grupos[chave], endereco)
+	}
+
+	duplicidades := []models.DuplicidadeEndereco{}
+
+	for chave, grupo := range grupos {
+		if len(grupo) < 2 {
+			continue
+		}
+
+		slices.SortFunc(grupo, func(a, b models.Endereco) int {
+			return cmp.Compare(a.ID, b.ID)
+		})
+
+		duplicidades = append(duplicidades, models.DuplicidadeEndereco{
+			Chave:     chave,
+			Enderecos: grupo,
+		})
+	}
+
+	slices.SortFunc(duplicidades, func(a, b models.DuplicidadeEndereco) int {
+		return strings.Compare(a.Chave, b.Chave)
+	})
+
+	return duplicidades, nil
+}
+
+// Mesclar mescla os endereços duplicados no endereço informado: os vínculos
+// com empresas e as entrevistas passam para ele e os duplicados são apagados.
+func (s *service) Mesclar(ctx context.Context, id string, mesclagem models.MesclagemEndereco) (models.Endereco, error) {
+	endereco, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.Endereco{}, err
+	}
+
+	if endereco.ID == 0 {
+		return models.Endereco{}, errors.New(ERROR_ENDERECO_NOT_FOUND)
+	}
+
+	slices.Sort(mesclagem.Duplicados)
+	mesclagem.Duplicados = slices.Compact(mesclagem.Duplicados)
+
+	for _, duplicado := range mesclagem.Duplicados {
+		if duplicado == endereco.ID {
+			return models.Endereco{}, errors.New(ERROR_MESCLAR_NELE_MESMO)
+		}
+
+		existente, err := s.repository.FindByID(ctx, fmt.Sprint(duplicado))
+		if err != nil {
+			return models.Endereco{}, err
+		}
+
+		if existente.ID == 0 {
+			return models.Endereco{}, fmt.Errorf(ERROR_DUPLICADO_NOT_FOUND, duplicado)
+		}
+	}
+
+	if err := s.repository.Mesclar(ctx, endereco.ID, mesclagem.Duplicados, time.Now()); err != nil {
+		return models.Endereco{}, err
+	}
+
+	return s.repository.FindByID(ctx, id)
+}
+
+// validarChave recusa o endereço igual, depois de normalizado, a outro já
+// cadastrado.
+func (s *service) validarChave(ctx context.Context, endereco models.Endereco) error {
+	existente, err := s.repository.FindByChave(ctx, endereco.Chave)
+	if err != nil {
+		return err
+	}
+
+	if existente.ID != 0 && existente.ID != endereco.ID {
+		return fmt.Errorf(ERROR_ENDERECO_CADASTRADO, existente.ID)
+	}
+
+	return nil
+}
+
+// NormalizarCadastrados normaliza os endereços não apagados e grava a chave
+// de cada um, para os endereços cadastrados antes da chave existir. Quando
+// dois endereços têm a mesma chave, ela fica com o de menor ID e o outro fica
+// sem chave até ser mesclado pelo relatório de duplicados.
+func (s *service) NormalizarCadastrados(ctx context.Context) (models.NormalizacaoEnderecos, error) {
+	normalizacao := models.NormalizacaoEnderecos{
+		Colisoes: []string{},
+	}
+
+	enderecos, err := s.repository.FindAll(ctx, "", "", "", "", "", "", "", "")
+	if err != nil {
+		return normalizacao, err
+	}
+
+	slices.SortFunc(enderecos, func(a, b models.Endereco) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	chaves := map[string]int64{}
+
+	for i := range enderecos {
+		enderecos[i] = Normalizar(enderecos[i])
+
+		if id, ok := chaves[enderecos[i].Chave]; ok {
+			normalizacao.Colisoes = append(normalizacao.Colisoes, fmt.Sprintf(AVISO_COLISAO_CHAVE, enderecos[i].ID, id))
+			enderecos[i].Chave = ""
+			continue
+		}
+
+		chaves[enderecos[i].Chave] = enderecos[i].ID
+	}
+
+	if err := s.repository.Normalizar(ctx, enderecos, time.Now()); err != nil {
+		return normalizacao, err
+	}
+
+	normalizacao.Enderecos = len(enderecos)
+	normalizacao.Chaves = len(chaves)
+
+	return normalizacao, nil
+}
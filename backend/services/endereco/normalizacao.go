@@ -0,0 +1,125 @@
+package endereco
+
+import (
+	"strings"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/cep"
+	"tsukuyomi/services/texto"
+)
+
+// tiposLogradouro associa as abreviações usuais do tipo de logradouro,
+// normalizadas, ao nome por extenso.
+var tiposLogradouro = map[string]string{
+	"r":    "Rua",
+	"av":   "Avenida",
+	"avn":  "Avenida",
+	"al":   "Alameda",
+	"tv":   "Travessa",
+	"trav": "Travessa",
+	"pc":   "Praça",
+	"pca":  "Praça",
+	"rod":  "Rodovia",
+	"estr": "Estrada",
+	"est":  "Estrada",
+	"lgo":  "Largo",
+	"lg":   "Largo",
+	"pq":   "Parque",
+	"vl":   "Vila",
+}
+
+// Normalizar padroniza o endereço antes de gravá-lo: remove espaços
+// repetidos, escreve por extenso o tipo de logradouro abreviado, como "R." e
+// "Av.", padroniza os números sem número como S/N, formata o CEP e troca o
+// estado por extenso pela sigla. Também calcula a chave do endereço, com o
+// endereço completo sem acentos e em minúsculas.
+func Normalizar(endereco models.Endereco) models.Endereco {
+	endereco.Logradouro = logradouro(endereco.Logradouro)
+	endereco.Numero = strings.ToUpper(espacos(endereco.Numero))
+	endereco.Bairro = espacos(endereco.Bairro)
+	endereco.Cidade = espacos(endereco.Cidade)
+	endereco.CEP = cep.Formatar(espacos(endereco.CEP))
+	endereco.Estado = estado(endereco.Estado)
+
+	switch texto.Normalizar(endereco.Numero) {
+	case "sn", "s n", "s numero", "sem numero":
+		endereco.Numero = "S/N"
+	}
+
+	if endereco.Complemento != nil {
+		complemento := espacos(*endereco.Complemento)
+
+		endereco.Complemento = nil
+		if complemento != "" {
+			endereco.Complemento = &complemento
+		}
+	}
+
+	complemento := ""
+	if endereco.Complemento != nil {
+		complemento = *endereco.Complemento
+	}
+
+	endereco.Chave = strings.Join([]string{
+		texto.Normalizar(endereco.Logradouro),
+		texto.Normalizar(endereco.Numero),
+		texto.Normalizar(complemento),
+		texto.Digitos(endereco.CEP),
+		texto.Normalizar(endereco.Cidade),
+		texto.Normalizar(endereco.Estado),
+	}, "|")
+
+	return endereco
+}
+
+// chaveDuplicidade identifica os endereços possivelmente duplicados, com o
+// mesmo logradouro, número, cidade e estado, ainda que o complemento, o
+// bairro ou o CEP tenham sido informados de formas diferentes.
+func chaveDuplicidade(endereco models.Endereco) string {
+	endereco = Normalizar(endereco)
+
+	return strings.Join([]string{
+		texto.Normalizar(endereco.Logradouro),
+		texto.Normalizar(endereco.Numero),
+		texto.Normalizar(endereco.Cidade),
+		texto.Normalizar(endereco.Estado),
+	}, "|")
+}
+
+func logradouro(valor string) string {
+	palavras := strings.Fields(valor)
+	if len(palavras) == 0 {
+		return ""
+	}
+
+	if tipo, ok := tiposLogradouro[texto.Normalizar(palavras[0])]; ok {
+		palavras[0] = tipo
+	} else if ponto := strings.Index(palavras[0], "."); ponto > 0 && ponto < len(palavras[0])-1 {
+		// Abreviação colada no nome, como "R.Augusta".
+		if tipo, ok := tiposLogradouro[texto.Normalizar(palavras[0][:ponto])]; ok {
+			palavras = append([]string{tipo, palavras[0][ponto+1:]}, palavras[1:]...)
+		}
+	}
+
+	return strings.Join(palavras, " ")
+}
+
+func estado(valor string) string {
+	valor = espacos(valor)
+
+	if len(valor) == 2 {
+		return strings.ToUpper(valor)
+	}
+
+	for sigla, nome := range cep.Estados {
+		if texto.Normalizar(nome) == texto.Normalizar(valor) {
+			return sigla
+		}
+	}
+
+	return valor
+}
+
+func espacos(valor string) string {
+	return strings.Join(strings.Fields(valor), " ")
+}
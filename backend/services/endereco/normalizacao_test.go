@@ -0,0 +1,61 @@
+package endereco
+
+import (
+	"testing"
+
+	"tsukuyomi/models"
+)
+
+func TestNormalizar(t *testing.T) {
+	complemento := func(valor string) *string {
+		return &valor
+	}
+
+	casos := []struct {
+		nome     string
+		endereco models.Endereco
+		esperado models.Endereco
+	}{
+		{
+			nome:     "abreviação com ponto e estado por extenso",
+			endereco: models.Endereco{Logradouro: "R. Augusta", Numero: "100", Bairro: "Consolação", Cidade: "São Paulo", CEP: "01305000", Estado: "são paulo"},
+			esperado: models.Endereco{Logradouro: "Rua Augusta", Numero: "100", Bairro: "Consolação", Cidade: "São Paulo", CEP: "01305-000", Estado: "SP", Chave: "rua augusta|100||01305000|sao paulo|sp"},
+		},
+		{
+			nome:     "espaços repetidos, sem número e complemento",
+			endereco: models.Endereco{Logradouro: "  Av   Paulista ", Numero: "s/n", Complemento: complemento(" Sala  10 "), Cidade: "São  Paulo", CEP: "01310-100", Estado: "sp"},
+			esperado: models.Endereco{Logradouro: "Avenida Paulista", Numero: "S/N", Complemento: complemento("Sala 10"), Cidade: "São Paulo", CEP: "01310-100", Estado: "SP", Chave: "avenida paulista|s n|sala 10|01310100|sao paulo|sp"},
+		},
+		{
+			nome:     "abreviação colada no nome",
+			endereco: models.Endereco{Logradouro: "R.Augusta", Numero: "sem número", Complemento: complemento("   "), Cidade: "Porto Alegre", CEP: "90000-000", Estado: "Rio Grande do Sul"},
+			esperado: models.Endereco{Logradouro: "Rua Augusta", Numero: "S/N", Cidade: "Porto Alegre", CEP: "90000-000", Estado: "RS", Chave: "rua augusta|s n||90000000|porto alegre|rs"},
+		},
+		{
+			nome:     "tipo por extenso e número com letra",
+			endereco: models.Endereco{Logradouro: "Rodovia Anhanguera", Numero: "10b", Cidade: "Jundiaí", CEP: "123", Estado: "Xyz"},
+			esperado: models.Endereco{Logradouro: "Rodovia Anhanguera", Numero: "10B", Cidade: "Jundiaí", CEP: "123", Estado: "Xyz", Chave: "rodovia anhanguera|10b||123|jundiai|xyz"},
+		},
+		{
+			nome:     "abreviação sem ponto",
+			endereco: models.Endereco{Logradouro: "rod Anhanguera km 10", Numero: "S N", Cidade: "Jundiaí", Estado: "SP"},
+			esperado: models.Endereco{Logradouro: "Rodovia Anhanguera km 10", Numero: "S/N", Cidade: "Jundiaí", Estado: "SP", Chave: "rodovia anhanguera km 10|s n|||jundiai|sp"},
+		},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			obtido := Normalizar(caso.endereco)
+
+			if obtido.Logradouro != caso.esperado.Logradouro || obtido.Numero != caso.esperado.Numero || obtido.Cidade != caso.esperado.Cidade ||
+				obtido.CEP != caso.esperado.CEP || obtido.Estado != caso.esperado.Estado || obtido.Chave != caso.esperado.Chave {
+				t.Errorf("Normalizar = %+v, esperado %+v", obtido, caso.esperado)
+			}
+
+			if (obtido.Complemento == nil) != (caso.esperado.Complemento == nil) ||
+				(obtido.Complemento != nil && *obtido.Complemento != *caso.esperado.Complemento) {
+				t.Errorf("complemento = %v, esperado %v", obtido.Complemento, caso.esperado.Complemento)
+			}
+		})
+	}
+}
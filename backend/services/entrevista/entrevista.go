@@ -0,0 +1,183 @@
+package entrevista
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/candidatura"
+	contatoEmpresa "tsukuyomi/repositories/contato_empresa"
+	"tsukuyomi/repositories/endereco"
+	"tsukuyomi/repositories/entrevista"
+)
+
+const (
+	ERROR_CANDIDATURA_NOT_FOUND = "candidatura não encontrada"
+	ERROR_ENDERECO_NOT_FOUND    = "endereço não encontrado"
+	ERROR_CONTATO_NOT_FOUND     = "contato %d não encontrado"
+	ERROR_CONTATO_OUTRA_EMPRESA = "o contato %d não pertence à empresa da candidatura"
+)
+
+type Service interface {
+	Create(ctx context.Context, entrevista models.Entrevista) (models.Entrevista, error)
+	FindByCandidatura(ctx context.Context, id_candidatura string) ([]models.Entrevista, error)
+	FindByID(ctx context.Context, id_candidatura, id string) (models.Entrevista, error)
+	FindByPeriodo(ctx context.Context, inicio, fim time.Time) ([]models.Entrevista, error)
+	Update(ctx context.Context, entrevista models.Entrevista) error
+	Delete(ctx context.Context, id_candidatura, id string) error
+}
+
+type service struct {
+	repository            entrevista.Repository
+	CandidaturaRepository candidatura.Repository
+	ContatoRepository     contatoEmpresa.Repository
+	EnderecoRepository    endereco.Repository
+}
+
+func NewService(repository entrevista.Repository, candidaturaRepository candidatura.Repository, contatoRepository contatoEmpresa.Repository, enderecoRepository endereco.Repository) Service {
+	return &service{
+		repository:            repository,
+		CandidaturaRepository: candidaturaRepository,
+		ContatoRepository:     contatoRepository,
+		EnderecoRepository:    enderecoRepository,
+	}
+}
+
+func (s *service) Create(ctx context.Context, entrevista models.Entrevista) (models.Entrevista, error) {
+	if err := s.validar(ctx, &entrevista); err != nil {
+		return models.Entrevista{}, err
+	}
+
+	return s.repository.Create(ctx, entrevista)
+}
+
+func (s *service) FindByCandidatura(ctx context.Context, id_candidatura string) ([]models.Entrevista, error) {
+	entrevistas, err := s.repository.FindByCandidatura(ctx, id_candidatura)
+	if err != nil {
+		return []models.Entrevista{}, err
+	}
+
+	for i := range entrevistas {
+		if err := s.carregarEndereco(ctx, &entrevistas[i]); err != nil {
+			return []models.Entrevista{}, err
+		}
+	}
+
+	return entrevistas, nil
+}
+
+// FindByID retorna a entrevista com o endereço, quando presencial, e os
+// entrevistadores.
+func (s *service) FindByID(ctx context.Context, id_candidatura, id string) (models.Entrevista, error) {
+	entrevista, err := s.repository.FindByID(ctx, id_candidatura, id)
+	if err != nil || entrevista.ID == 0 {
+		return entrevista, err
+	}
+
+	if err := s.carregarEndereco(ctx, &entrevista); err != nil {
+		return models.Entrevista{}, err
+	}
+
+	return entrevista, nil
+}
+
+// FindByPeriodo retorna as entrevistas de todas as candidaturas marcadas entre
+// os horários informados, com o endereço das presenciais.
+func (s *service) FindByPeriodo(ctx context.Context, inicio, fim time.Time) ([]models.Entrevista, error) {
+	entrevistas, err := s.repository.FindByPeriodo(ctx, inicio, fim)
+	if err != nil {
+		return []models.Entrevista{}, err
+	}
+
+	for i := range entrevistas {
+		if err := s.carregarEndereco(ctx, &entrevistas[i]); err != nil {
+			return []models.Entrevista{}, err
+		}
+	}
+
+	return entrevistas, nil
+}
+
+func (s *service) Update(ctx context.Context, entrevista models.Entrevista) error {
+	if err := s.validar(ctx, &entrevista); err != nil {
+		return err
+	}
+
+	return s.repository.Update(ctx, entrevista)
+}
+
+func (s *service) Delete(ctx context.Context, id_candidatura, id string) error {
+	return s.repository.Delete(ctx, id_candidatura, id)
+}
+
+// validar confere que a candidatura e o endereço existem e que os
+// entrevistadores são contatos da empresa da candidatura, descartando os
+// repetidos.
+func (s *service) validar(ctx context.Context, entrevista *models.Entrevista) error {
+	candidatura, err := s.CandidaturaRepository.FindByID(ctx, fmt.Sprint(entrevista.IDCandidatura))
+	if err != nil {
+		return err
+	}
+
+	if candidatura.ID == 0 {
+		return errors.New(ERROR_CANDIDATURA_NOT_FOUND)
+	}
+
+	if entrevista.IDEndereco != nil {
+		endereco, err := s.EnderecoRepository.FindByID(ctx, fmt.Sprint(*entrevista.IDEndereco))
+		if err != nil {
+			return err
+		}
+
+		if endereco.ID == 0 {
+			return errors.New(ERROR_ENDERECO_NOT_FOUND)
+		}
+	}
+
+	entrevistadores := []int64{}
+
+	for _, id_contato := range entrevista.IDEntrevistadores {
+		if slices.Contains(entrevistadores, id_contato) {
+			continue
+		}
+
+		contato, err := s.ContatoRepository.FindByID(ctx, fmt.Sprint(id_contato))
+		if err != nil {
+			return err
+		}
+
+		if contato.ID == 0 {
+			return fmt.Errorf(ERROR_CONTATO_NOT_FOUND, id_contato)
+		}
+
+		if contato.Empresa.ID != candidatura.IDEmpresa {
+			return fmt.Errorf(ERROR_CONTATO_OUTRA_EMPRESA, id_contato)
+		}
+
+		entrevistadores = append(entrevistadores, id_contato)
+	}
+
+	entrevista.IDEntrevistadores = entrevistadores
+
+	return nil
+}
+
+func (s *service) carregarEndereco(ctx context.Context, entrevista *models.Entrevista) error {
+	if entrevista.IDEndereco == nil {
+		return nil
+	}
+
+	endereco, err := s.EnderecoRepository.FindByID(ctx, fmt.Sprint(*entrevista.IDEndereco))
+	if err != nil {
+		return err
+	}
+
+	if endereco.ID != 0 {
+		entrevista.Endereco = &endereco
+	}
+
+	return nil
+}
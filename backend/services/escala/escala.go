@@ -0,0 +1,232 @@
+package escala
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/ausencia"
+	"tsukuyomi/repositories/emprego"
+	"tsukuyomi/repositories/empresa"
+	"tsukuyomi/repositories/escala"
+	"tsukuyomi/services/datas"
+	feriadoService "tsukuyomi/services/feriado"
+)
+
+const (
+	ERROR_EMPREGO_NOT_FOUND = "emprego não encontrado"
+)
+
+type Service interface {
+	Create(ctx context.Context, escala models.Escala) (models.Escala, error)
+	FindByEmprego(ctx context.Context, id_emprego string) ([]models.Escala, error)
+	Delete(ctx context.Context, id_emprego, id string) error
+	Previsto(ctx context.Context, id_emprego string, inicio, fim time.Time) ([]models.JornadaPrevista, error)
+}
+
+type service struct {
+	repository         escala.Repository
+	EmpregoRepository  emprego.Repository
+	EmpresaRepository  empresa.Repository
+	AusenciaRepository ausencia.Repository
+	FeriadoService     feriadoService.Service
+}
+
+func NewService(repository escala.Repository, empregoRepository emprego.Repository, empresaRepository empresa.Repository, ausenciaRepository ausencia.Repository, feriadoService feriadoService.Service) Service {
+	return &service{
+		repository:         repository,
+		EmpregoRepository:  empregoRepository,
+		EmpresaRepository:  empresaRepository,
+		AusenciaRepository: ausenciaRepository,
+		FeriadoService:     feriadoService,
+	}
+}
+
+func (s *service) Create(ctx context.Context, escala models.Escala) (models.Escala, error) {
+	return s.repository.Create(ctx, escala)
+}
+
+func (s *service) FindByEmprego(ctx context.Context, id_emprego string) ([]models.Escala, error) {
+	return s.repository.FindByEmprego(ctx, id_emprego)
+}
+
+func (s *service) Delete(ctx context.Context, id_emprego, id string) error {
+	return s.repository.Delete(ctx, id_emprego, id)
+}
+
+// Previsto retorna a jornada prevista de cada dia do período para o emprego,
+// considerando as escalas vigentes, os feriados da localidade da empresa e as
+// ausências registradas.
+func (s *service) Previsto(ctx context.Context, id_emprego string, inicio, fim time.Time) ([]models.JornadaPrevista, error) {
+	emprego, err := s.EmpregoRepository.FindByID(ctx, id_emprego)
+	if err != nil {
+		return []models.JornadaPrevista{}, err
+	}
+
+	if emprego.ID == 0 {
+		return []models.JornadaPrevista{}, errors.New(ERROR_EMPREGO_NOT_FOUND)
+	}
+
+	escalas, err := s.repository.FindByEmprego(ctx, id_emprego)
+	if err != nil {
+		return []models.JornadaPrevista{}, err
+	}
+
+	empresa, err := s.EmpresaRepository.FindByID(ctx, fmt.Sprint(emprego.Empresa.ID))
+	if err != nil {
+		return []models.JornadaPrevista{}, err
+	}
+
+	uf, cidade := Localidade(empresa)
+
+	// A semana anterior ao início é necessária para saber se o descanso
+	// semanal do primeiro domingo do período foi perdido.
+	semanaAnterior := inicio.AddDate(0, 0, -6)
+
+	feriados, err := s.FeriadoService.FindByPeriodo(ctx, semanaAnterior, fim, uf, cidade)
+	if err != nil {
+		return []models.JornadaPrevista{}, err
+	}
+
+	ausencias, err := s.AusenciaRepository.FindByPeriodo(ctx, id_emprego, semanaAnterior, fim)
+	if err != nil {
+		return []models.JornadaPrevista{}, err
+	}
+
+	porData := feriadoService.Mapear(feriados)
+
+	var jornadas []models.JornadaPrevista
+
+	for dia := semanaAnterior; !dia.After(fim); dia = dia.AddDate(0, 0, 1) {
+		jornadas = append(jornadas, Jornada(emprego, escalas, porData, dia))
+	}
+
+	AplicarAusencias(jornadas, ausencias)
+
+	return jornadas[6:], nil
+}
+
+// Jornada calcula a jornada prevista de um dia. Todo cálculo de horas
+// previstas deve passar por aqui: a escala vigente no dia é a de data de
+// início mais recente que não seja posterior a ele, e, na ausência de escalas,
+// a carga horária do emprego é tratada como jornada diária de segunda a sexta.
+// Feriados zeram a jornada, exceto em escalas de revezamento, que seguem o
+// ciclo independentemente do calendário.
+func Jornada(emprego models.Emprego, escalas []models.Escala, feriados map[string]models.Feriado, dia time.Time) models.JornadaPrevista {
+	dia = datas.Dia(dia)
+
+	jornada := models.JornadaPrevista{
+		Data: dia,
+	}
+
+	if dia.Before(datas.Dia(emprego.DataInicio)) || (emprego.DataFim != nil && dia.After(datas.Dia(*emprego.DataFim))) {
+		return jornada
+	}
+
+	vigente := Vigente(escalas, dia)
+
+	if vigente != nil {
+		jornada.IDEscala = &vigente.ID
+		jornada.Minutos = vigente.MinutosPrevistos(dia)
+		jornada.Intervalo = vigente.Intervalo
+	} else if dia.Weekday() != time.Saturday && dia.Weekday() != time.Sunday {
+		jornada.Minutos = emprego.CargaHoraria
+	}
+
+	if feriado, ok := feriados[feriadoService.Chave(dia)]; ok {
+		jornada.Feriado = &feriado.Nome
+
+		if vigente == nil || vigente.Tipo == models.ESCALA_SEMANAL {
+			jornada.Minutos = 0
+		}
+	}
+
+	if jornada.Minutos == 0 {
+		jornada.Intervalo = 0
+	}
+
+	return jornada
+}
+
+// AplicarAusencias ajusta as jornadas de dias consecutivos às ausências. Dias
+// de ausência justificada têm a jornada abonada e ficam neutros no saldo; dias
+// de falta injustificada mantêm a jornada e, sem trabalho, viram débito. Uma
+// falta injustificada de segunda a sábado faz perder o descanso semanal
+// remunerado (Lei 605/1949, art. 6º), descontado no domingo seguinte pela
+// média diária prevista na semana.
+func AplicarAusencias(jornadas []models.JornadaPrevista, ausencias []models.Ausencia) {
+	for i := range jornadas {
+		for _, ausencia := range ausencias {
+			if !ausencia.Abrange(jornadas[i].Data) {
+				continue
+			}
+
+			tipo := ausencia.Tipo
+			jornadas[i].Ausencia = &tipo
+
+			if ausencia.Justificada() {
+				jornadas[i].Abonado = jornadas[i].Minutos
+				jornadas[i].Minutos = 0
+				jornadas[i].Intervalo = 0
+			}
+
+			break
+		}
+	}
+
+	for i := 6; i < len(jornadas); i++ {
+		if jornadas[i].Data.Weekday() != time.Sunday {
+			continue
+		}
+
+		faltou := false
+		previsto := int64(0)
+		diasPrevistos := int64(0)
+
+		for _, dia := range jornadas[i-6 : i] {
+			if dia.Ausencia != nil && *dia.Ausencia == models.AUSENCIA_FALTA_INJUSTIFICADA {
+				faltou = true
+			}
+
+			if minutos := dia.Minutos + dia.Abonado; minutos > 0 {
+				previsto += minutos
+				diasPrevistos++
+			}
+		}
+
+		if !faltou || diasPrevistos == 0 {
+			continue
+		}
+
+		jornadas[i].DescontoDSR = previsto / diasPrevistos
+		jornadas[i].Minutos += jornadas[i].DescontoDSR
+	}
+}
+
+// Vigente retorna a escala em vigor no dia, ou nil se nenhuma escala havia
+// começado. As escalas devem estar ordenadas pela data de início.
+func Vigente(escalas []models.Escala, dia time.Time) *models.Escala {
+	var vigente *models.Escala
+
+	for i := range escalas {
+		if datas.Dia(escalas[i].DataInicio).After(dia) {
+			break
+		}
+
+		vigente = &escalas[i]
+	}
+
+	return vigente
+}
+
+// Localidade retorna o estado e a cidade do primeiro endereço da empresa, usados
+// para determinar os feriados locais.
+func Localidade(empresa models.Empresa) (string, string) {
+	if len(empresa.Enderecos) == 0 {
+		return "", ""
+	}
+
+	return empresa.Enderecos[0].Estado, empresa.Enderecos[0].Cidade
+}
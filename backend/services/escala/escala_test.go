@@ -0,0 +1,130 @@
+package escala
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"tsukuyomi/models"
+)
+
+func dia(d int) time.Time {
+	return time.Date(2026, time.March, d, 0, 0, 0, 0, time.Local)
+}
+
+// semana monta as jornadas de segunda, 2 de março de 2026, a domingo, 8 de
+// março: 8h de segunda a sexta, 4h no sábado e folga no domingo.
+func semana() []models.JornadaPrevista {
+	jornadas := make([]models.JornadaPrevista, 7)
+
+	for i := range jornadas {
+		jornadas[i] = models.JornadaPrevista{Data: dia(2 + i), Minutos: 480, Intervalo: 60}
+	}
+
+	jornadas[5].Minutos, jornadas[5].Intervalo = 240, 0
+	jornadas[6].Minutos, jornadas[6].Intervalo = 0, 0
+
+	return jornadas
+}
+
+func TestAplicarAusencias(t *testing.T) {
+	casos := []struct {
+		nome      string
+		ausencias []models.Ausencia
+		minutos   []int64
+		abonado   []int64
+		desconto  int64
+		ausentes  []string
+	}{
+		{
+			nome:     "sem ausências",
+			minutos:  []int64{480, 480, 480, 480, 480, 240, 0},
+			abonado:  []int64{0, 0, 0, 0, 0, 0, 0},
+			ausentes: []string{"", "", "", "", "", "", ""},
+		},
+		{
+			nome: "atestado abona a jornada",
+			ausencias: []models.Ausencia{
+				{Tipo: models.AUSENCIA_ATESTADO_MEDICO, DataInicio: dia(4), DataFim: dia(5)},
+			},
+			minutos:  []int64{480, 480, 0, 0, 480, 240, 0},
+			abonado:  []int64{0, 0, 480, 480, 0, 0, 0},
+			ausentes: []string{"", "", models.AUSENCIA_ATESTADO_MEDICO, models.AUSENCIA_ATESTADO_MEDICO, "", "", ""},
+		},
+		{
+			nome: "falta injustificada desconta o descanso semanal",
+			ausencias: []models.Ausencia{
+				{Tipo: models.AUSENCIA_FALTA_INJUSTIFICADA, DataInicio: dia(4), DataFim: dia(4)},
+			},
+			minutos:  []int64{480, 480, 480, 480, 480, 240, 440},
+			abonado:  []int64{0, 0, 0, 0, 0, 0, 0},
+			desconto: 440,
+			ausentes: []string{"", "", models.AUSENCIA_FALTA_INJUSTIFICADA, "", "", "", ""},
+		},
+		{
+			nome: "dias abonados entram na média do desconto",
+			ausencias: []models.Ausencia{
+				{Tipo: models.AUSENCIA_FALTA_INJUSTIFICADA, DataInicio: dia(2), DataFim: dia(2)},
+				{Tipo: models.AUSENCIA_LUTO, DataInicio: dia(3), DataFim: dia(4)},
+			},
+			minutos:  []int64{480, 0, 0, 480, 480, 240, 440},
+			abonado:  []int64{0, 480, 480, 0, 0, 0, 0},
+			desconto: 440,
+			ausentes: []string{models.AUSENCIA_FALTA_INJUSTIFICADA, models.AUSENCIA_LUTO, models.AUSENCIA_LUTO, "", "", "", ""},
+		},
+		{
+			nome: "falta no domingo não desconta o próprio descanso",
+			ausencias: []models.Ausencia{
+				{Tipo: models.AUSENCIA_FALTA_INJUSTIFICADA, DataInicio: dia(8), DataFim: dia(8)},
+			},
+			minutos:  []int64{480, 480, 480, 480, 480, 240, 0},
+			abonado:  []int64{0, 0, 0, 0, 0, 0, 0},
+			ausentes: []string{"", "", "", "", "", "", models.AUSENCIA_FALTA_INJUSTIFICADA},
+		},
+		{
+			nome: "ausência fora do período",
+			ausencias: []models.Ausencia{
+				{Tipo: models.AUSENCIA_FALTA_INJUSTIFICADA, DataInicio: dia(1), DataFim: dia(1)},
+			},
+			minutos:  []int64{480, 480, 480, 480, 480, 240, 0},
+			abonado:  []int64{0, 0, 0, 0, 0, 0, 0},
+			ausentes: []string{"", "", "", "", "", "", ""},
+		},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			jornadas := semana()
+			AplicarAusencias(jornadas, caso.ausencias)
+
+			minutos := make([]int64, len(jornadas))
+			abonado := make([]int64, len(jornadas))
+			ausentes := make([]string, len(jornadas))
+
+			for i, jornada := range jornadas {
+				minutos[i] = jornada.Minutos
+				abonado[i] = jornada.Abonado
+
+				if jornada.Ausencia != nil {
+					ausentes[i] = *jornada.Ausencia
+				}
+			}
+
+			if !reflect.DeepEqual(minutos, caso.minutos) {
+				t.Errorf("minutos = %v, esperado %v", minutos, caso.minutos)
+			}
+
+			if !reflect.DeepEqual(abonado, caso.abonado) {
+				t.Errorf("abonado = %v, esperado %v", abonado, caso.abonado)
+			}
+
+			if !reflect.DeepEqual(ausentes, caso.ausentes) {
+				t.Errorf("ausências = %v, esperado %v", ausentes, caso.ausentes)
+			}
+
+			if desconto := jornadas[6].DescontoDSR; desconto != caso.desconto {
+				t.Errorf("desconto do DSR = %d, esperado %d", desconto, caso.desconto)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package feriado
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/feriado"
+	"tsukuyomi/services/datas"
+)
+
+type Service interface {
+	Create(ctx context.Context, feriado models.Feriado) (models.Feriado, error)
+	FindAll(ctx context.Context, ano int, uf, cidade string) ([]models.Feriado, error)
+	FindByID(ctx context.Context, id string) (models.Feriado, error)
+	Delete(ctx context.Context, id string) error
+	FindByPeriodo(ctx context.Context, inicio, fim time.Time, uf, cidade string) ([]models.Feriado, error)
+	DiasUteis(ctx context.Context, inicio, fim time.Time, uf, cidade string) (models.DiasUteis, error)
+}
+
+type service struct {
+	repository feriado.Repository
+}
+
+func NewService(repository feriado.Repository) Service {
+	return &service{
+		repository: repository,
+	}
+}
+
+func (s *service) Create(ctx context.Context, feriado models.Feriado) (models.Feriado, error) {
+	return s.repository.Create(ctx, feriado)
+}
+
+// FindAll retorna os feriados nacionais do ano somados aos feriados estaduais e
+// municipais cadastrados para a localidade, ordenados por data.
+func (s *service) FindAll(ctx context.Context, ano int, uf, cidade string) ([]models.Feriado, error) {
+	locais, err := s.repository.FindAll(ctx, ano, uf, cidade)
+	if err != nil {
+		return []models.Feriado{}, err
+	}
+
+	feriados := Nacionais(ano)
+
+	for _, local := range locais {
+		if local.Recorrente {
+			local.Data = time.Date(ano, local.Data.Month(), local.Data.Day(), 0, 0, 0, 0, time.Local)
+		}
+
+		feriados = append(feriados, local)
+	}
+
+	sort.SliceStable(feriados, func(i, j int) bool {
+		return feriados[i].Data.Before(feriados[j].Data)
+	})
+
+	return feriados, nil
+}
+
+func (s *service) FindByID(ctx context.Context, id string) (models.Feriado, error) {
+	return s.repository.FindByID(ctx, id)
+}
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	return s.repository.Delete(ctx, id)
+}
+
+// FindByPeriodo retorna os feriados entre as datas informadas, inclusive,
+// mesmo quando o período abrange mais de um ano.
+func (s *service) FindByPeriodo(ctx context.Context, inicio, fim time.Time, uf, cidade string) ([]models.Feriado, error) {
+	inicio = datas.Dia(inicio)
+	fim = datas.Dia(fim)
+
+	var feriados []models.Feriado
+
+	for ano := inicio.Year(); ano <= fim.Year(); ano++ {
+		doAno, err := s.FindAll(ctx, ano, uf, cidade)
+		if err != nil {
+			return []models.Feriado{}, err
+		}
+
+		for _, feriado := range doAno {
+			data := datas.Dia(feriado.Data)
+			if data.Before(inicio) || data.After(fim) {
+				continue
+			}
+
+			feriados = append(feriados, feriado)
+		}
+	}
+
+	return feriados, nil
+}
+
+// DiasUteis conta os dias de segunda a sexta entre as datas informadas,
+// inclusive, desconsiderando os feriados da localidade.
+func (s *service) DiasUteis(ctx context.Context, inicio, fim time.Time, uf, cidade string) (models.DiasUteis, error) {
+	inicio = datas.Dia(inicio)
+	fim = datas.Dia(fim)
+
+	feriados, err := s.FindByPeriodo(ctx, inicio, fim, uf, cidade)
+	if err != nil {
+		return models.DiasUteis{}, err
+	}
+
+	porData := Mapear(feriados)
+
+	resultado := models.DiasUteis{
+		Inicio:   inicio,
+		Fim:      fim,
+		Feriados: []models.Feriado{},
+	}
+
+	for dia := inicio; !dia.After(fim); dia = dia.AddDate(0, 0, 1) {
+		if dia.Weekday() == time.Saturday || dia.Weekday() == time.Sunday {
+			continue
+		}
+
+		if feriado, ok := porData[Chave(dia)]; ok {
+			resultado.Feriados = append(resultado.Feriados, feriado)
+			continue
+		}
+
+		resultado.DiasUteis++
+	}
+
+	return resultado, nil
+}
+
+// Pascoa calcula o domingo de Páscoa do ano informado pelo algoritmo de
+// Meeus/Jones/Butcher para o calendário gregoriano.
+func Pascoa(ano int) time.Time {
+	a := ano % 19
+	b := ano / 100
+	c := ano % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	mes := (h + l - 7*m + 114) / 31
+	dia := (h+l-7*m+114)%31 + 1
+
+	return time.Date(ano, time.Month(mes), dia, 0, 0, 0, 0, time.Local)
+}
+
+// Nacionais gera os feriados nacionais do ano, incluindo os móveis derivados
+// da Páscoa. Carnaval e Corpus Christi são pontos facultativos, mas são
+// retornados marcados como tal por serem observados pela maioria dos
+// empregadores.
+func Nacionais(ano int) []models.Feriado {
+	pascoa := Pascoa(ano)
+
+	fixo := func(mes time.Month, dia int, nome string) models.Feriado {
+		return models.Feriado{
+			Data:        time.Date(ano, mes, dia, 0, 0, 0, 0, time.Local),
+			Nome:        nome,
+			Abrangencia: models.ABRANGENCIA_NACIONAL,
+			Recorrente:  true,
+		}
+	}
+
+	movel := func(dias int, nome string, facultativo bool) models.Feriado {
+		return models.Feriado{
+			Data:        pascoa.AddDate(0, 0, dias),
+			Nome:        nome,
+			Abrangencia: models.ABRANGENCIA_NACIONAL,
+			Facultativo: facultativo,
+		}
+	}
+
+	feriados := []models.Feriado{
+		fixo(time.January, 1, "Confraternização Universal"),
+		movel(-48, "Carnaval", true),
+		movel(-47, "Carnaval", true),
+		movel(-2, "Sexta-feira Santa", false),
+		fixo(time.April, 21, "Tiradentes"),
+		fixo(time.May, 1, "Dia do Trabalho"),
+		movel(60, "Corpus Christi", true),
+		fixo(time.September, 7, "Independência do Brasil"),
+		fixo(time.October, 12, "Nossa Senhora Aparecida"),
+		fixo(time.November, 2, "Finados"),
+		fixo(time.November, 15, "Proclamação da República"),
+		fixo(time.December, 25, "Natal"),
+	}
+
+	// Lei 14.759/2023
+	if ano >= 2024 {
+		feriados = append(feriados, fixo(time.November, 20, "Dia Nacional de Zumbi e da Consciência Negra"))
+	}
+
+	sort.SliceStable(feriados, func(i, j int) bool {
+		return feriados[i].Data.Before(feriados[j].Data)
+	})
+
+	return feriados
+}
+
+// Mapear indexa os feriados pela data, no formato retornado por Chave.
+func Mapear(feriados []models.Feriado) map[string]models.Feriado {
+	porData := make(map[string]models.Feriado, len(feriados))
+
+	for _, feriado := range feriados {
+		if _, ok := porData[Chave(feriado.Data)]; ok {
+			continue
+		}
+
+		porData[Chave(feriado.Data)] = feriado
+	}
+
+	return porData
+}
+
+func Chave(data time.Time) string {
+	return data.Format(time.DateOnly)
+}
@@ -0,0 +1,115 @@
+package feriado
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPascoa(t *testing.T) {
+	casos := []struct {
+		ano  int
+		data string
+	}{
+		{1818, "1818-03-22"},
+		{2000, "2000-04-23"},
+		{2011, "2011-04-24"},
+		{2019, "2019-04-21"},
+		{2024, "2024-03-31"},
+		{2025, "2025-04-20"},
+		{2026, "2026-04-05"},
+		{2038, "2038-04-25"},
+	}
+
+	for _, caso := range casos {
+		if obtido := Chave(Pascoa(caso.ano)); obtido != caso.data {
+			t.Errorf("Pascoa(%d) = %s, esperado %s", caso.ano, obtido, caso.data)
+		}
+	}
+}
+
+func TestNacionais(t *testing.T) {
+	casos := []struct {
+		nome         string
+		ano          int
+		quantidade   int
+		feriados     map[string]string
+		facultativos []string
+		ausentes     []string
+	}{
+		{
+			nome:       "antes da Lei 14.759/2023",
+			ano:        2023,
+			quantidade: 12,
+			feriados: map[string]string{
+				"2023-02-20": "Carnaval",
+				"2023-02-21": "Carnaval",
+				"2023-04-07": "Sexta-feira Santa",
+				"2023-06-08": "Corpus Christi",
+			},
+			facultativos: []string{"2023-02-20", "2023-02-21", "2023-06-08"},
+			ausentes:     []string{"2023-11-20"},
+		},
+		{
+			nome:       "com a Consciência Negra",
+			ano:        2026,
+			quantidade: 13,
+			feriados: map[string]string{
+				"2026-01-01": "Confraternização Universal",
+				"2026-02-16": "Carnaval",
+				"2026-02-17": "Carnaval",
+				"2026-04-03": "Sexta-feira Santa",
+				"2026-04-21": "Tiradentes",
+				"2026-06-04": "Corpus Christi",
+				"2026-11-20": "Dia Nacional de Zumbi e da Consciência Negra",
+				"2026-12-25": "Natal",
+			},
+			facultativos: []string{"2026-02-16", "2026-02-17", "2026-06-04"},
+		},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			feriados := Nacionais(caso.ano)
+
+			if len(feriados) != caso.quantidade {
+				t.Fatalf("Nacionais(%d) retornou %d feriados, esperado %d", caso.ano, len(feriados), caso.quantidade)
+			}
+
+			for i := 1; i < len(feriados); i++ {
+				if feriados[i].Data.Before(feriados[i-1].Data) {
+					t.Errorf("feriados fora de ordem: %s antes de %s", Chave(feriados[i-1].Data), Chave(feriados[i].Data))
+				}
+			}
+
+			porData := Mapear(feriados)
+
+			for data, nome := range caso.feriados {
+				feriado, ok := porData[data]
+				if !ok {
+					t.Errorf("feriado de %s ausente", data)
+					continue
+				}
+
+				if feriado.Nome != nome {
+					t.Errorf("feriado de %s = %q, esperado %q", data, feriado.Nome, nome)
+				}
+			}
+
+			for _, data := range caso.facultativos {
+				if !porData[data].Facultativo {
+					t.Errorf("feriado de %s deveria ser ponto facultativo", data)
+				}
+			}
+
+			for _, data := range caso.ausentes {
+				if _, ok := porData[data]; ok {
+					t.Errorf("feriado de %s não deveria existir em %d", data, caso.ano)
+				}
+			}
+
+			if porData[Chave(time.Date(caso.ano, time.April, 21, 0, 0, 0, 0, time.Local))].Facultativo {
+				t.Errorf("Tiradentes não é ponto facultativo")
+			}
+		})
+	}
+}
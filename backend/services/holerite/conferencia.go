@@ -0,0 +1,154 @@
+package holerite
+
+import (
+	"tsukuyomi/models"
+	"tsukuyomi/services/tributos"
+)
+
+// TOLERANCIA é a diferença máxima, em reais, aceita entre o valor retido e o
+// recalculado, para absorver arredondamentos feitos pelas folhas de
+// pagamento.
+const TOLERANCIA models.Dinheiro = 5 * models.ESCALA_DINHEIRO / 100
+
+const (
+	AVISO_SEM_TABELA = "Não há tabelas de INSS e IRRF para o mês de referência; foram usadas as mais antigas disponíveis."
+	AVISO_PLR        = "O IRRF sobre a participação nos lucros segue tabela exclusiva anual e não é conferido."
+	AVISO_PENDENTES  = "Há linhas sem rubrica; as bases de cálculo podem estar incompletas."
+)
+
+// Conferir recalcula o INSS e o IRRF do holerite a partir das incidências das
+// rubricas de cada linha. Créditos com incidência somam à base e débitos com
+// incidência, como faltas, são deduzidos dela; a pensão alimentícia é uma
+// dedução legal do IRRF. O 13º salário tem bases próprias, e a participação
+// nos lucros, de tributação exclusiva, não entra nas bases mensais. O IRRF é
+// calculado com o INSS recalculado, para que uma divergência no INSS não se
+// propague como divergência no imposto.
+func Conferir(holerite models.Holerite, dependentes int) models.ConferenciaHolerite {
+	conferencia := models.ConferenciaHolerite{
+		IDHolerite:       holerite.ID,
+		Referencia:       holerite.Referencia,
+		Dependentes:      dependentes,
+		Tributos:         []models.ConferenciaTributo{},
+		NaoClassificadas: []string{},
+		Avisos:           []string{},
+	}
+
+	var baseINSS, baseINSSDecimo, rendimentos, rendimentosDecimo, pensao models.Dinheiro
+	var participacao bool
+
+	retido := make(map[string]models.Dinheiro)
+
+	for _, linha := range holerite.Detalhamento {
+		if linha.Rubrica == nil {
+			conferencia.NaoClassificadas = append(conferencia.NaoClassificadas, linha.Descricao)
+			continue
+		}
+
+		valor := linha.Valor
+		if linha.Tipo == models.HOLERITE_DEBITO {
+			valor = -valor
+		}
+
+		rubrica := linha.Rubrica
+		decimo := rubrica.Categoria == models.RUBRICA_DECIMO_TERCEIRO
+
+		switch rubrica.Categoria {
+		case models.RUBRICA_INSS:
+			retido[models.TRIBUTO_INSS] += linha.Valor
+			continue
+		case models.RUBRICA_INSS_DECIMO_TERCEIRO:
+			retido[models.TRIBUTO_INSS_DECIMO_TERCEIRO] += linha.Valor
+			continue
+		case models.RUBRICA_IRRF:
+			retido[models.TRIBUTO_IRRF] += linha.Valor
+			continue
+		case models.RUBRICA_IRRF_DECIMO_TERCEIRO:
+			retido[models.TRIBUTO_IRRF_DECIMO_TERCEIRO] += linha.Valor
+			continue
+		case models.RUBRICA_IRRF_PARTICIPACAO_LUCROS, models.RUBRICA_PARTICIPACAO_LUCROS:
+			participacao = participacao || linha.Tipo == models.HOLERITE_DEBITO
+			continue
+		case models.RUBRICA_PENSAO_ALIMENTICIA:
+			if rubrica.IncideIRRF && linha.Tipo == models.HOLERITE_DEBITO {
+				pensao += linha.Valor
+				continue
+			}
+		}
+
+		if rubrica.IncideINSS {
+			if decimo {
+				baseINSSDecimo += valor
+			} else {
+				baseINSS += valor
+			}
+		}
+
+		if rubrica.IncideIRRF {
+			if decimo {
+				rendimentosDecimo += valor
+			} else {
+				rendimentos += valor
+			}
+		}
+	}
+
+	referencia := holerite.Referencia
+
+	if !tributos.Vigente(referencia) {
+		conferencia.Avisos = append(conferencia.Avisos, AVISO_SEM_TABELA)
+	}
+
+	if participacao {
+		conferencia.Avisos = append(conferencia.Avisos, AVISO_PLR)
+	}
+
+	if len(conferencia.NaoClassificadas) > 0 {
+		conferencia.Avisos = append(conferencia.Avisos, AVISO_PENDENTES)
+	}
+
+	baseINSS = max(baseINSS, 0)
+	baseINSSDecimo = max(baseINSSDecimo, 0)
+	rendimentos = max(rendimentos, 0)
+	rendimentosDecimo = max(rendimentosDecimo, 0)
+
+	inss := tributos.INSS(baseINSS, referencia)
+	inssDecimo := tributos.INSS(baseINSSDecimo, referencia)
+	irrf := tributos.IRRFMensal(rendimentos, inss+pensao, dependentes, referencia)
+	irrfDecimo := tributos.IRRFDecimoTerceiro(rendimentosDecimo, inssDecimo, dependentes, referencia)
+
+	adicionar := func(tributo string, rendimentos models.Dinheiro, resultado tributos.Calculo) {
+		if rendimentos == 0 && retido[tributo] == 0 {
+			return
+		}
+
+		calculado := resultado.Imposto
+
+		diferenca := tributos.Arredondar(retido[tributo] - calculado)
+
+		conferencia.Tributos = append(conferencia.Tributos, models.ConferenciaTributo{
+			Tributo:     tributo,
+			Rendimentos: tributos.Arredondar(rendimentos),
+			Base:        resultado.Base,
+			Deducoes:    resultado.Deducoes,
+			Calculado:   calculado,
+			Retido:      tributos.Arredondar(retido[tributo]),
+			Diferenca:   diferenca,
+			Divergente:  diferenca > TOLERANCIA || -diferenca > TOLERANCIA,
+		})
+	}
+
+	adicionar(models.TRIBUTO_INSS, baseINSS, tributos.Calculo{Base: tributos.Arredondar(baseINSS), Imposto: inss})
+	adicionar(models.TRIBUTO_IRRF, rendimentos, irrf)
+	adicionar(models.TRIBUTO_INSS_DECIMO_TERCEIRO, baseINSSDecimo, tributos.Calculo{Base: tributos.Arredondar(baseINSSDecimo), Imposto: inssDecimo})
+	adicionar(models.TRIBUTO_IRRF_DECIMO_TERCEIRO, rendimentosDecimo, irrfDecimo)
+
+	conferencia.Consistente = len(conferencia.NaoClassificadas) == 0
+
+	for _, tributo := range conferencia.Tributos {
+		if tributo.Divergente {
+			conferencia.Consistente = false
+		}
+	}
+
+	return conferencia
+}
@@ -0,0 +1,198 @@
+package holerite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/emprego"
+	"tsukuyomi/repositories/holerite"
+	"tsukuyomi/repositories/remuneracao"
+	"tsukuyomi/repositories/rubrica"
+	rubricaService "tsukuyomi/services/rubrica"
+)
+
+const (
+	ERROR_EMPREGO_NOT_FOUND  = "emprego não encontrado"
+	ERROR_HOLERITE_NOT_FOUND = "holerite não encontrado"
+	ERROR_RUBRICA_NOT_FOUND  = "rubrica não encontrada: %d"
+	ERROR_RUBRICA_TIPO       = "a rubrica %d é de %s e não pode ser usada em uma linha de %s"
+	ERROR_SEM_REMUNERACAO    = "nenhuma remuneração vigente no emprego no mês de referência; registre o histórico salarial antes do holerite"
+	ERROR_CATALOGO_VAZIO     = "nenhuma rubrica cadastrada; importe o catálogo padrão de rubricas antes de classificar"
+)
+
+type Service interface {
+	Create(ctx context.Context, holerite models.Holerite) (models.Holerite, error)
+	FindByEmprego(ctx context.Context, id_emprego string) ([]models.Holerite, error)
+	FindByID(ctx context.Context, id_emprego, id string) (models.Holerite, error)
+	Classificar(ctx context.Context, id_emprego, id string, todas bool) (models.Holerite, error)
+	Conferir(ctx context.Context, id_emprego, id string, dependentes int) (models.ConferenciaHolerite, error)
+}
+
+type service struct {
+	repository            holerite.Repository
+	EmpregoRepository     emprego.Repository
+	RemuneracaoRepository remuneracao.Repository
+	RubricaRepository     rubrica.Repository
+}
+
+func NewService(repository holerite.Repository, empregoRepository emprego.Repository, remuneracaoRepository remuneracao.Repository, rubricaRepository rubrica.Repository) Service {
+	return &service{
+		repository:            repository,
+		EmpregoRepository:     empregoRepository,
+		RemuneracaoRepository: remuneracaoRepository,
+		RubricaRepository:     rubricaRepository,
+	}
+}
+
+// Create registra o holerite do mês de referência, vinculado à remuneração
+// vigente no emprego no mês. Linhas sem rubrica são classificadas pela
+// descrição com o catálogo de rubricas.
+func (s *service) Create(ctx context.Context, holerite models.Holerite) (models.Holerite, error) {
+	id_emprego := fmt.Sprint(holerite.IDEmprego)
+
+	emprego, err := s.EmpregoRepository.FindByID(ctx, id_emprego)
+	if err != nil {
+		return models.Holerite{}, err
+	}
+
+	if emprego.ID == 0 {
+		return models.Holerite{}, errors.New(ERROR_EMPREGO_NOT_FOUND)
+	}
+
+	holerite.Referencia = time.Date(holerite.Referencia.Year(), holerite.Referencia.Month(), 1, 0, 0, 0, 0, time.Local)
+
+	remuneracoes, err := s.RemuneracaoRepository.FindByEmprego(ctx, id_emprego)
+	if err != nil {
+		return models.Holerite{}, err
+	}
+
+	fimMes := holerite.Referencia.AddDate(0, 1, 0)
+	moeda := emprego.Moeda
+
+	for _, remuneracao := range remuneracoes {
+		if remuneracao.Data.Before(fimMes) {
+			holerite.IDRemuneracao = remuneracao.ID
+			moeda = remuneracao.Moeda
+		}
+	}
+
+	if holerite.IDRemuneracao == 0 {
+		return models.Holerite{}, errors.New(ERROR_SEM_REMUNERACAO)
+	}
+
+	if holerite.Moeda == "" {
+		holerite.Moeda = moeda
+	}
+
+	rubricas, err := s.RubricaRepository.FindAll(ctx, "", "", "")
+	if err != nil {
+		return models.Holerite{}, err
+	}
+
+	catalogo := make(map[int64]models.Rubrica)
+
+	for _, rubrica := range rubricas {
+		catalogo[rubrica.ID] = rubrica
+	}
+
+	for i, linha := range holerite.Detalhamento {
+		if linha.IDRubrica == nil {
+			if rubrica := rubricaService.Classificar(rubricas, linha); rubrica != nil {
+				holerite.Detalhamento[i].IDRubrica = &rubrica.ID
+				holerite.Detalhamento[i].Rubrica = rubrica
+			}
+
+			continue
+		}
+
+		rubrica, ok := catalogo[*linha.IDRubrica]
+		if !ok {
+			return models.Holerite{}, fmt.Errorf(ERROR_RUBRICA_NOT_FOUND, *linha.IDRubrica)
+		}
+
+		if rubrica.Tipo != linha.Tipo {
+			return models.Holerite{}, fmt.Errorf(ERROR_RUBRICA_TIPO, rubrica.ID, rubrica.Tipo, linha.Tipo)
+		}
+
+		holerite.Detalhamento[i].Rubrica = &rubrica
+	}
+
+	holerite.Empresa = emprego.Empresa
+
+	return s.repository.Create(ctx, holerite)
+}
+
+func (s *service) FindByEmprego(ctx context.Context, id_emprego string) ([]models.Holerite, error) {
+	return s.repository.FindByEmprego(ctx, id_emprego)
+}
+
+func (s *service) FindByID(ctx context.Context, id_emprego, id string) (models.Holerite, error) {
+	return s.repository.FindByID(ctx, id_emprego, id)
+}
+
+// Classificar atribui rubricas às linhas do holerite pela descrição. Por
+// padrão, apenas as linhas ainda sem rubrica são classificadas; com todas,
+// as linhas já classificadas também são revistas.
+func (s *service) Classificar(ctx context.Context, id_emprego, id string, todas bool) (models.Holerite, error) {
+	holerite, err := s.repository.FindByID(ctx, id_emprego, id)
+	if err != nil {
+		return models.Holerite{}, err
+	}
+
+	if holerite.ID == 0 {
+		return models.Holerite{}, errors.New(ERROR_HOLERITE_NOT_FOUND)
+	}
+
+	rubricas, err := s.RubricaRepository.FindAll(ctx, "", "", "")
+	if err != nil {
+		return models.Holerite{}, err
+	}
+
+	if len(rubricas) == 0 {
+		return models.Holerite{}, errors.New(ERROR_CATALOGO_VAZIO)
+	}
+
+	var alteradas []models.DetalhamentoHolerite
+
+	for i, linha := range holerite.Detalhamento {
+		if linha.IDRubrica != nil && !todas {
+			continue
+		}
+
+		rubrica := rubricaService.Classificar(rubricas, linha)
+		if rubrica == nil || (linha.IDRubrica != nil && *linha.IDRubrica == rubrica.ID) {
+			continue
+		}
+
+		holerite.Detalhamento[i].IDRubrica = &rubrica.ID
+		holerite.Detalhamento[i].Rubrica = rubrica
+
+		alteradas = append(alteradas, holerite.Detalhamento[i])
+	}
+
+	if len(alteradas) == 0 {
+		return holerite, nil
+	}
+
+	if err := s.repository.Classificar(ctx, alteradas); err != nil {
+		return models.Holerite{}, err
+	}
+
+	return holerite, nil
+}
+
+func (s *service) Conferir(ctx context.Context, id_emprego, id string, dependentes int) (models.ConferenciaHolerite, error) {
+	holerite, err := s.repository.FindByID(ctx, id_emprego, id)
+	if err != nil {
+		return models.ConferenciaHolerite{}, err
+	}
+
+	if holerite.ID == 0 {
+		return models.ConferenciaHolerite{}, errors.New(ERROR_HOLERITE_NOT_FOUND)
+	}
+
+	return Conferir(holerite, dependentes), nil
+}
@@ -0,0 +1,186 @@
+package indice
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/texto"
+)
+
+var (
+	dataBCB     = regexp.MustCompile(`^(\d{1,2})/(\d{1,2})/(\d{4})$`)
+	mesAno      = regexp.MustCompile(`^(\d{1,2})/(\d{4})$`)
+	anoMes      = regexp.MustCompile(`^(\d{4})-(\d{1,2})(-\d{1,2})?$`)
+	codigoMes   = regexp.MustCompile(`^(\d{4})(\d{2})$`)
+	nomeMesAno  = regexp.MustCompile(`^([a-z]+) (de )?(\d{4})$`)
+	semVariacao = map[string]bool{"": true, "-": true, "...": true, "..": true, "x": true}
+)
+
+var meses = []string{"janeiro", "fevereiro", "marco", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"}
+
+// lerIndices interpreta os arquivos CSV de variação mensal exportados do
+// Sistema Gerenciador de Séries Temporais do Banco Central, com as colunas
+// data (DD/MM/AAAA) e valor, e das tabelas do SIDRA do IBGE, com o mês no
+// formato "janeiro 2024" ou no código AAAAMM seguido da variação. Em cada
+// linha, o mês é o primeiro campo reconhecido como data e a variação é o
+// último campo numérico. Linhas sem mês, como cabeçalhos e notas, são
+// desconsideradas. Os arquivos podem ser separados por ponto e vírgula ou
+// vírgula, em ISO-8859-1 ou UTF-8, com a vírgula ou o ponto como separador
+// decimal.
+func lerIndices(indice string, conteudo io.Reader) ([]models.IndiceEconomico, models.ImportacaoIndices, error) {
+	relatorio := models.ImportacaoIndices{
+		Indice: indice,
+		Erros:  []string{},
+	}
+
+	dados, err := io.ReadAll(conteudo)
+	if err != nil {
+		return nil, relatorio, err
+	}
+
+	dados = bytes.TrimPrefix(dados, []byte("\xef\xbb\xbf"))
+
+	if !utf8.Valid(dados) {
+		dados = texto.Latin1ParaUTF8(dados)
+	}
+
+	separador := ';'
+
+	primeiraLinha, _, _ := bufio.NewReader(bytes.NewReader(dados)).ReadLine()
+	if !bytes.ContainsRune(primeiraLinha, ';') && bytes.ContainsRune(primeiraLinha, ',') {
+		separador = ','
+	}
+
+	leitor := csv.NewReader(bytes.NewReader(dados))
+	leitor.Comma = separador
+	leitor.FieldsPerRecord = -1
+	leitor.LazyQuotes = true
+
+	var indices []models.IndiceEconomico
+	vistos := make(map[time.Time]bool)
+	numero := 0
+
+	for {
+		registro, err := leitor.Read()
+		if err == io.EOF {
+			break
+		}
+
+		numero++
+
+		if err != nil {
+			relatorio.Linhas++
+			relatorio.Erros = append(relatorio.Erros, fmt.Sprintf("linha %d: %s", numero, err.Error()))
+			continue
+		}
+
+		coluna := -1
+		var referencia time.Time
+
+		for i, campo := range registro {
+			if mes, ok := lerReferencia(campo); ok {
+				coluna = i
+				referencia = mes
+				break
+			}
+		}
+
+		if coluna < 0 {
+			continue
+		}
+
+		relatorio.Linhas++
+
+		campo := ""
+		if coluna < len(registro)-1 {
+			campo = strings.TrimSpace(registro[len(registro)-1])
+		}
+
+		if semVariacao[campo] {
+			relatorio.Ignorados++
+			continue
+		}
+
+		variacao, err := lerVariacao(campo)
+		if err != nil {
+			relatorio.Erros = append(relatorio.Erros, fmt.Sprintf("linha %d: variação inválida: %s", numero, campo))
+			continue
+		}
+
+		indiceEconomico := models.IndiceEconomico{
+			Indice:     indice,
+			Referencia: referencia,
+			Variacao:   variacao,
+		}
+
+		if err := indiceEconomico.Validate(); err != nil {
+			relatorio.Erros = append(relatorio.Erros, fmt.Sprintf("linha %d: %s", numero, err.Error()))
+			continue
+		}
+
+		if vistos[referencia] {
+			relatorio.Ignorados++
+			continue
+		}
+
+		vistos[referencia] = true
+		indices = append(indices, indiceEconomico)
+	}
+
+	return indices, relatorio, nil
+}
+
+// lerReferencia retorna o primeiro dia do mês informado no campo.
+func lerReferencia(campo string) (time.Time, bool) {
+	campo = strings.TrimSpace(campo)
+
+	var ano, mes int
+
+	if m := dataBCB.FindStringSubmatch(campo); m != nil {
+		mes, _ = strconv.Atoi(m[2])
+		ano, _ = strconv.Atoi(m[3])
+	} else if m := mesAno.FindStringSubmatch(campo); m != nil {
+		mes, _ = strconv.Atoi(m[1])
+		ano, _ = strconv.Atoi(m[2])
+	} else if m := anoMes.FindStringSubmatch(campo); m != nil {
+		ano, _ = strconv.Atoi(m[1])
+		mes, _ = strconv.Atoi(m[2])
+	} else if m := codigoMes.FindStringSubmatch(campo); m != nil {
+		ano, _ = strconv.Atoi(m[1])
+		mes, _ = strconv.Atoi(m[2])
+	} else if m := nomeMesAno.FindStringSubmatch(texto.Normalizar(campo)); m != nil && len(m[1]) >= 3 {
+		for i, nome := range meses {
+			if strings.HasPrefix(nome, m[1]) {
+				mes = i + 1
+				break
+			}
+		}
+		ano, _ = strconv.Atoi(m[3])
+	}
+
+	if mes < 1 || mes > 12 || ano < 1900 || ano > 2100 {
+		return time.Time{}, false
+	}
+
+	return time.Date(ano, time.Month(mes), 1, 0, 0, 0, 0, time.Local), true
+}
+
+func lerVariacao(campo string) (float64, error) {
+	campo = strings.TrimSuffix(strings.TrimSpace(campo), "%")
+
+	if strings.Contains(campo, ",") {
+		campo = strings.ReplaceAll(campo, ".", "")
+		campo = strings.ReplaceAll(campo, ",", ".")
+	}
+
+	return strconv.ParseFloat(strings.TrimSpace(campo), 64)
+}
@@ -0,0 +1,341 @@
+package indice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/cotacao"
+	"tsukuyomi/repositories/emprego"
+	"tsukuyomi/repositories/indice"
+	"tsukuyomi/repositories/remuneracao"
+	cotacaoService "tsukuyomi/services/cotacao"
+	"tsukuyomi/services/tributos"
+)
+
+const (
+	ERROR_INDICE_INVALIDO   = "índice inválido; informe ipca, inpc ou igpm"
+	ERROR_INDICE_SEM_DADOS  = "nenhuma variação importada para o índice"
+	ERROR_BASE_INVALIDA     = "mês-base inválido; informe no formato AAAA-MM"
+	ERROR_EMPREGO_NOT_FOUND = "emprego não encontrado"
+	ERROR_MOEDAS_DIFERENTES = "o histórico salarial tem remunerações em moedas diferentes; converta para reais"
+)
+
+type Service interface {
+	FindAll(ctx context.Context, indice, inicio, fim string) ([]models.IndiceEconomico, error)
+	Importar(ctx context.Context, indice string, conteudo io.Reader) (models.ImportacaoIndices, error)
+	EvolucaoReal(ctx context.Context, id_emprego, indice, base string, brl bool) (models.EvolucaoReal, error)
+}
+
+type service struct {
+	repository            indice.Repository
+	EmpregoRepository     emprego.Repository
+	RemuneracaoRepository remuneracao.Repository
+	CotacaoRepository     cotacao.Repository
+}
+
+func NewService(repository indice.Repository, empregoRepository emprego.Repository, remuneracaoRepository remuneracao.Repository, cotacaoRepository cotacao.Repository) Service {
+	return &service{
+		repository:            repository,
+		EmpregoRepository:     empregoRepository,
+		RemuneracaoRepository: remuneracaoRepository,
+		CotacaoRepository:     cotacaoRepository,
+	}
+}
+
+func (s *service) FindAll(ctx context.Context, indice, inicio, fim string) ([]models.IndiceEconomico, error) {
+	if !Valido(indice) {
+		return []models.IndiceEconomico{}, errors.New(ERROR_INDICE_INVALIDO)
+	}
+
+	return s.repository.FindAll(ctx, indice, inicio, fim)
+}
+
+// Importar importa as variações mensais do índice a partir do arquivo CSV do
+// Banco Central ou do IBGE. Meses já importados têm a variação atualizada.
+func (s *service) Importar(ctx context.Context, indice string, conteudo io.Reader) (models.ImportacaoIndices, error) {
+	if !Valido(indice) {
+		return models.ImportacaoIndices{}, errors.New(ERROR_INDICE_INVALIDO)
+	}
+
+	indices, relatorio, err := lerIndices(indice, conteudo)
+	if err != nil {
+		return models.ImportacaoIndices{}, err
+	}
+
+	criado := time.Now()
+
+	for i := range indices {
+		indices[i].Criado = criado
+	}
+
+	if len(indices) == 0 {
+		return relatorio, nil
+	}
+
+	inseridos, atualizados, err := s.repository.Importar(ctx, indices)
+	if err != nil {
+		return models.ImportacaoIndices{}, err
+	}
+
+	relatorio.Importados = inseridos
+	relatorio.Atualizados = atualizados
+	relatorio.Ignorados += len(indices) - inseridos - atualizados
+
+	return relatorio, nil
+}
+
+// EvolucaoReal deflaciona o histórico salarial do emprego pelo índice para
+// valores do mês-base, no formato AAAA-MM. Sem o mês-base, usa o último mês
+// com índice publicado, limitado ao fim do emprego. Com brl, cada salário em
+// moeda estrangeira é antes convertido para reais pela PTAX de compra da data
+// em que passou a vigorar.
+func (s *service) EvolucaoReal(ctx context.Context, id_emprego, indice, base string, brl bool) (models.EvolucaoReal, error) {
+	if indice == "" {
+		indice = models.INDICE_IPCA
+	}
+
+	if !Valido(indice) {
+		return models.EvolucaoReal{}, errors.New(ERROR_INDICE_INVALIDO)
+	}
+
+	emprego, err := s.EmpregoRepository.FindByID(ctx, id_emprego)
+	if err != nil {
+		return models.EvolucaoReal{}, err
+	}
+
+	if emprego.ID == 0 {
+		return models.EvolucaoReal{}, errors.New(ERROR_EMPREGO_NOT_FOUND)
+	}
+
+	remuneracoes, err := s.RemuneracaoRepository.FindByEmprego(ctx, id_emprego)
+	if err != nil {
+		return models.EvolucaoReal{}, err
+	}
+
+	if brl {
+		conversor := cotacaoService.NovoConversor(s.CotacaoRepository)
+
+		conversao, err := conversor.Converter(ctx, emprego.Moeda, emprego.DataInicio)
+		if err != nil {
+			return models.EvolucaoReal{}, err
+		}
+
+		emprego.RemuneracaoInicial = emprego.RemuneracaoInicial.Multiplicar(conversao.Taxa)
+		emprego.Moeda = models.MOEDA_BRL
+
+		for i := range remuneracoes {
+			conversao, err := conversor.Converter(ctx, remuneracoes[i].Moeda, remuneracoes[i].Data)
+			if err != nil {
+				return models.EvolucaoReal{}, err
+			}
+
+			remuneracoes[i].Remuneracao = remuneracoes[i].Remuneracao.Multiplicar(conversao.Taxa)
+			remuneracoes[i].Moeda = models.MOEDA_BRL
+		}
+	}
+
+	for _, remuneracao := range remuneracoes {
+		if remuneracao.Moeda != emprego.Moeda {
+			return models.EvolucaoReal{}, errors.New(ERROR_MOEDAS_DIFERENTES)
+		}
+	}
+
+	indices, err := s.repository.FindAll(ctx, indice, "", "")
+	if err != nil {
+		return models.EvolucaoReal{}, err
+	}
+
+	if len(indices) == 0 {
+		return models.EvolucaoReal{}, errors.New(ERROR_INDICE_SEM_DADOS)
+	}
+
+	var mesBase *time.Time
+
+	if base != "" {
+		mes, err := time.ParseInLocation("2006-01", base, time.Local)
+		if err != nil {
+			return models.EvolucaoReal{}, errors.New(ERROR_BASE_INVALIDA)
+		}
+
+		mesBase = &mes
+	}
+
+	return Deflacionar(emprego, remuneracoes, NovaSerie(indices), mesBase, time.Now())
+}
+
+// Valido informa se o índice é um dos índices de preços suportados.
+func Valido(indice string) bool {
+	switch indice {
+	case models.INDICE_IPCA, models.INDICE_INPC, models.INDICE_IGPM:
+		return true
+	}
+
+	return false
+}
+
+// Deflacionar calcula a evolução real do histórico salarial. O salário
+// inicial do emprego é o primeiro período quando não há remuneração na data
+// de início. Cada salário é considerado aos preços do mês em que passou a
+// vigorar e é levado ao mês-base pela inflação acumulada entre os dois meses.
+func Deflacionar(emprego models.Emprego, remuneracoes []models.Remuneracao, serie Serie, base *time.Time, hoje time.Time) (models.EvolucaoReal, error) {
+	ultimoMes := serie.Ultimo()
+
+	for _, limite := range []*time.Time{&hoje, emprego.DataFim} {
+		if limite != nil && Mes(*limite).Before(ultimoMes) {
+			ultimoMes = Mes(*limite)
+		}
+	}
+
+	if base == nil {
+		base = &ultimoMes
+	}
+
+	evolucao := models.EvolucaoReal{
+		IDEmprego: emprego.ID,
+		Indice:    serie.Indice,
+		Moeda:     emprego.Moeda,
+		Base:      Mes(*base),
+		Periodos:  []models.PeriodoEvolucao{},
+		UltimoMes: ultimoMes,
+	}
+
+	if len(remuneracoes) == 0 || Mes(remuneracoes[0].Data).After(Mes(emprego.DataInicio)) {
+		remuneracoes = append([]models.Remuneracao{{
+			Remuneracao: emprego.RemuneracaoInicial,
+			Data:        emprego.DataInicio,
+		}}, remuneracoes...)
+	}
+
+	var primeiro, anterior *models.PeriodoEvolucao
+
+	for _, remuneracao := range remuneracoes {
+		fator, err := serie.Fator(remuneracao.Data, evolucao.Base)
+		if err != nil {
+			return models.EvolucaoReal{}, err
+		}
+
+		periodo := models.PeriodoEvolucao{
+			Data:            remuneracao.Data,
+			Remuneracao:     remuneracao.Remuneracao,
+			RemuneracaoReal: remuneracao.Remuneracao.Multiplicar(fator),
+		}
+
+		if anterior != nil {
+			inflacao, err := serie.Fator(anterior.Data, periodo.Data)
+			if err != nil {
+				return models.EvolucaoReal{}, err
+			}
+
+			periodo.VariacaoNominal = percentual(periodo.Remuneracao.Razao(anterior.Remuneracao))
+			periodo.Inflacao = percentual(inflacao)
+			periodo.GanhoReal = percentual(periodo.RemuneracaoReal.Razao(anterior.RemuneracaoReal))
+			periodo.GanhoRealAcumulado = percentual(periodo.RemuneracaoReal.Razao(primeiro.RemuneracaoReal))
+		}
+
+		evolucao.Periodos = append(evolucao.Periodos, periodo)
+
+		anterior = &evolucao.Periodos[len(evolucao.Periodos)-1]
+		if primeiro == nil {
+			primeiro = anterior
+		}
+	}
+
+	inflacao := 1.0
+
+	if ultimoMes.After(Mes(anterior.Data)) {
+		fator, err := serie.Fator(anterior.Data, ultimoMes)
+		if err != nil {
+			return models.EvolucaoReal{}, err
+		}
+
+		inflacao = fator
+	}
+
+	evolucao.GanhoRealAcumulado = anterior.GanhoRealAcumulado
+	evolucao.InflacaoDesdeUltimoReajuste = percentual(inflacao)
+	evolucao.GanhoRealAtual = percentual(anterior.RemuneracaoReal.Razao(primeiro.RemuneracaoReal) / inflacao)
+
+	for i := range evolucao.Periodos {
+		evolucao.Periodos[i].RemuneracaoReal = tributos.Arredondar(evolucao.Periodos[i].RemuneracaoReal)
+	}
+
+	return evolucao, nil
+}
+
+// Serie é a sequência de variações mensais de um índice, indexada pelo mês.
+type Serie struct {
+	Indice    string
+	variacoes map[int]float64
+	ultimo    time.Time
+}
+
+func NovaSerie(indices []models.IndiceEconomico) Serie {
+	serie := Serie{
+		variacoes: make(map[int]float64),
+	}
+
+	for _, indice := range indices {
+		serie.Indice = indice.Indice
+		serie.variacoes[chave(indice.Referencia)] = indice.Variacao
+
+		if Mes(indice.Referencia).After(serie.ultimo) {
+			serie.ultimo = Mes(indice.Referencia)
+		}
+	}
+
+	return serie
+}
+
+// Ultimo retorna o último mês com variação publicada.
+func (s Serie) Ultimo() time.Time {
+	return s.ultimo
+}
+
+// Fator retorna o fator que leva um valor aos preços do fim do mês de origem
+// para os preços do fim do mês de destino: a inflação acumulada nos meses
+// seguintes à origem até o destino, ou o inverso dela quando o destino é
+// anterior à origem.
+func (s Serie) Fator(origem, destino time.Time) (float64, error) {
+	de, para := chave(origem), chave(destino)
+
+	inverso := para < de
+	if inverso {
+		de, para = para, de
+	}
+
+	fator := 1.0
+
+	for mes := de + 1; mes <= para; mes++ {
+		variacao, ok := s.variacoes[mes]
+		if !ok {
+			return 0, fmt.Errorf("variação do %s em %02d/%d não importada", s.Indice, mes%12+1, mes/12)
+		}
+
+		fator *= 1 + variacao/100
+	}
+
+	if inverso {
+		return 1 / fator, nil
+	}
+
+	return fator, nil
+}
+
+// Mes retorna o primeiro dia do mês da data.
+func Mes(data time.Time) time.Time {
+	return time.Date(data.Year(), data.Month(), 1, 0, 0, 0, 0, time.Local)
+}
+
+func chave(data time.Time) int {
+	return data.Year()*12 + int(data.Month()) - 1
+}
+
+// percentual converte um fator, como 1.045, na variação percentual, 4.5.
+func percentual(fator float64) float64 {
+	return math.Round((fator-1)*10000) / 100
+}
@@ -0,0 +1,19 @@
+package lote
+
+const (
+	// TAMANHO é a quantidade de registros gravados por transação nas
+	// importações de arquivos grandes.
+	TAMANHO = 1000
+	// LIMITE_ERROS é a quantidade de erros listados no resumo da importação.
+	LIMITE_ERROS = 100
+)
+
+// Erro acrescenta o erro à lista do resumo da importação enquanto ela não
+// atingir LIMITE_ERROS.
+func Erro(erros []string, erro string) []string {
+	if len(erros) < LIMITE_ERROS {
+		erros = append(erros, erro)
+	}
+
+	return erros
+}
@@ -0,0 +1,222 @@
+package notafiscal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/emprego"
+	notaFiscal "tsukuyomi/repositories/nota_fiscal"
+	"tsukuyomi/services/beneficio"
+	"tsukuyomi/services/tributos"
+)
+
+const (
+	ERROR_EMPREGO_NOT_FOUND    = "emprego não encontrado"
+	ERROR_EMPREGO_CLT          = "notas fiscais só podem ser registradas em empregos PJ"
+	ERROR_NUMERO_DUPLICADO     = "já existe uma nota fiscal com o número informado no emprego"
+	ERROR_COMPETENCIA_INVALIDA = "competência inválida, informe no formato AAAA-MM"
+	ERROR_PRO_LABORE_INVALIDO  = "pró-labore inválido"
+)
+
+const (
+	AVISO_INICIO_ATIVIDADE = "Há menos de doze meses de atividade antes da competência; a RBT12 e a folha foram proporcionalizadas pela média mensal."
+	AVISO_SEM_PRO_LABORE   = "Sem pró-labore informado, o Fator R é zero e a tributação é pelo anexo V."
+	AVISO_NOTAS_PENDENTES  = "A receita inclui notas ainda não pagas; o Simples Nacional considera a receita pela data de emissão."
+)
+
+type Service interface {
+	Create(ctx context.Context, nota models.NotaFiscal) (models.NotaFiscal, error)
+	FindByEmprego(ctx context.Context, id_emprego, situacao string) ([]models.NotaFiscal, error)
+	FindByID(ctx context.Context, id_emprego, id string) (models.NotaFiscal, error)
+	Update(ctx context.Context, nota models.NotaFiscal) error
+	Delete(ctx context.Context, id_emprego, id string) error
+	EstimarDAS(ctx context.Context, id_emprego, competencia, pro_labore, anexo string) (models.EstimativaDAS, error)
+}
+
+type service struct {
+	repository        notaFiscal.Repository
+	EmpregoRepository emprego.Repository
+}
+
+func NewService(repository notaFiscal.Repository, empregoRepository emprego.Repository) Service {
+	return &service{
+		repository:        repository,
+		EmpregoRepository: empregoRepository,
+	}
+}
+
+func (s *service) Create(ctx context.Context, nota models.NotaFiscal) (models.NotaFiscal, error) {
+	if err := s.validar(ctx, nota); err != nil {
+		return models.NotaFiscal{}, err
+	}
+
+	return s.repository.Create(ctx, nota)
+}
+
+func (s *service) FindByEmprego(ctx context.Context, id_emprego, situacao string) ([]models.NotaFiscal, error) {
+	return s.repository.FindByEmprego(ctx, id_emprego, situacao)
+}
+
+func (s *service) FindByID(ctx context.Context, id_emprego, id string) (models.NotaFiscal, error) {
+	return s.repository.FindByID(ctx, id_emprego, id)
+}
+
+func (s *service) Update(ctx context.Context, nota models.NotaFiscal) error {
+	if err := s.validar(ctx, nota); err != nil {
+		return err
+	}
+
+	return s.repository.Update(ctx, nota)
+}
+
+func (s *service) Delete(ctx context.Context, id_emprego, id string) error {
+	return s.repository.Delete(ctx, id_emprego, id)
+}
+
+// validar confere que o emprego existe, não é CLT e não tem outra nota com o
+// mesmo número.
+func (s *service) validar(ctx context.Context, nota models.NotaFiscal) error {
+	emprego, err := s.EmpregoRepository.FindByID(ctx, fmt.Sprint(nota.IDEmprego))
+	if err != nil {
+		return err
+	}
+
+	if emprego.ID == 0 {
+		return errors.New(ERROR_EMPREGO_NOT_FOUND)
+	}
+
+	if beneficio.CLT(emprego.TipoContrato) {
+		return errors.New(ERROR_EMPREGO_CLT)
+	}
+
+	notas, err := s.repository.FindByEmprego(ctx, fmt.Sprint(nota.IDEmprego), "")
+	if err != nil {
+		return err
+	}
+
+	for _, existente := range notas {
+		if existente.Numero == nota.Numero && existente.ID != nota.ID {
+			return errors.New(ERROR_NUMERO_DUPLICADO)
+		}
+	}
+
+	return nil
+}
+
+// EstimarDAS estima o DAS da competência, por padrão o mês atual, a partir
+// das notas emitidas no emprego. A folha de salários considera o pró-labore
+// mensal informado em todos os meses do período. Sem anexo informado, ele é
+// definido pelo Fator R.
+func (s *service) EstimarDAS(ctx context.Context, id_emprego, competencia, pro_labore, anexo string) (models.EstimativaDAS, error) {
+	mes := time.Now()
+
+	if competencia != "" {
+		data, err := time.ParseInLocation("2006-01", competencia, time.Local)
+		if err != nil {
+			return models.EstimativaDAS{}, errors.New(ERROR_COMPETENCIA_INVALIDA)
+		}
+
+		mes = data
+	}
+
+	mes = time.Date(mes.Year(), mes.Month(), 1, 0, 0, 0, 0, time.Local)
+
+	var proLabore models.Dinheiro
+
+	if pro_labore != "" {
+		valor, err := models.ParseDinheiro(pro_labore)
+		if err != nil || valor < 0 {
+			return models.EstimativaDAS{}, errors.New(ERROR_PRO_LABORE_INVALIDO)
+		}
+
+		proLabore = valor
+	}
+
+	emprego, err := s.EmpregoRepository.FindByID(ctx, id_emprego)
+	if err != nil {
+		return models.EstimativaDAS{}, err
+	}
+
+	if emprego.ID == 0 {
+		return models.EstimativaDAS{}, errors.New(ERROR_EMPREGO_NOT_FOUND)
+	}
+
+	notas, err := s.repository.FindByEmprego(ctx, id_emprego, "")
+	if err != nil {
+		return models.EstimativaDAS{}, err
+	}
+
+	estimativa := models.EstimativaDAS{
+		IDEmprego:   emprego.ID,
+		Competencia: mes,
+		Avisos:      []string{},
+	}
+
+	inicio := mes.AddDate(-1, 0, 0)
+	fim := mes.AddDate(0, 1, 0)
+
+	var pendentes bool
+
+	for _, nota := range notas {
+		if nota.DataEmissao.Before(inicio) || !nota.DataEmissao.Before(fim) {
+			continue
+		}
+
+		if nota.DataEmissao.Before(mes) {
+			estimativa.RBT12 += nota.Valor
+		} else {
+			estimativa.ReceitaMes += nota.Valor
+			estimativa.Notas++
+		}
+
+		pendentes = pendentes || nota.Situacao == models.NOTA_FISCAL_PENDENTE
+	}
+
+	// No início de atividade, a receita dos doze meses é a média mensal dos
+	// meses anteriores multiplicada por doze ou, no primeiro mês, a receita do
+	// próprio mês multiplicada por doze.
+	meses := (mes.Year()-emprego.DataInicio.Year())*12 + int(mes.Month()-emprego.DataInicio.Month())
+
+	if meses < 12 {
+		estimativa.Avisos = append(estimativa.Avisos, AVISO_INICIO_ATIVIDADE)
+
+		if meses <= 0 {
+			estimativa.RBT12 = estimativa.ReceitaMes * 12
+		} else {
+			estimativa.RBT12 = tributos.Arredondar(estimativa.RBT12.Dividir(float64(meses)) * 12)
+		}
+	}
+
+	estimativa.Folha12 = proLabore * 12
+	estimativa.FatorR = math.Round(tributos.FatorR(estimativa.Folha12, estimativa.RBT12)*1e4) / 1e4
+
+	estimativa.Anexo = anexo
+	if anexo == "" {
+		estimativa.Anexo = tributos.AnexoFatorR(estimativa.Folha12, estimativa.RBT12)
+
+		if proLabore == 0 {
+			estimativa.Avisos = append(estimativa.Avisos, AVISO_SEM_PRO_LABORE)
+		}
+	}
+
+	if pendentes {
+		estimativa.Avisos = append(estimativa.Avisos, AVISO_NOTAS_PENDENTES)
+	}
+
+	calculo, err := tributos.SimplesNacional(estimativa.Anexo, estimativa.RBT12, estimativa.ReceitaMes)
+	if err != nil {
+		return models.EstimativaDAS{}, err
+	}
+
+	estimativa.Faixa = calculo.Faixa
+	estimativa.AliquotaNominal = math.Round(calculo.AliquotaNominal*1e6) / 1e4
+	estimativa.ParcelaDeduzir = calculo.ParcelaDeduzir
+	estimativa.AliquotaEfetiva = math.Round(calculo.AliquotaEfetiva*1e6) / 1e4
+	estimativa.Valor = calculo.Imposto
+
+	return estimativa, nil
+}
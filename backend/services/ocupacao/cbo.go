@@ -0,0 +1,105 @@
+package ocupacao
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/texto"
+)
+
+// lerCBO interpreta o arquivo CSV de ocupações publicado pelo Ministério do
+// Trabalho (CBO2002 - Ocupacao.csv), com as colunas CODIGO e TITULO. O arquivo
+// oficial é separado por ponto e vírgula e codificado em ISO-8859-1, mas
+// arquivos separados por vírgula e em UTF-8 também são aceitos. Códigos podem
+// vir com ou sem hífen.
+func lerCBO(conteudo io.Reader) ([]models.Ocupacao, models.ImportacaoCBO, error) {
+	relatorio := models.ImportacaoCBO{
+		Erros: []string{},
+	}
+
+	dados, err := io.ReadAll(conteudo)
+	if err != nil {
+		return nil, relatorio, err
+	}
+
+	dados = bytes.TrimPrefix(dados, []byte("\xef\xbb\xbf"))
+
+	if !utf8.Valid(dados) {
+		dados = texto.Latin1ParaUTF8(dados)
+	}
+
+	separador := ';'
+
+	primeiraLinha, _, _ := bufio.NewReader(bytes.NewReader(dados)).ReadLine()
+	if !bytes.ContainsRune(primeiraLinha, ';') && bytes.ContainsRune(primeiraLinha, ',') {
+		separador = ','
+	}
+
+	leitor := csv.NewReader(bytes.NewReader(dados))
+	leitor.Comma = separador
+	leitor.FieldsPerRecord = -1
+	leitor.LazyQuotes = true
+
+	var ocupacoes []models.Ocupacao
+	vistos := make(map[string]bool)
+	numero := 0
+
+	for {
+		registro, err := leitor.Read()
+		if err == io.EOF {
+			break
+		}
+
+		numero++
+
+		if err != nil {
+			relatorio.Linhas++
+			relatorio.Erros = append(relatorio.Erros, fmt.Sprintf("linha %d: %s", numero, err.Error()))
+			continue
+		}
+
+		if len(registro) == 0 || (len(registro) == 1 && strings.TrimSpace(registro[0]) == "") {
+			continue
+		}
+
+		if numero == 1 && strings.EqualFold(strings.TrimSpace(registro[0]), "codigo") {
+			continue
+		}
+
+		relatorio.Linhas++
+
+		if len(registro) < 2 {
+			relatorio.Erros = append(relatorio.Erros, fmt.Sprintf("linha %d: esperadas as colunas código e título", numero))
+			continue
+		}
+
+		cbo := texto.Digitos(registro[0])
+		nome := strings.TrimSpace(registro[1])
+
+		ocupacao := models.Ocupacao{
+			Nome: nome,
+			CBO:  &cbo,
+		}
+
+		if err := ocupacao.Validate(); err != nil {
+			relatorio.Erros = append(relatorio.Erros, fmt.Sprintf("linha %d: %s", numero, err.Error()))
+			continue
+		}
+
+		if vistos[cbo] {
+			relatorio.Ignoradas++
+			continue
+		}
+
+		vistos[cbo] = true
+		ocupacoes = append(ocupacoes, ocupacao)
+	}
+
+	return ocupacoes, relatorio, nil
+}
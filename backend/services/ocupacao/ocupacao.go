@@ -0,0 +1,97 @@
+package ocupacao
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/ocupacao"
+	"tsukuyomi/services/texto"
+)
+
+type Service interface {
+	Create(ctx context.Context, ocupacao models.Ocupacao) (models.Ocupacao, error)
+	FindAll(ctx context.Context, search, cbo string) ([]models.Ocupacao, error)
+	FindByID(ctx context.Context, id string) (models.Ocupacao, error)
+	Update(ctx context.Context, ocupacao models.Ocupacao) error
+	Delete(ctx context.Context, id string) error
+	ImportarCBO(ctx context.Context, conteudo io.Reader) (models.ImportacaoCBO, error)
+}
+
+type service struct {
+	repository ocupacao.Repository
+}
+
+func NewService(repository ocupacao.Repository) Service {
+	return &service{
+		repository: repository,
+	}
+}
+
+func (s *service) Create(ctx context.Context, ocupacao models.Ocupacao) (models.Ocupacao, error) {
+	return s.repository.Create(ctx, normalizar(ocupacao))
+}
+
+func (s *service) FindAll(ctx context.Context, search, cbo string) ([]models.Ocupacao, error) {
+	return s.repository.FindAll(ctx, search, texto.Digitos(cbo))
+}
+
+func (s *service) FindByID(ctx context.Context, id string) (models.Ocupacao, error) {
+	return s.repository.FindByID(ctx, id)
+}
+
+func (s *service) Update(ctx context.Context, ocupacao models.Ocupacao) error {
+	return s.repository.Update(ctx, normalizar(ocupacao))
+}
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	return s.repository.Delete(ctx, id)
+}
+
+// ImportarCBO importa a lista de ocupações da Classificação Brasileira de
+// Ocupações. Ocupações com código já cadastrado têm o nome atualizado.
+func (s *service) ImportarCBO(ctx context.Context, conteudo io.Reader) (models.ImportacaoCBO, error) {
+	ocupacoes, relatorio, err := lerCBO(conteudo)
+	if err != nil {
+		return models.ImportacaoCBO{}, err
+	}
+
+	criado := time.Now()
+
+	for i := range ocupacoes {
+		ocupacoes[i].Criado = criado
+	}
+
+	if len(ocupacoes) == 0 {
+		return relatorio, nil
+	}
+
+	inseridas, atualizadas, err := s.repository.Importar(ctx, ocupacoes)
+	if err != nil {
+		return models.ImportacaoCBO{}, err
+	}
+
+	relatorio.Importadas = inseridas
+	relatorio.Atualizadas = atualizadas
+	relatorio.Ignoradas += len(ocupacoes) - inseridas - atualizadas
+
+	return relatorio, nil
+}
+
+// normalizar remove a formatação do código CBO. Códigos vazios são gravados
+// como nulos para não violar a unicidade.
+func normalizar(ocupacao models.Ocupacao) models.Ocupacao {
+	if ocupacao.CBO == nil {
+		return ocupacao
+	}
+
+	cbo := texto.Digitos(*ocupacao.CBO)
+	ocupacao.CBO = &cbo
+
+	if cbo == "" {
+		ocupacao.CBO = nil
+	}
+
+	return ocupacao
+}
@@ -0,0 +1,110 @@
+package pessoa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"tsukuyomi/models"
+	contatoEmpresa "tsukuyomi/repositories/contato_empresa"
+	"tsukuyomi/repositories/empresa"
+	"tsukuyomi/repositories/pessoa"
+)
+
+const (
+	ERROR_EMPRESA_NOT_FOUND = "empresa não encontrada"
+)
+
+type Service interface {
+	Create(ctx context.Context, pessoa models.Pessoa) (models.Pessoa, error)
+	FindAll(ctx context.Context, search, empresa, departamento string) ([]models.Pessoa, error)
+	FindByEmpresa(ctx context.Context, id_empresa, search string) ([]models.Pessoa, error)
+	FindByID(ctx context.Context, id string) (models.Pessoa, error)
+	Update(ctx context.Context, pessoa models.Pessoa) error
+	Delete(ctx context.Context, id string) error
+}
+
+type service struct {
+	repository        pessoa.Repository
+	EmpresaRepository empresa.Repository
+	ContatoRepository contatoEmpresa.Repository
+}
+
+func NewService(repository pessoa.Repository, empresaRepository empresa.Repository, contatoRepository contatoEmpresa.Repository) Service {
+	return &service{
+		repository:        repository,
+		EmpresaRepository: empresaRepository,
+		ContatoRepository: contatoRepository,
+	}
+}
+
+func (s *service) Create(ctx context.Context, pessoa models.Pessoa) (models.Pessoa, error) {
+	empresa, err := s.EmpresaRepository.FindByID(ctx, fmt.Sprint(pessoa.IDEmpresa))
+	if err != nil {
+		return models.Pessoa{}, err
+	}
+
+	if empresa.ID == 0 {
+		return models.Pessoa{}, errors.New(ERROR_EMPRESA_NOT_FOUND)
+	}
+
+	return s.repository.Create(ctx, pessoa)
+}
+
+func (s *service) FindAll(ctx context.Context, search, empresa, departamento string) ([]models.Pessoa, error) {
+	return s.repository.FindAll(ctx, search, empresa, departamento)
+}
+
+// FindByEmpresa retorna as pessoas da empresa com os contatos de cada uma.
+func (s *service) FindByEmpresa(ctx context.Context, id_empresa, search string) ([]models.Pessoa, error) {
+	pessoas, err := s.repository.FindAll(ctx, search, id_empresa, "")
+	if err != nil {
+		return []models.Pessoa{}, err
+	}
+
+	for i := range pessoas {
+		if err := s.carregarContatos(ctx, &pessoas[i]); err != nil {
+			return []models.Pessoa{}, err
+		}
+	}
+
+	return pessoas, nil
+}
+
+// FindByID retorna a pessoa com os contatos dela.
+func (s *service) FindByID(ctx context.Context, id string) (models.Pessoa, error) {
+	pessoa, err := s.repository.FindByID(ctx, id)
+	if err != nil || pessoa.ID == 0 {
+		return pessoa, err
+	}
+
+	if err := s.carregarContatos(ctx, &pessoa); err != nil {
+		return models.Pessoa{}, err
+	}
+
+	return pessoa, nil
+}
+
+func (s *service) Update(ctx context.Context, pessoa models.Pessoa) error {
+	return s.repository.Update(ctx, pessoa)
+}
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	return s.repository.Delete(ctx, id)
+}
+
+func (s *service) carregarContatos(ctx context.Context, pessoa *models.Pessoa) error {
+	contatos, err := s.ContatoRepository.FindAll(ctx, "", "", fmt.Sprint(pessoa.ID), "", "")
+	if err != nil {
+		return err
+	}
+
+	pessoa.Contatos = []models.ContatoEmpresa{}
+
+	for _, contato := range contatos {
+		contato.Pessoa = nil
+		pessoa.Contatos = append(pessoa.Contatos, contato)
+	}
+
+	return nil
+}
@@ -0,0 +1,363 @@
+package ponto
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"tsukuyomi/models"
+	feriadoService "tsukuyomi/services/feriado"
+	"tsukuyomi/services/texto"
+)
+
+// Tamanhos dos registros do AFD. Os leiautes da Portaria 671/2021 trazem CRC-16
+// ou hash; os da Portaria 1510/2009 são aceitos para arquivos de relógios
+// antigos.
+const (
+	AFD_CABECALHO_671    = 302
+	AFD_CABECALHO_1510   = 232
+	AFD_MARCACAO_671     = 50
+	AFD_MARCACAO_1510    = 34
+	AFD_MARCACAO_REP_P   = 137
+	AFD_TIPO_CABECALHO   = "1"
+	AFD_TIPO_MARCACAO    = "3"
+	AFD_TIPO_MARCACAO_RP = "7"
+	AFD_TIPO_TRAILER     = "9"
+)
+
+type marcacaoAFD struct {
+	linha         int
+	nsr           string
+	tipo          string
+	horario       time.Time
+	identificador string
+}
+
+// ImportarAFD lê um Arquivo Fonte de Dados e insere as marcações que ainda não
+// existem no cartão de ponto do emprego. Quando informado, o CPF (ou PIS, no
+// leiaute da Portaria 1510) filtra as marcações do empregado.
+func (s *service) ImportarAFD(ctx context.Context, id_emprego string, conteudo io.Reader, cpf string) (models.ImportacaoAFD, error) {
+	idEmprego, err := strconv.ParseInt(id_emprego, 10, 64)
+	if err != nil {
+		return models.ImportacaoAFD{}, fmt.Errorf("ID do emprego inválido: %w", err)
+	}
+
+	marcacoes, relatorio, err := lerAFD(conteudo)
+	if err != nil {
+		return models.ImportacaoAFD{}, err
+	}
+
+	cpf = strings.TrimLeft(texto.Digitos(cpf), "0")
+
+	var novas []marcacaoAFD
+
+	for _, marcacao := range marcacoes {
+		if cpf != "" && strings.TrimLeft(marcacao.identificador, "0") != cpf {
+			relatorio.adicionar(marcacao, models.AFD_IGNORADA, "marcação de outro empregado")
+			continue
+		}
+
+		novas = append(novas, marcacao)
+	}
+
+	if len(novas) == 0 {
+		return relatorio.ImportacaoAFD, nil
+	}
+
+	existentes, err := s.repository.FindByEmprego(ctx, id_emprego, novas[0].horario, novas[len(novas)-1].horario)
+	if err != nil {
+		return models.ImportacaoAFD{}, err
+	}
+
+	registradas := make(map[int64]bool, len(existentes))
+	porDia := make(map[string][]models.CartaoPonto)
+
+	for _, existente := range existentes {
+		registradas[existente.Horario.Truncate(time.Minute).Unix()] = true
+		porDia[feriadoService.Chave(existente.Horario)] = append(porDia[feriadoService.Chave(existente.Horario)], existente)
+	}
+
+	criado := time.Now()
+	var importadas []marcacaoAFD
+
+	for _, marcacao := range novas {
+		if registradas[marcacao.horario.Unix()] {
+			relatorio.adicionar(marcacao, models.AFD_IGNORADA, "marcação já registrada")
+			continue
+		}
+
+		registradas[marcacao.horario.Unix()] = true
+		importadas = append(importadas, marcacao)
+
+		dia := feriadoService.Chave(marcacao.horario)
+		porDia[dia] = append(porDia[dia], models.CartaoPonto{
+			IDEmprego: idEmprego,
+			Horario:   marcacao.horario,
+			Criado:    criado,
+		})
+	}
+
+	// O AFD não informa se a marcação é entrada ou saída: as marcações de cada
+	// dia, somadas às já existentes, são alternadas a partir de uma entrada.
+	var inserir []models.CartaoPonto
+
+	for _, marcacoesDoDia := range porDia {
+		sort.SliceStable(marcacoesDoDia, func(i, j int) bool {
+			return marcacoesDoDia[i].Horario.Before(marcacoesDoDia[j].Horario)
+		})
+
+		for i, marcacao := range marcacoesDoDia {
+			if marcacao.ID != 0 {
+				continue
+			}
+
+			marcacao.Tipo = models.PONTO_ENTRADA
+			if i%2 == 1 {
+				marcacao.Tipo = models.PONTO_SAIDA
+			}
+
+			inserir = append(inserir, marcacao)
+		}
+	}
+
+	if len(inserir) > 0 {
+		if _, err := s.repository.CreateMany(ctx, inserir); err != nil {
+			return models.ImportacaoAFD{}, err
+		}
+	}
+
+	for _, marcacao := range importadas {
+		relatorio.adicionar(marcacao, models.AFD_IMPORTADA, "")
+	}
+
+	sort.SliceStable(relatorio.Detalhes, func(i, j int) bool {
+		return relatorio.Detalhes[i].Linha < relatorio.Detalhes[j].Linha
+	})
+
+	return relatorio.ImportacaoAFD, nil
+}
+
+type relatorioAFD struct {
+	models.ImportacaoAFD
+}
+
+func (r *relatorioAFD) adicionar(marcacao marcacaoAFD, situacao, motivo string) {
+	linha := models.LinhaAFD{
+		Linha:    marcacao.linha,
+		NSR:      marcacao.nsr,
+		Tipo:     marcacao.tipo,
+		Situacao: situacao,
+		Motivo:   motivo,
+	}
+
+	if !marcacao.horario.IsZero() {
+		horario := marcacao.horario
+		linha.Horario = &horario
+	}
+
+	switch situacao {
+	case models.AFD_IMPORTADA:
+		r.Importadas++
+	case models.AFD_IGNORADA:
+		r.Ignoradas++
+	case models.AFD_ERRO:
+		r.Erros++
+	}
+
+	r.Detalhes = append(r.Detalhes, linha)
+}
+
+// lerAFD interpreta os registros de largura fixa do AFD, valida o CRC-16 dos
+// registros que o possuem e confere a quantidade de marcações do trailer. As
+// marcações válidas são retornadas em ordem cronológica; as demais linhas são
+// registradas no relatório.
+func lerAFD(conteudo io.Reader) ([]marcacaoAFD, relatorioAFD, error) {
+	relatorio := relatorioAFD{
+		ImportacaoAFD: models.ImportacaoAFD{
+			Detalhes: []models.LinhaAFD{},
+		},
+	}
+
+	var marcacoes []marcacaoAFD
+	var trailer *marcacaoAFD
+	hashAnterior := ""
+	contagem := 0
+	numero := 0
+
+	leitor := bufio.NewScanner(conteudo)
+	leitor.Buffer(make([]byte, 0, 1024), 1024*1024)
+
+	for leitor.Scan() {
+		numero++
+
+		linha := strings.TrimRight(leitor.Text(), "\r\n")
+		if strings.TrimSpace(linha) == "" {
+			continue
+		}
+
+		relatorio.Linhas++
+
+		registro := marcacaoAFD{linha: numero}
+
+		if len(linha) < 10 {
+			relatorio.adicionar(registro, models.AFD_ERRO, "registro com tamanho inválido")
+			continue
+		}
+
+		registro.nsr = linha[0:9]
+		registro.tipo = linha[9:10]
+
+		if registro.nsr == "000000000" && registro.tipo == AFD_TIPO_CABECALHO {
+			if len(linha) == AFD_CABECALHO_671 && !crcValido(linha) {
+				relatorio.adicionar(registro, models.AFD_ERRO, "CRC-16 do cabeçalho não confere")
+			}
+
+			continue
+		}
+
+		if registro.nsr == "999999999" {
+			trailer = &registro
+			if err := conferirTrailer(linha, contagem); err != nil {
+				relatorio.adicionar(registro, models.AFD_ERRO, err.Error())
+			}
+
+			continue
+		}
+
+		if registro.tipo != AFD_TIPO_MARCACAO && registro.tipo != AFD_TIPO_MARCACAO_RP {
+			relatorio.adicionar(registro, models.AFD_IGNORADA, fmt.Sprintf("registro do tipo %s não é uma marcação de ponto", registro.tipo))
+			continue
+		}
+
+		contagem++
+
+		var err error
+
+		switch {
+		case registro.tipo == AFD_TIPO_MARCACAO && len(linha) == AFD_MARCACAO_671:
+			if !crcValido(linha) {
+				relatorio.adicionar(registro, models.AFD_ERRO, "CRC-16 da marcação não confere")
+				continue
+			}
+
+			registro.horario, err = time.Parse("2006-01-02T15:04:05-0700", linha[10:34])
+			registro.identificador = linha[34:46]
+		case registro.tipo == AFD_TIPO_MARCACAO && len(linha) == AFD_MARCACAO_1510:
+			registro.horario, err = time.ParseInLocation("020120061504", linha[10:22], time.Local)
+			registro.identificador = linha[22:34]
+		case registro.tipo == AFD_TIPO_MARCACAO_RP && len(linha) == AFD_MARCACAO_REP_P:
+			valido := hashValido(linha, hashAnterior)
+			hashAnterior = linha[73:]
+
+			if !valido {
+				relatorio.adicionar(registro, models.AFD_ERRO, "hash SHA-256 da marcação do REP-P não confere")
+				continue
+			}
+
+			registro.horario, err = time.Parse("2006-01-02T15:04:05-0700", linha[10:34])
+			registro.identificador = linha[34:46]
+		default:
+			relatorio.adicionar(registro, models.AFD_ERRO, fmt.Sprintf("marcação com tamanho inválido (%d caracteres)", len(linha)))
+			continue
+		}
+
+		if err != nil {
+			relatorio.adicionar(registro, models.AFD_ERRO, "data e hora da marcação inválidas")
+			continue
+		}
+
+		registro.horario = registro.horario.In(time.Local).Truncate(time.Minute)
+		marcacoes = append(marcacoes, registro)
+	}
+
+	if err := leitor.Err(); err != nil {
+		return nil, relatorio, err
+	}
+
+	if trailer == nil {
+		relatorio.adicionar(marcacaoAFD{linha: numero}, models.AFD_ERRO, "arquivo sem trailer, pode estar incompleto")
+	}
+
+	sort.SliceStable(marcacoes, func(i, j int) bool {
+		return marcacoes[i].horario.Before(marcacoes[j].horario)
+	})
+
+	return marcacoes, relatorio, nil
+}
+
+// conferirTrailer compara a quantidade de marcações informada no trailer com a
+// lida no arquivo. No leiaute da Portaria 671 o trailer informa as marcações
+// dos tipos 3 e 7 separadamente; no da 1510, apenas as do tipo 3.
+func conferirTrailer(linha string, contagem int) error {
+	var informado int
+
+	switch len(linha) {
+	case 64:
+		tipo3, err3 := strconv.Atoi(linha[18:27])
+		tipo7, err7 := strconv.Atoi(linha[54:63])
+		if err3 != nil || err7 != nil {
+			return fmt.Errorf("trailer com quantidades inválidas")
+		}
+
+		informado = tipo3 + tipo7
+	case 46:
+		tipo3, err := strconv.Atoi(linha[18:27])
+		if err != nil {
+			return fmt.Errorf("trailer com quantidades inválidas")
+		}
+
+		informado = tipo3
+	default:
+		return fmt.Errorf("trailer com tamanho inválido (%d caracteres)", len(linha))
+	}
+
+	if informado != contagem {
+		return fmt.Errorf("trailer informa %d marcações, mas o arquivo contém %d", informado, contagem)
+	}
+
+	return nil
+}
+
+// crcValido confere os quatro últimos caracteres do registro, que trazem o
+// CRC-16/KERMIT dos caracteres anteriores em hexadecimal.
+func crcValido(linha string) bool {
+	corpo := linha[:len(linha)-4]
+	informado := strings.ToUpper(linha[len(linha)-4:])
+
+	return fmt.Sprintf("%04X", crc16Kermit([]byte(corpo))) == informado
+}
+
+// hashValido confere o hash SHA-256 da marcação do REP-P, calculado sobre os
+// campos do registro antes do hash concatenados ao hash da marcação do tipo 7
+// anterior, vazio na primeira. A cadeia segue pelo hash informado, para que
+// um registro adulterado não invalide os seguintes.
+func hashValido(linha, anterior string) bool {
+	hash := sha256.Sum256([]byte(linha[:73] + anterior))
+
+	return strings.EqualFold(hex.EncodeToString(hash[:]), linha[73:])
+}
+
+func crc16Kermit(dados []byte) uint16 {
+	var crc uint16
+
+	for _, b := range dados {
+		crc ^= uint16(b)
+
+		for i := 0; i < 8; i++ {
+			if crc&1 == 1 {
+				crc = (crc >> 1) ^ 0x8408
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+
+	return crc
+}
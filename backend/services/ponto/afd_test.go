@@ -0,0 +1,168 @@
+package ponto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCRC16Kermit(t *testing.T) {
+	casos := []struct {
+		dados string
+		crc   uint16
+	}{
+		{"", 0x0000},
+		{"123456789", 0x2189},
+		{"A", 0x538D},
+	}
+
+	for _, caso := range casos {
+		if obtido := crc16Kermit([]byte(caso.dados)); obtido != caso.crc {
+			t.Errorf("crc16Kermit(%q) = %04X, esperado %04X", caso.dados, obtido, caso.crc)
+		}
+	}
+}
+
+func marcacao671(nsr int, horario, cpf string) string {
+	corpo := fmt.Sprintf("%09d3%s%012s", nsr, horario, cpf)
+
+	return corpo + fmt.Sprintf("%04X", crc16Kermit([]byte(corpo)))
+}
+
+func marcacao1510(nsr int, horario, pis string) string {
+	return fmt.Sprintf("%09d3%s%012s", nsr, horario, pis)
+}
+
+// marcacaoREPP monta as marcações do tipo 7 encadeadas pelo hash, a partir do
+// hash anterior informado.
+func marcacaoREPP(nsr int, horario, cpf, anterior string) string {
+	corpo := fmt.Sprintf("%09d7%s%012s", nsr, horario, cpf)
+	corpo += strings.Repeat("0", 73-len(corpo))
+	hash := sha256.Sum256([]byte(corpo + anterior))
+
+	return corpo + hex.EncodeToString(hash[:])
+}
+
+func trailer671(tipo3, tipo7 int) string {
+	return fmt.Sprintf("9999999999%08d%09d%027d%09d9", 0, tipo3, 0, tipo7)
+}
+
+func trailer1510(tipo3 int) string {
+	return fmt.Sprintf("9999999999%08d%09d%018d9", 0, tipo3, 0)
+}
+
+func TestLerAFD(t *testing.T) {
+	repP1 := marcacaoREPP(3, "2026-03-02T12:00:00-0300", "12345678901", "")
+	repP2 := marcacaoREPP(4, "2026-03-02T13:00:00-0300", "12345678901", repP1[73:])
+	adulterada := repP1[:40] + "9" + repP1[41:]
+
+	casos := []struct {
+		nome      string
+		linhas    []string
+		marcacoes int
+		erros     int
+		ignoradas int
+		motivo    string
+	}{
+		{
+			nome: "leiaute da Portaria 671",
+			linhas: []string{
+				marcacao671(1, "2026-03-02T17:00:00-0300", "12345678901"),
+				marcacao671(2, "2026-03-02T08:00:00-0300", "12345678901"),
+				repP1,
+				repP2,
+				trailer671(2, 2),
+			},
+			marcacoes: 4,
+		},
+		{
+			nome: "leiaute da Portaria 1510",
+			linhas: []string{
+				marcacao1510(1, "020320260800", "12345678901"),
+				marcacao1510(2, "020320261700", "12345678901"),
+				trailer1510(2),
+			},
+			marcacoes: 2,
+		},
+		{
+			nome: "CRC-16 que não confere",
+			linhas: []string{
+				marcacao671(1, "2026-03-02T08:00:00-0300", "12345678901")[:46] + "0000",
+				trailer671(1, 0),
+			},
+			erros:  1,
+			motivo: "CRC-16 da marcação não confere",
+		},
+		{
+			nome:      "hash do REP-P adulterado não invalida o seguinte",
+			linhas:    []string{adulterada, repP2, trailer671(0, 2)},
+			marcacoes: 1,
+			erros:     1,
+			motivo:    "hash SHA-256 da marcação do REP-P não confere",
+		},
+		{
+			nome:      "trailer com outra quantidade",
+			linhas:    []string{marcacao1510(1, "020320260800", "12345678901"), trailer1510(3)},
+			marcacoes: 1,
+			erros:     1,
+			motivo:    "trailer informa 3 marcações, mas o arquivo contém 1",
+		},
+		{
+			nome:      "arquivo sem trailer",
+			linhas:    []string{marcacao1510(1, "020320260800", "12345678901")},
+			marcacoes: 1,
+			erros:     1,
+			motivo:    "arquivo sem trailer, pode estar incompleto",
+		},
+		{
+			nome:      "registro que não é marcação",
+			linhas:    []string{"0000000014" + strings.Repeat("0", 40), trailer671(0, 0)},
+			ignoradas: 1,
+			motivo:    "registro do tipo 4 não é uma marcação de ponto",
+		},
+		{
+			nome:   "marcação com tamanho inválido",
+			linhas: []string{"0000000013" + strings.Repeat("0", 30), trailer671(1, 0)},
+			erros:  1,
+			motivo: "marcação com tamanho inválido (40 caracteres)",
+		},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			marcacoes, relatorio, err := lerAFD(strings.NewReader(strings.Join(caso.linhas, "\r\n") + "\r\n"))
+			if err != nil {
+				t.Fatalf("lerAFD retornou erro: %v", err)
+			}
+
+			if len(marcacoes) != caso.marcacoes || relatorio.Erros != caso.erros || relatorio.Ignoradas != caso.ignoradas {
+				t.Errorf("marcações, erros e ignoradas = %d, %d, %d, esperado %d, %d, %d", len(marcacoes), relatorio.Erros, relatorio.Ignoradas, caso.marcacoes, caso.erros, caso.ignoradas)
+			}
+
+			if caso.motivo != "" && (len(relatorio.Detalhes) == 0 || relatorio.Detalhes[0].Motivo != caso.motivo) {
+				t.Errorf("detalhes = %+v, esperado o motivo %q", relatorio.Detalhes, caso.motivo)
+			}
+
+			for i := 1; i < len(marcacoes); i++ {
+				if marcacoes[i].horario.Before(marcacoes[i-1].horario) {
+					t.Errorf("marcações fora da ordem cronológica: %v antes de %v", marcacoes[i-1].horario, marcacoes[i].horario)
+				}
+			}
+		})
+	}
+}
+
+func TestLerAFDHorario(t *testing.T) {
+	marcacoes, _, err := lerAFD(strings.NewReader(marcacao671(1, "2026-03-02T08:00:30-0300", "12345678901") + "\n" + trailer671(1, 0)))
+	if err != nil || len(marcacoes) != 1 {
+		t.Fatalf("lerAFD = %v, %v, esperado uma marcação", marcacoes, err)
+	}
+
+	esperado := time.Date(2026, time.March, 2, 11, 0, 0, 0, time.UTC)
+	if !marcacoes[0].horario.Equal(esperado) || marcacoes[0].identificador != "012345678901" {
+		t.Errorf("marcação = %v %s, esperado %v 012345678901", marcacoes[0].horario, marcacoes[0].identificador, esperado)
+	}
+}
@@ -0,0 +1,268 @@
+package ponto
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"tsukuyomi/models"
+)
+
+const (
+	ERROR_EMPREGO_NOT_FOUND = "emprego não encontrado"
+	LEGENDA_CORRECAO        = "* Marcação incluída ou alterada por correção aprovada."
+)
+
+var diasDaSemana = []string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"}
+
+// Espelho monta o espelho de ponto do mês, com a apuração de cada dia, os
+// totais e o saldo do banco de horas ao final do mês.
+func (s *service) Espelho(ctx context.Context, id_emprego string, mes time.Time) (models.EspelhoPonto, error) {
+	emprego, err := s.EmpregoRepository.FindByID(ctx, id_emprego)
+	if err != nil {
+		return models.EspelhoPonto{}, err
+	}
+
+	if emprego.ID == 0 {
+		return models.EspelhoPonto{}, errors.New(ERROR_EMPREGO_NOT_FOUND)
+	}
+
+	inicio := time.Date(mes.Year(), mes.Month(), 1, 0, 0, 0, 0, time.Local)
+	fim := inicio.AddDate(0, 1, -1)
+
+	dias, err := s.Apurar(ctx, id_emprego, inicio, fim)
+	if err != nil {
+		return models.EspelhoPonto{}, err
+	}
+
+	saldoAnterior, err := s.repository.SaldoBancoHoras(ctx, id_emprego, inicio)
+	if err != nil {
+		return models.EspelhoPonto{}, err
+	}
+
+	espelho := models.EspelhoPonto{
+		Empresa:       emprego.Empresa,
+		Emprego:       emprego,
+		Mes:           inicio,
+		Dias:          dias,
+		SaldoAnterior: saldoAnterior,
+	}
+
+	espelho.Emprego.Empresa = models.Empresa{}
+
+	for _, dia := range dias {
+		espelho.TotalPrevisto += dia.Previsto.Minutos
+		espelho.TotalTrabalhado += dia.Trabalhado
+		espelho.SaldoMes += dia.Saldo
+	}
+
+	espelho.SaldoBancoHoras = espelho.SaldoAnterior + espelho.SaldoMes
+
+	return espelho, nil
+}
+
+// EspelhoCSV exporta o espelho de ponto em CSV separado por ponto e vírgula,
+// com BOM para que planilhas reconheçam a acentuação.
+func EspelhoCSV(espelho models.EspelhoPonto) ([]byte, error) {
+	var saida bytes.Buffer
+
+	saida.WriteString("\xef\xbb\xbf")
+
+	escritor := csv.NewWriter(&saida)
+	escritor.Comma = ';'
+
+	linhas := [][]string{
+		{"Empresa", espelho.Empresa.Nome},
+		{"CNPJ", espelho.Empresa.CNPJ},
+		{"Ocupação", espelho.Emprego.Ocupacao},
+		{"Mês", espelho.Mes.Format("01/2006")},
+		{},
+		{"Data", "Dia", "Marcações", "Marcações originais", "Previsto", "Trabalhado", "Intervalo", "Saldo", "Ocorrências"},
+	}
+
+	for _, dia := range espelho.Dias {
+		linhas = append(linhas, []string{
+			dia.Data.Format("02/01/2006"),
+			diasDaSemana[dia.Data.Weekday()],
+			marcacoes(dia.Marcacoes),
+			marcacoes(dia.Originais),
+			horas(dia.Previsto.Minutos, false),
+			horas(dia.Trabalhado, false),
+			horas(dia.Intervalo, false),
+			horas(dia.Saldo, true),
+			strings.Join(ocorrencias(dia), ", "),
+		})
+	}
+
+	linhas = append(linhas,
+		[]string{},
+		[]string{"Total previsto", horas(espelho.TotalPrevisto, false)},
+		[]string{"Total trabalhado", horas(espelho.TotalTrabalhado, false)},
+		[]string{"Saldo do mês", horas(espelho.SaldoMes, true)},
+		[]string{"Saldo anterior do banco de horas", horas(espelho.SaldoAnterior, true)},
+		[]string{"Saldo do banco de horas", horas(espelho.SaldoBancoHoras, true)},
+		[]string{},
+		[]string{LEGENDA_CORRECAO},
+	)
+
+	if err := escritor.WriteAll(linhas); err != nil {
+		return nil, err
+	}
+
+	return saida.Bytes(), nil
+}
+
+// EspelhoPDF exporta o espelho de ponto em PDF, em páginas A4 retrato.
+func EspelhoPDF(espelho models.EspelhoPonto) []byte {
+	documento := novoDocumentoPDF()
+
+	colunas := []struct {
+		titulo string
+		x      float64
+	}{
+		{"Data", PDF_MARGEM},
+		{"Marcações", PDF_MARGEM + 62},
+		{"Previsto", PDF_MARGEM + 242},
+		{"Trabalhado", PDF_MARGEM + 290},
+		{"Intervalo", PDF_MARGEM + 342},
+		{"Saldo", PDF_MARGEM + 390},
+		{"Ocorrências", PDF_MARGEM + 432},
+	}
+
+	y := PDF_MARGEM
+
+	cabecalho := func() {
+		documento.texto(PDF_MARGEM, y+12, 14, true, "Espelho de ponto - "+espelho.Mes.Format("01/2006"))
+		y += 32
+		documento.texto(PDF_MARGEM, y, 9, false, fmt.Sprintf("Empresa: %s    CNPJ: %s", espelho.Empresa.Nome, espelho.Empresa.CNPJ))
+		y += 13
+		documento.texto(PDF_MARGEM, y, 9, false, "Ocupação: "+espelho.Emprego.Ocupacao)
+		y += 20
+
+		for _, coluna := range colunas {
+			documento.texto(coluna.x, y, 8, true, coluna.titulo)
+		}
+
+		y += 4
+		documento.linha(PDF_MARGEM, y, PDF_LARGURA-PDF_MARGEM, y)
+		y += 11
+	}
+
+	cabecalho()
+
+	for _, dia := range espelho.Dias {
+		if y > PDF_ALTURA-PDF_MARGEM-90 {
+			documento.novaPagina()
+			y = PDF_MARGEM
+			cabecalho()
+		}
+
+		valores := []string{
+			dia.Data.Format("02/01") + " " + diasDaSemana[dia.Data.Weekday()],
+			marcacoes(dia.Marcacoes),
+			horas(dia.Previsto.Minutos, false),
+			horas(dia.Trabalhado, false),
+			horas(dia.Intervalo, false),
+			horas(dia.Saldo, true),
+			strings.Join(ocorrencias(dia), ", "),
+		}
+
+		for i, coluna := range colunas {
+			documento.texto(coluna.x, y, 8, false, valores[i])
+		}
+
+		y += 12
+	}
+
+	documento.linha(PDF_MARGEM, y-8, PDF_LARGURA-PDF_MARGEM, y-8)
+	y += 8
+
+	totais := [][]string{
+		{"Total previsto", horas(espelho.TotalPrevisto, false)},
+		{"Total trabalhado", horas(espelho.TotalTrabalhado, false)},
+		{"Saldo do mês", horas(espelho.SaldoMes, true)},
+		{"Saldo anterior do banco de horas", horas(espelho.SaldoAnterior, true)},
+		{"Saldo do banco de horas", horas(espelho.SaldoBancoHoras, true)},
+	}
+
+	for _, total := range totais {
+		documento.texto(PDF_MARGEM, y, 9, true, total[0])
+		documento.texto(PDF_MARGEM+200, y, 9, false, total[1])
+		y += 13
+	}
+
+	documento.texto(PDF_MARGEM, y+8, 8, false, LEGENDA_CORRECAO)
+
+	return documento.bytes()
+}
+
+// marcacoes formata os horários das marcações, indicando com um asterisco as
+// incluídas ou alteradas por correção.
+func marcacoes(lista []models.CartaoPonto) string {
+	horarios := make([]string, len(lista))
+
+	for i, marcacao := range lista {
+		horarios[i] = marcacao.Horario.Format("15:04")
+
+		if marcacao.IDCorrecao != nil {
+			horarios[i] += "*"
+		}
+	}
+
+	return strings.Join(horarios, " ")
+}
+
+func ocorrencias(dia models.ApuracaoDia) []string {
+	var ocorrencias []string
+
+	if dia.Previsto.Feriado != nil {
+		ocorrencias = append(ocorrencias, "Feriado: "+*dia.Previsto.Feriado)
+	} else if dia.Previsto.Ausencia == nil && dia.Previsto.Minutos == 0 && dia.Trabalhado == 0 {
+		ocorrencias = append(ocorrencias, "Folga")
+	}
+
+	if dia.Previsto.Ausencia != nil {
+		ocorrencias = append(ocorrencias, models.TIPOS_AUSENCIA[*dia.Previsto.Ausencia])
+	}
+
+	if dia.Previsto.Abonado > 0 {
+		ocorrencias = append(ocorrencias, "Abonado: "+horas(dia.Previsto.Abonado, false))
+	}
+
+	if dia.Previsto.DescontoDSR > 0 {
+		ocorrencias = append(ocorrencias, "DSR perdido: "+horas(dia.Previsto.DescontoDSR, false))
+	}
+
+	if dia.Corrigido {
+		original := marcacoes(dia.Originais)
+		if original == "" {
+			original = "nenhuma"
+		}
+
+		ocorrencias = append(ocorrencias, "Corrigido, original: "+original)
+	}
+
+	if dia.Inconsistente {
+		ocorrencias = append(ocorrencias, "Marcações inconsistentes")
+	}
+
+	return ocorrencias
+}
+
+// horas formata minutos como HH:MM, com sinal explícito para saldos.
+func horas(total int64, sinal bool) string {
+	prefixo := ""
+
+	if total < 0 {
+		prefixo = "-"
+		total = -total
+	} else if sinal && total > 0 {
+		prefixo = "+"
+	}
+
+	return fmt.Sprintf("%s%02d:%02d", prefixo, total/60, total%60)
+}
@@ -0,0 +1,120 @@
+package ponto
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	PDF_LARGURA = 595.28
+	PDF_ALTURA  = 841.89
+	PDF_MARGEM  = 36.0
+)
+
+// documentoPDF gera PDFs simples de texto e linhas com as fontes padrão
+// Helvetica, sem depender de bibliotecas ou serviços externos. Os textos são
+// convertidos para WinAnsiEncoding, que cobre a acentuação do português.
+type documentoPDF struct {
+	paginas []*bytes.Buffer
+}
+
+func novoDocumentoPDF() *documentoPDF {
+	documento := &documentoPDF{}
+	documento.novaPagina()
+
+	return documento
+}
+
+func (d *documentoPDF) novaPagina() {
+	d.paginas = append(d.paginas, &bytes.Buffer{})
+}
+
+func (d *documentoPDF) pagina() *bytes.Buffer {
+	return d.paginas[len(d.paginas)-1]
+}
+
+// texto escreve na página atual com a origem no canto superior esquerdo.
+func (d *documentoPDF) texto(x, y, tamanho float64, negrito bool, conteudo string) {
+	fonte := "F1"
+	if negrito {
+		fonte = "F2"
+	}
+
+	fmt.Fprintf(d.pagina(), "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", fonte, tamanho, x, PDF_ALTURA-y, escaparPDF(conteudo))
+}
+
+func (d *documentoPDF) linha(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(d.pagina(), "0.5 w %.2f %.2f m %.2f %.2f l S\n", x1, PDF_ALTURA-y1, x2, PDF_ALTURA-y2)
+}
+
+func (d *documentoPDF) bytes() []byte {
+	var saida bytes.Buffer
+	var offsets []int
+
+	objeto := func(conteudo string) {
+		offsets = append(offsets, saida.Len())
+		fmt.Fprintf(&saida, "%d 0 obj\n%s\nendobj\n", len(offsets), conteudo)
+	}
+
+	saida.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objetos 1 a 4: catálogo, árvore de páginas e fontes. Cada página ocupa
+	// dois objetos a partir do 5: a página em si e o seu conteúdo.
+	kids := make([]string, len(d.paginas))
+	for i := range d.paginas {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+i*2)
+	}
+
+	objeto("<< /Type /Catalog /Pages 2 0 R >>")
+	objeto(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.paginas)))
+	objeto("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	objeto("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, pagina := range d.paginas {
+		objeto(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			PDF_LARGURA,
+			PDF_ALTURA,
+			6+i*2,
+		))
+		objeto(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", pagina.Len(), pagina.String()))
+	}
+
+	xref := saida.Len()
+
+	fmt.Fprintf(&saida, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&saida, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&saida, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return saida.Bytes()
+}
+
+// escaparPDF converte o texto para WinAnsiEncoding e escapa os caracteres
+// reservados das strings literais do PDF.
+func escaparPDF(conteudo string) string {
+	var saida strings.Builder
+
+	for _, r := range conteudo {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			saida.WriteByte('\\')
+			saida.WriteRune(r)
+		case r == '–' || r == '—':
+			saida.WriteByte('-')
+		case r < 0x20:
+			saida.WriteByte(' ')
+		case r < 0x80:
+			saida.WriteRune(r)
+		case r >= 0xA0 && r <= 0xFF:
+			fmt.Fprintf(&saida, "\\%03o", r)
+		default:
+			saida.WriteByte('?')
+		}
+	}
+
+	return saida.String()
+}
@@ -0,0 +1,368 @@
+package ponto
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"tsukuyomi/models"
+	correcaoPonto "tsukuyomi/repositories/correcao_ponto"
+	"tsukuyomi/repositories/emprego"
+	"tsukuyomi/repositories/ponto"
+	escalaService "tsukuyomi/services/escala"
+	feriadoService "tsukuyomi/services/feriado"
+)
+
+const (
+	// CLT, art. 66
+	MINIMO_INTERJORNADA = 11 * 60
+	// CLT, art. 71
+	JORNADA_EXIGE_ALMOCO = 6 * 60
+	MINIMO_ALMOCO        = 60
+	// CLT, art. 59
+	MAXIMO_HORAS_EXTRAS = 2 * 60
+	// CLT, art. 67
+	MAXIMO_DIAS_CONSECUTIVOS = 6
+)
+
+type Service interface {
+	Apurar(ctx context.Context, id_emprego string, inicio, fim time.Time) ([]models.ApuracaoDia, error)
+	Conformidade(ctx context.Context, id_emprego string, inicio, fim time.Time) (models.RelatorioConformidade, error)
+	Espelho(ctx context.Context, id_emprego string, mes time.Time) (models.EspelhoPonto, error)
+	ImportarAFD(ctx context.Context, id_emprego string, conteudo io.Reader, cpf string) (models.ImportacaoAFD, error)
+}
+
+type service struct {
+	repository              ponto.Repository
+	EmpregoRepository       emprego.Repository
+	CorrecaoPontoRepository correcaoPonto.Repository
+	EscalaService           escalaService.Service
+}
+
+func NewService(repository ponto.Repository, empregoRepository emprego.Repository, correcaoPontoRepository correcaoPonto.Repository, escalaService escalaService.Service) Service {
+	return &service{
+		repository:              repository,
+		EmpregoRepository:       empregoRepository,
+		CorrecaoPontoRepository: correcaoPontoRepository,
+		EscalaService:           escalaService,
+	}
+}
+
+// Apurar consolida as marcações de cada dia do período com a jornada prevista,
+// calculando o tempo trabalhado, o intervalo e o saldo do dia. As correções
+// aprovadas são aplicadas antes da consolidação, e as marcações originais de
+// cada dia são mantidas na apuração.
+func (s *service) Apurar(ctx context.Context, id_emprego string, inicio, fim time.Time) ([]models.ApuracaoDia, error) {
+	previstos, err := s.EscalaService.Previsto(ctx, id_emprego, inicio, fim)
+	if err != nil {
+		return []models.ApuracaoDia{}, err
+	}
+
+	// Busca também o dia seguinte ao fim do período para fechar turnos que
+	// atravessam a meia-noite.
+	marcacoes, err := s.repository.FindByEmprego(ctx, id_emprego, inicio, fim.AddDate(0, 0, 1))
+	if err != nil {
+		return []models.ApuracaoDia{}, err
+	}
+
+	correcoes, err := s.CorrecaoPontoRepository.FindAprovadas(ctx, id_emprego, inicio, fim.AddDate(0, 0, 1))
+	if err != nil {
+		return []models.ApuracaoDia{}, err
+	}
+
+	dias := Consolidar(previstos, AplicarCorrecoes(marcacoes, correcoes))
+
+	indice := make(map[string]int, len(dias))
+	for i := range dias {
+		dias[i].Originais = []models.CartaoPonto{}
+		indice[feriadoService.Chave(dias[i].Data)] = i
+	}
+
+	for _, marcacao := range marcacoes {
+		if i, ok := indice[feriadoService.Chave(marcacao.Horario)]; ok {
+			dias[i].Originais = append(dias[i].Originais, marcacao)
+		}
+	}
+
+	for _, correcao := range correcoes {
+		if correcao.Horario != nil {
+			if i, ok := indice[feriadoService.Chave(*correcao.Horario)]; ok {
+				dias[i].Corrigido = true
+			}
+		}
+
+		if correcao.Original != nil {
+			if i, ok := indice[feriadoService.Chave(correcao.Original.Horario)]; ok {
+				dias[i].Corrigido = true
+			}
+		}
+	}
+
+	return dias, nil
+}
+
+// AplicarCorrecoes retorna as marcações com as correções aprovadas aplicadas,
+// em ordem cronológica. As marcações originais não são modificadas; as
+// incluídas ou alteradas por uma correção levam o seu ID.
+func AplicarCorrecoes(marcacoes []models.CartaoPonto, correcoes []models.CorrecaoPonto) []models.CartaoPonto {
+	porMarcacao := make(map[int64]models.CorrecaoPonto, len(correcoes))
+	ajustadas := make([]models.CartaoPonto, 0, len(marcacoes))
+
+	for _, correcao := range correcoes {
+		if correcao.IDCartaoPonto != nil {
+			porMarcacao[*correcao.IDCartaoPonto] = correcao
+		}
+	}
+
+	vistas := make(map[int64]bool, len(marcacoes))
+
+	for _, marcacao := range marcacoes {
+		vistas[marcacao.ID] = true
+
+		correcao, ok := porMarcacao[marcacao.ID]
+		if !ok {
+			ajustadas = append(ajustadas, marcacao)
+			continue
+		}
+
+		if correcao.Acao == models.CORRECAO_ALTERAR {
+			ajustadas = append(ajustadas, corrigir(marcacao, correcao))
+		}
+	}
+
+	for _, correcao := range correcoes {
+		switch {
+		case correcao.Acao == models.CORRECAO_INCLUIR:
+			ajustadas = append(ajustadas, corrigir(models.CartaoPonto{IDEmprego: correcao.IDEmprego}, correcao))
+		case correcao.Acao == models.CORRECAO_ALTERAR && correcao.Original != nil && !vistas[correcao.Original.ID]:
+			// A marcação original está fora do período, mas foi movida para dentro dele.
+			ajustadas = append(ajustadas, corrigir(*correcao.Original, correcao))
+		}
+	}
+
+	sort.SliceStable(ajustadas, func(i, j int) bool {
+		return ajustadas[i].Horario.Before(ajustadas[j].Horario)
+	})
+
+	return ajustadas
+}
+
+func corrigir(marcacao models.CartaoPonto, correcao models.CorrecaoPonto) models.CartaoPonto {
+	id := correcao.ID
+	marcacao.IDCorrecao = &id
+
+	if correcao.Horario != nil {
+		marcacao.Horario = *correcao.Horario
+	}
+
+	if correcao.Tipo != nil {
+		marcacao.Tipo = *correcao.Tipo
+	}
+
+	return marcacao
+}
+
+// Consolidar agrupa as marcações em pares de entrada e saída e distribui cada
+// par no dia da sua entrada. Saídas sem entrada e entradas sem saída marcam o
+// dia como inconsistente e não entram no tempo trabalhado.
+func Consolidar(previstos []models.JornadaPrevista, marcacoes []models.CartaoPonto) []models.ApuracaoDia {
+	dias := make([]models.ApuracaoDia, len(previstos))
+	indice := make(map[string]int, len(previstos))
+
+	for i, previsto := range previstos {
+		dias[i] = models.ApuracaoDia{
+			Data:      previsto.Data,
+			Marcacoes: []models.CartaoPonto{},
+			Previsto:  previsto,
+		}
+
+		indice[feriadoService.Chave(previsto.Data)] = i
+	}
+
+	var aberta *models.CartaoPonto
+
+	registrar := func(dia string, marcacoes ...models.CartaoPonto) *models.ApuracaoDia {
+		i, ok := indice[dia]
+		if !ok {
+			return nil
+		}
+
+		dias[i].Marcacoes = append(dias[i].Marcacoes, marcacoes...)
+
+		return &dias[i]
+	}
+
+	for i := range marcacoes {
+		marcacao := marcacoes[i]
+
+		switch marcacao.Tipo {
+		case models.PONTO_ENTRADA:
+			if aberta != nil {
+				if dia := registrar(feriadoService.Chave(aberta.Horario), *aberta); dia != nil {
+					dia.Inconsistente = true
+				}
+			}
+
+			aberta = &marcacao
+		case models.PONTO_SAIDA:
+			if aberta == nil {
+				if dia := registrar(feriadoService.Chave(marcacao.Horario), marcacao); dia != nil {
+					dia.Inconsistente = true
+				}
+
+				continue
+			}
+
+			dia := registrar(feriadoService.Chave(aberta.Horario), *aberta, marcacao)
+			if dia != nil {
+				if dia.Saida != nil {
+					dia.Intervalo += minutos(*dia.Saida, aberta.Horario)
+				}
+
+				if dia.Entrada == nil {
+					dia.Entrada = &aberta.Horario
+				}
+
+				dia.Saida = &marcacoes[i].Horario
+				dia.Trabalhado += minutos(aberta.Horario, marcacao.Horario)
+			}
+
+			aberta = nil
+		}
+	}
+
+	if aberta != nil {
+		if dia := registrar(feriadoService.Chave(aberta.Horario), *aberta); dia != nil {
+			dia.Inconsistente = true
+		}
+	}
+
+	for i := range dias {
+		dias[i].Saldo = dias[i].Trabalhado - dias[i].Previsto.Minutos
+	}
+
+	return dias
+}
+
+// Conformidade verifica as regras de jornada da CLT no período: interjornada
+// mínima, intervalo para refeição, limite de horas extras diárias e limite de
+// dias consecutivos de trabalho.
+func (s *service) Conformidade(ctx context.Context, id_emprego string, inicio, fim time.Time) (models.RelatorioConformidade, error) {
+	// Os dias anteriores ao período são necessários para medir a interjornada
+	// do primeiro dia e a sequência de dias trabalhados.
+	dias, err := s.Apurar(ctx, id_emprego, inicio.AddDate(0, 0, -MAXIMO_DIAS_CONSECUTIVOS), fim)
+	if err != nil {
+		return models.RelatorioConformidade{}, err
+	}
+
+	idEmprego, _ := strconv.ParseInt(id_emprego, 10, 64)
+
+	relatorio := models.RelatorioConformidade{
+		IDEmprego: idEmprego,
+		Inicio:    inicio,
+		Fim:       fim,
+		Dias:      VerificarJornadas(dias, inicio),
+	}
+
+	for _, dia := range relatorio.Dias {
+		relatorio.Violacoes += len(dia.Violacoes)
+	}
+
+	return relatorio, nil
+}
+
+// VerificarJornadas aplica as regras de jornada da CLT aos dias apurados e
+// retorna os dias a partir do início com alguma violação. Os dias anteriores
+// ao início servem apenas para medir a interjornada e a sequência de dias
+// trabalhados.
+func VerificarJornadas(dias []models.ApuracaoDia, inicio time.Time) []models.ConformidadeDia {
+	conformidade := []models.ConformidadeDia{}
+
+	var ultimaSaida *time.Time
+	consecutivos := int64(0)
+
+	for _, dia := range dias {
+		violacoes := []models.Violacao{}
+
+		if dia.Entrada != nil && ultimaSaida != nil {
+			descanso := minutos(*ultimaSaida, *dia.Entrada)
+			if descanso < MINIMO_INTERJORNADA {
+				violacoes = append(violacoes, models.Violacao{
+					Regra:     models.REGRA_INTERJORNADA,
+					Descricao: fmt.Sprintf("Descanso de %s entre jornadas, inferior ao mínimo de 11h.", formatarMinutos(descanso)),
+					Medido:    descanso,
+					Limite:    MINIMO_INTERJORNADA,
+					Unidade:   models.UNIDADE_VIOLACAO_MINUTOS,
+				})
+			}
+		}
+
+		if dia.Trabalhado > JORNADA_EXIGE_ALMOCO && dia.Intervalo < MINIMO_ALMOCO {
+			violacoes = append(violacoes, models.Violacao{
+				Regra:     models.REGRA_INTERVALO_ALMOCO,
+				Descricao: fmt.Sprintf("Intervalo de %s em jornada de %s, inferior ao mínimo de 1h.", formatarMinutos(dia.Intervalo), formatarMinutos(dia.Trabalhado)),
+				Medido:    dia.Intervalo,
+				Limite:    MINIMO_ALMOCO,
+				Unidade:   models.UNIDADE_VIOLACAO_MINUTOS,
+			})
+		}
+
+		if extras := dia.Trabalhado - dia.Previsto.Minutos; extras > MAXIMO_HORAS_EXTRAS {
+			violacoes = append(violacoes, models.Violacao{
+				Regra:     models.REGRA_HORAS_EXTRAS,
+				Descricao: fmt.Sprintf("%s de horas extras no dia, acima do limite de 2h.", formatarMinutos(extras)),
+				Medido:    extras,
+				Limite:    MAXIMO_HORAS_EXTRAS,
+				Unidade:   models.UNIDADE_VIOLACAO_MINUTOS,
+			})
+		}
+
+		if dia.Trabalhado > 0 {
+			consecutivos++
+		} else {
+			consecutivos = 0
+		}
+
+		if consecutivos > MAXIMO_DIAS_CONSECUTIVOS {
+			violacoes = append(violacoes, models.Violacao{
+				Regra:     models.REGRA_DIAS_CONSECUTIVOS,
+				Descricao: fmt.Sprintf("%d dias consecutivos de trabalho sem descanso semanal.", consecutivos),
+				Medido:    consecutivos,
+				Limite:    MAXIMO_DIAS_CONSECUTIVOS,
+				Unidade:   models.UNIDADE_VIOLACAO_DIAS,
+			})
+		}
+
+		if dia.Saida != nil {
+			ultimaSaida = dia.Saida
+		}
+
+		if dia.Data.Before(inicio) || len(violacoes) == 0 {
+			continue
+		}
+
+		conformidade = append(conformidade, models.ConformidadeDia{
+			Data:      dia.Data,
+			Violacoes: violacoes,
+		})
+	}
+
+	return conformidade
+}
+
+func minutos(de, ate time.Time) int64 {
+	return int64(ate.Sub(de).Minutes())
+}
+
+func formatarMinutos(total int64) string {
+	sinal := ""
+	if total < 0 {
+		sinal = "-"
+		total = -total
+	}
+
+	return fmt.Sprintf("%s%dh%02d", sinal, total/60, total%60)
+}
@@ -0,0 +1,227 @@
+package ponto
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"tsukuyomi/models"
+)
+
+func horario(dia int, hora, minuto int) time.Time {
+	return time.Date(2026, time.March, dia, hora, minuto, 0, 0, time.Local)
+}
+
+func marcacao(tipo string, dia, hora, minuto int) models.CartaoPonto {
+	return models.CartaoPonto{Tipo: tipo, Horario: horario(dia, hora, minuto)}
+}
+
+func TestConsolidar(t *testing.T) {
+	previstos := []models.JornadaPrevista{
+		{Data: horario(2, 0, 0), Minutos: 480},
+		{Data: horario(3, 0, 0), Minutos: 480},
+	}
+
+	casos := []struct {
+		nome          string
+		marcacoes     []models.CartaoPonto
+		trabalhado    int64
+		intervalo     int64
+		saldo         int64
+		entrada       *time.Time
+		saida         *time.Time
+		inconsistente bool
+	}{
+		{
+			nome: "jornada com almoço",
+			marcacoes: []models.CartaoPonto{
+				marcacao(models.PONTO_ENTRADA, 2, 8, 0),
+				marcacao(models.PONTO_SAIDA, 2, 12, 0),
+				marcacao(models.PONTO_ENTRADA, 2, 13, 0),
+				marcacao(models.PONTO_SAIDA, 2, 17, 30),
+			},
+			trabalhado: 510,
+			intervalo:  60,
+			saldo:      30,
+			entrada:    ptr(horario(2, 8, 0)),
+			saida:      ptr(horario(2, 17, 30)),
+		},
+		{
+			nome: "jornada noturna conta no dia da entrada",
+			marcacoes: []models.CartaoPonto{
+				marcacao(models.PONTO_ENTRADA, 2, 22, 0),
+				marcacao(models.PONTO_SAIDA, 3, 5, 0),
+			},
+			trabalhado: 420,
+			saldo:      -60,
+			entrada:    ptr(horario(2, 22, 0)),
+			saida:      ptr(horario(3, 5, 0)),
+		},
+		{
+			nome: "entrada sem saída",
+			marcacoes: []models.CartaoPonto{
+				marcacao(models.PONTO_ENTRADA, 2, 8, 0),
+			},
+			saldo:         -480,
+			inconsistente: true,
+		},
+		{
+			nome: "saída sem entrada",
+			marcacoes: []models.CartaoPonto{
+				marcacao(models.PONTO_SAIDA, 2, 17, 0),
+			},
+			saldo:         -480,
+			inconsistente: true,
+		},
+		{
+			nome: "entrada repetida",
+			marcacoes: []models.CartaoPonto{
+				marcacao(models.PONTO_ENTRADA, 2, 8, 0),
+				marcacao(models.PONTO_ENTRADA, 2, 9, 0),
+				marcacao(models.PONTO_SAIDA, 2, 17, 0),
+			},
+			trabalhado:    480,
+			entrada:       ptr(horario(2, 9, 0)),
+			saida:         ptr(horario(2, 17, 0)),
+			inconsistente: true,
+		},
+		{
+			nome: "marcação fora do período",
+			marcacoes: []models.CartaoPonto{
+				marcacao(models.PONTO_ENTRADA, 1, 8, 0),
+				marcacao(models.PONTO_SAIDA, 1, 17, 0),
+			},
+			saldo: -480,
+		},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			dias := Consolidar(previstos, caso.marcacoes)
+			if len(dias) != len(previstos) {
+				t.Fatalf("Consolidar retornou %d dias, esperado %d", len(dias), len(previstos))
+			}
+
+			dia := dias[0]
+			if dia.Trabalhado != caso.trabalhado || dia.Intervalo != caso.intervalo || dia.Saldo != caso.saldo {
+				t.Errorf("trabalhado, intervalo e saldo = %d, %d, %d, esperado %d, %d, %d", dia.Trabalhado, dia.Intervalo, dia.Saldo, caso.trabalhado, caso.intervalo, caso.saldo)
+			}
+
+			if !mesmoHorario(dia.Entrada, caso.entrada) || !mesmoHorario(dia.Saida, caso.saida) {
+				t.Errorf("entrada e saída = %v, %v, esperado %v, %v", dia.Entrada, dia.Saida, caso.entrada, caso.saida)
+			}
+
+			if dia.Inconsistente != caso.inconsistente {
+				t.Errorf("inconsistente = %t, esperado %t", dia.Inconsistente, caso.inconsistente)
+			}
+
+			if dias[1].Trabalhado != 0 || dias[1].Saldo != -480 {
+				t.Errorf("segundo dia com trabalhado %d e saldo %d, esperado 0 e -480", dias[1].Trabalhado, dias[1].Saldo)
+			}
+		})
+	}
+}
+
+func TestVerificarJornadas(t *testing.T) {
+	dia := func(data, entrada, saida time.Time, trabalhado, intervalo int64) models.ApuracaoDia {
+		return models.ApuracaoDia{
+			Data:       data,
+			Previsto:   models.JornadaPrevista{Data: data, Minutos: 480},
+			Trabalhado: trabalhado,
+			Intervalo:  intervalo,
+			Entrada:    &entrada,
+			Saida:      &saida,
+		}
+	}
+
+	normal := func(d int) models.ApuracaoDia {
+		return dia(horario(d, 0, 0), horario(d, 8, 0), horario(d, 17, 0), 480, 60)
+	}
+
+	folga := func(d int) models.ApuracaoDia {
+		return models.ApuracaoDia{
+			Data:     horario(d, 0, 0),
+			Previsto: models.JornadaPrevista{Data: horario(d, 0, 0)},
+		}
+	}
+
+	casos := []struct {
+		nome     string
+		dias     []models.ApuracaoDia
+		inicio   int
+		esperado map[int][]string
+	}{
+		{
+			nome:     "jornadas regulares",
+			dias:     []models.ApuracaoDia{normal(2), normal(3), normal(4)},
+			inicio:   2,
+			esperado: map[int][]string{},
+		},
+		{
+			nome: "interjornada inferior a 11h",
+			dias: []models.ApuracaoDia{
+				dia(horario(2, 0, 0), horario(2, 13, 0), horario(2, 22, 0), 480, 60),
+				dia(horario(3, 0, 0), horario(3, 8, 0), horario(3, 17, 0), 480, 60),
+			},
+			inicio:   2,
+			esperado: map[int][]string{3: {models.REGRA_INTERJORNADA}},
+		},
+		{
+			nome: "intervalo de almoço curto",
+			dias: []models.ApuracaoDia{
+				dia(horario(2, 0, 0), horario(2, 8, 0), horario(2, 15, 30), 420, 30),
+				dia(horario(3, 0, 0), horario(3, 8, 0), horario(3, 14, 0), 360, 0),
+			},
+			inicio:   2,
+			esperado: map[int][]string{2: {models.REGRA_INTERVALO_ALMOCO}},
+		},
+		{
+			nome: "horas extras acima de 2h",
+			dias: []models.ApuracaoDia{
+				dia(horario(2, 0, 0), horario(2, 8, 0), horario(2, 19, 0), 600, 60),
+				dia(horario(3, 0, 0), horario(3, 8, 0), horario(3, 19, 30), 630, 60),
+			},
+			inicio:   2,
+			esperado: map[int][]string{3: {models.REGRA_HORAS_EXTRAS}},
+		},
+		{
+			nome:     "sétimo dia consecutivo",
+			dias:     []models.ApuracaoDia{normal(2), normal(3), normal(4), normal(5), normal(6), normal(7), normal(8), folga(9), normal(10)},
+			inicio:   2,
+			esperado: map[int][]string{8: {models.REGRA_DIAS_CONSECUTIVOS}},
+		},
+		{
+			nome:     "dias anteriores ao início contam na sequência",
+			dias:     []models.ApuracaoDia{normal(2), normal(3), normal(4), normal(5), normal(6), normal(7), normal(8), normal(9)},
+			inicio:   9,
+			esperado: map[int][]string{9: {models.REGRA_DIAS_CONSECUTIVOS}},
+		},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			obtido := make(map[int][]string)
+			for _, conformidade := range VerificarJornadas(caso.dias, horario(caso.inicio, 0, 0)) {
+				for _, violacao := range conformidade.Violacoes {
+					obtido[conformidade.Data.Day()] = append(obtido[conformidade.Data.Day()], violacao.Regra)
+				}
+			}
+
+			if !reflect.DeepEqual(obtido, caso.esperado) {
+				t.Errorf("VerificarJornadas = %v, esperado %v", obtido, caso.esperado)
+			}
+		})
+	}
+}
+
+func ptr(horario time.Time) *time.Time {
+	return &horario
+}
+
+func mesmoHorario(obtido, esperado *time.Time) bool {
+	if obtido == nil || esperado == nil {
+		return obtido == esperado
+	}
+
+	return obtido.Equal(*esperado)
+}
@@ -0,0 +1,91 @@
+package relatorio
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"tsukuyomi/models"
+)
+
+// RendimentosCSV exporta o informe em CSV separado por ponto e vírgula, com
+// uma linha por fonte pagadora e as colunas na ordem da ficha "Rendimentos
+// tributáveis recebidos de pessoa jurídica" da DIRPF, seguidas dos
+// rendimentos de tributação exclusiva e isentos.
+func RendimentosCSV(informe models.InformeRendimentos) ([]byte, error) {
+	var saida bytes.Buffer
+
+	saida.WriteString("\xef\xbb\xbf")
+
+	escritor := csv.NewWriter(&saida)
+	escritor.Comma = ';'
+
+	linhas := [][]string{
+		{"Ano-calendário", strconv.Itoa(informe.Ano)},
+		{},
+		{
+			"CNPJ da fonte pagadora",
+			"Nome da fonte pagadora",
+			"Rendimentos recebidos de pessoa jurídica",
+			"Contribuição previdenciária oficial",
+			"Imposto retido na fonte",
+			"13º salário",
+			"IRRF sobre 13º salário",
+			"Participação nos lucros ou resultados",
+			"IRRF sobre participação nos lucros",
+			"Rendimentos isentos e não tributáveis",
+		},
+	}
+
+	for _, fonte := range informe.Fontes {
+		linhas = append(linhas, colunas(formatarCNPJ(fonte.CNPJ), fonte.Nome, fonte))
+	}
+
+	linhas = append(linhas, colunas("Total", "", informe.Totais))
+
+	if err := escritor.WriteAll(linhas); err != nil {
+		return nil, err
+	}
+
+	return saida.Bytes(), nil
+}
+
+func colunas(cnpj, nome string, fonte models.RendimentosFonte) []string {
+	return []string{
+		cnpj,
+		nome,
+		reais(fonte.RendimentosTributaveis),
+		reais(fonte.ContribuicaoPrevidenciaria),
+		reais(fonte.ImpostoRetido),
+		reais(fonte.DecimoTerceiro),
+		reais(fonte.IRRFDecimoTerceiro),
+		reais(fonte.ParticipacaoLucros),
+		reais(fonte.IRRFParticipacaoLucros),
+		reais(fonte.RendimentosIsentos),
+	}
+}
+
+// reais formata o valor com vírgula decimal, como no programa da DIRPF.
+func reais(valor models.Dinheiro) string {
+	return strings.Replace(valor.Arredondar(models.ARREDONDAR_MEIO_ACIMA).String(), ".", ",", 1)
+}
+
+func formatarCNPJ(cnpj string) string {
+	var digitos strings.Builder
+
+	for _, r := range cnpj {
+		if r >= '0' && r <= '9' {
+			digitos.WriteRune(r)
+		}
+	}
+
+	if digitos.Len() != 14 {
+		return cnpj
+	}
+
+	d := digitos.String()
+
+	return fmt.Sprintf("%s.%s.%s/%s-%s", d[0:2], d[2:5], d[5:8], d[8:12], d[12:14])
+}
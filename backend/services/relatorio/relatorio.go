@@ -0,0 +1,275 @@
+package relatorio
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/cotacao"
+	"tsukuyomi/repositories/holerite"
+	cotacaoService "tsukuyomi/services/cotacao"
+	"tsukuyomi/services/texto"
+)
+
+type Service interface {
+	Rendimentos(ctx context.Context, ano int) (models.InformeRendimentos, error)
+}
+
+type service struct {
+	HoleriteRepository holerite.Repository
+	CotacaoRepository  cotacao.Repository
+}
+
+func NewService(holeriteRepository holerite.Repository, cotacaoRepository cotacao.Repository) Service {
+	return &service{
+		HoleriteRepository: holeriteRepository,
+		CotacaoRepository:  cotacaoRepository,
+	}
+}
+
+// Termos que identificam as linhas do holerite pela descrição, já
+// normalizados.
+var (
+	termosINSS         = []string{"inss", " i n s s ", "contribuicao previdenciaria", "previdencia social"}
+	termosIRRF         = []string{" irrf ", " i r r f ", " ir ", "imposto de renda", " irpf "}
+	termosParticipacao = []string{"plr", "ppr", "participacao nos lucros", "participacao lucros", "participacao nos resultados"}
+	termosDecimo       = []string{"13o", "13 o", "13 salario", "decimo terceiro", "gratificacao natalina"}
+	termosAdiantamento = []string{"adiantamento", "vale salarial", "1a parcela", "primeira parcela"}
+	termosIsentos      = []string{
+		"indenizad", "indenizacao", "abono pecuniario", "salario familia", "vale transporte",
+		"auxilio creche", "diaria", "ajuda de custo", "reembolso", "multa fgts", "multa rescisoria",
+	}
+)
+
+// Rendimentos monta o informe de rendimentos do ano, agrupando as linhas dos
+// holerites pagos no ano pelo CNPJ da empresa. Como a DIRPF segue o regime de
+// caixa, o holerite de dezembro pago em janeiro entra no ano seguinte; sem
+// data de pagamento, vale o mês de referência. Holerites em moeda estrangeira
+// são convertidos para reais pela PTAX de compra da data de pagamento.
+func (s *service) Rendimentos(ctx context.Context, ano int) (models.InformeRendimentos, error) {
+	holerites, err := s.HoleriteRepository.FindByAno(ctx, ano)
+	if err != nil {
+		return models.InformeRendimentos{}, err
+	}
+
+	conversor := cotacaoService.NovoConversor(s.CotacaoRepository)
+
+	var conversoes []models.ConversaoHolerite
+
+	for i := range holerites {
+		if holerites[i].Moeda == "" || holerites[i].Moeda == models.MOEDA_BRL {
+			continue
+		}
+
+		conversao, err := conversor.Converter(ctx, holerites[i].Moeda, DataPagamento(holerites[i]))
+		if err != nil {
+			return models.InformeRendimentos{}, err
+		}
+
+		for j := range holerites[i].Detalhamento {
+			holerites[i].Detalhamento[j].Valor = holerites[i].Detalhamento[j].Valor.Multiplicar(conversao.Taxa)
+		}
+
+		conversoes = append(conversoes, models.ConversaoHolerite{
+			IDHolerite: holerites[i].ID,
+			Conversao:  conversao,
+		})
+	}
+
+	informe := Informe(ano, holerites)
+	informe.Conversoes = conversoes
+
+	return informe, nil
+}
+
+// DataPagamento retorna a data de pagamento do holerite ou, quando não
+// informada, o último dia do mês de referência.
+func DataPagamento(holerite models.Holerite) time.Time {
+	if holerite.DataPagamento != nil {
+		return *holerite.DataPagamento
+	}
+
+	return time.Date(holerite.Referencia.Year(), holerite.Referencia.Month()+1, 0, 0, 0, 0, 0, time.Local)
+}
+
+// Informe consolida os holerites por fonte pagadora, na ordem em que as fontes
+// aparecem, e calcula os totais do ano.
+func Informe(ano int, holerites []models.Holerite) models.InformeRendimentos {
+	informe := models.InformeRendimentos{
+		Ano:    ano,
+		Fontes: []models.RendimentosFonte{},
+	}
+
+	indice := make(map[string]int)
+	inssDecimo := make(map[string]models.Dinheiro)
+
+	for _, holerite := range holerites {
+		cnpj := holerite.Empresa.CNPJ
+
+		i, ok := indice[cnpj]
+		if !ok {
+			i = len(informe.Fontes)
+			indice[cnpj] = i
+			informe.Fontes = append(informe.Fontes, models.RendimentosFonte{
+				CNPJ: cnpj,
+				Nome: holerite.Empresa.Nome,
+			})
+		}
+
+		fonte := &informe.Fontes[i]
+		fonte.Holerites++
+
+		for _, linha := range holerite.Detalhamento {
+			switch Classificar(linha) {
+			case models.RENDIMENTO_TRIBUTAVEL:
+				fonte.RendimentosTributaveis += linha.Valor
+			case models.RENDIMENTO_INSS:
+				fonte.ContribuicaoPrevidenciaria += linha.Valor
+			case models.RENDIMENTO_IRRF:
+				fonte.ImpostoRetido += linha.Valor
+			case models.RENDIMENTO_DECIMO_TERCEIRO:
+				fonte.DecimoTerceiro += linha.Valor
+			case models.RENDIMENTO_INSS_DECIMO_TERCEIRO:
+				inssDecimo[cnpj] += linha.Valor
+			case models.RENDIMENTO_IRRF_DECIMO_TERCEIRO:
+				fonte.IRRFDecimoTerceiro += linha.Valor
+			case models.RENDIMENTO_PARTICIPACAO_LUCROS:
+				fonte.ParticipacaoLucros += linha.Valor
+			case models.RENDIMENTO_IRRF_PARTICIPACAO:
+				fonte.IRRFParticipacaoLucros += linha.Valor
+			case models.RENDIMENTO_ISENTO:
+				fonte.RendimentosIsentos += linha.Valor
+			}
+		}
+	}
+
+	for i := range informe.Fontes {
+		fonte := &informe.Fontes[i]
+
+		fonte.DecimoTerceiro = max(fonte.DecimoTerceiro-inssDecimo[fonte.CNPJ], 0)
+
+		arredondar(fonte)
+
+		informe.Totais.RendimentosTributaveis += fonte.RendimentosTributaveis
+		informe.Totais.ContribuicaoPrevidenciaria += fonte.ContribuicaoPrevidenciaria
+		informe.Totais.ImpostoRetido += fonte.ImpostoRetido
+		informe.Totais.DecimoTerceiro += fonte.DecimoTerceiro
+		informe.Totais.IRRFDecimoTerceiro += fonte.IRRFDecimoTerceiro
+		informe.Totais.ParticipacaoLucros += fonte.ParticipacaoLucros
+		informe.Totais.IRRFParticipacaoLucros += fonte.IRRFParticipacaoLucros
+		informe.Totais.RendimentosIsentos += fonte.RendimentosIsentos
+		informe.Totais.Holerites += fonte.Holerites
+	}
+
+	arredondar(&informe.Totais)
+
+	return informe
+}
+
+// Classificar identifica a categoria de uma linha do holerite pela rubrica
+// ou, nas linhas ainda sem rubrica, pela descrição. Créditos não
+// identificados são tributáveis, e débitos não identificados, como
+// vale-transporte e plano de saúde, não entram no informe. Como nas rubricas,
+// adiantamentos, inclusive o do 13º salário, não entram no informe.
+func Classificar(linha models.DetalhamentoHolerite) string {
+	if linha.Rubrica != nil {
+		return classificarRubrica(*linha.Rubrica)
+	}
+
+	descricao := " " + texto.Normalizar(linha.Descricao) + " "
+
+	decimo := contem(descricao, termosDecimo)
+	participacao := contem(descricao, termosParticipacao)
+
+	if linha.Tipo == models.HOLERITE_DEBITO {
+		inss := contem(descricao, termosINSS)
+		irrf := contem(descricao, termosIRRF)
+
+		switch {
+		case inss && decimo:
+			return models.RENDIMENTO_INSS_DECIMO_TERCEIRO
+		case inss:
+			return models.RENDIMENTO_INSS
+		case irrf && decimo:
+			return models.RENDIMENTO_IRRF_DECIMO_TERCEIRO
+		case irrf && participacao:
+			return models.RENDIMENTO_IRRF_PARTICIPACAO
+		case irrf:
+			return models.RENDIMENTO_IRRF
+		}
+
+		return models.RENDIMENTO_OUTROS
+	}
+
+	switch {
+	case contem(descricao, termosAdiantamento):
+		return models.RENDIMENTO_OUTROS
+	case participacao:
+		return models.RENDIMENTO_PARTICIPACAO_LUCROS
+	case decimo:
+		return models.RENDIMENTO_DECIMO_TERCEIRO
+	case contem(descricao, termosIsentos):
+		return models.RENDIMENTO_ISENTO
+	}
+
+	return models.RENDIMENTO_TRIBUTAVEL
+}
+
+// classificarRubrica traduz a categoria da rubrica para o informe. Créditos
+// sem incidência de IRRF são rendimentos isentos, exceto adiantamentos, que
+// são descontados no pagamento do mês.
+func classificarRubrica(rubrica models.Rubrica) string {
+	switch rubrica.Categoria {
+	case models.RUBRICA_INSS:
+		return models.RENDIMENTO_INSS
+	case models.RUBRICA_INSS_DECIMO_TERCEIRO:
+		return models.RENDIMENTO_INSS_DECIMO_TERCEIRO
+	case models.RUBRICA_IRRF:
+		return models.RENDIMENTO_IRRF
+	case models.RUBRICA_IRRF_DECIMO_TERCEIRO:
+		return models.RENDIMENTO_IRRF_DECIMO_TERCEIRO
+	case models.RUBRICA_IRRF_PARTICIPACAO_LUCROS:
+		return models.RENDIMENTO_IRRF_PARTICIPACAO
+	}
+
+	if rubrica.Tipo == models.HOLERITE_DEBITO || rubrica.Categoria == models.RUBRICA_ADIANTAMENTO {
+		return models.RENDIMENTO_OUTROS
+	}
+
+	switch {
+	case rubrica.Categoria == models.RUBRICA_PARTICIPACAO_LUCROS:
+		return models.RENDIMENTO_PARTICIPACAO_LUCROS
+	case rubrica.Categoria == models.RUBRICA_DECIMO_TERCEIRO:
+		return models.RENDIMENTO_DECIMO_TERCEIRO
+	case !rubrica.IncideIRRF:
+		return models.RENDIMENTO_ISENTO
+	}
+
+	return models.RENDIMENTO_TRIBUTAVEL
+}
+
+func contem(descricao string, termos []string) bool {
+	for _, termo := range termos {
+		if strings.Contains(descricao, termo) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func arredondar(fonte *models.RendimentosFonte) {
+	for _, valor := range []*models.Dinheiro{
+		&fonte.RendimentosTributaveis,
+		&fonte.ContribuicaoPrevidenciaria,
+		&fonte.ImpostoRetido,
+		&fonte.DecimoTerceiro,
+		&fonte.IRRFDecimoTerceiro,
+		&fonte.ParticipacaoLucros,
+		&fonte.IRRFParticipacaoLucros,
+		&fonte.RendimentosIsentos,
+	} {
+		*valor = valor.Arredondar(models.ARREDONDAR_MEIO_ACIMA)
+	}
+}
@@ -0,0 +1,96 @@
+package remuneracao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/emprego"
+	empregoOcupacao "tsukuyomi/repositories/emprego_ocupacao"
+	"tsukuyomi/repositories/ocupacao"
+	"tsukuyomi/repositories/remuneracao"
+	empregoOcupacaoService "tsukuyomi/services/emprego_ocupacao"
+)
+
+const (
+	ERROR_EMPREGO_NOT_FOUND  = "emprego não encontrado"
+	ERROR_OCUPACAO_NOT_FOUND = "ocupação não encontrada"
+	ERROR_SEM_OCUPACAO       = "nenhuma ocupação vigente no emprego na data informada; informe o ID da ocupação"
+)
+
+type Service interface {
+	Create(ctx context.Context, remuneracao models.Remuneracao) (models.Remuneracao, error)
+	FindByEmprego(ctx context.Context, id_emprego string) ([]models.Remuneracao, error)
+	Delete(ctx context.Context, id_emprego, id string) error
+}
+
+type service struct {
+	repository                remuneracao.Repository
+	EmpregoRepository         emprego.Repository
+	OcupacaoRepository        ocupacao.Repository
+	EmpregoOcupacaoRepository empregoOcupacao.Repository
+}
+
+func NewService(repository remuneracao.Repository, empregoRepository emprego.Repository, ocupacaoRepository ocupacao.Repository, empregoOcupacaoRepository empregoOcupacao.Repository) Service {
+	return &service{
+		repository:                repository,
+		EmpregoRepository:         empregoRepository,
+		OcupacaoRepository:        ocupacaoRepository,
+		EmpregoOcupacaoRepository: empregoOcupacaoRepository,
+	}
+}
+
+// Create registra uma remuneração no histórico salarial do emprego. Sem a
+// ocupação informada, a remuneração é vinculada à ocupação vigente na data.
+func (s *service) Create(ctx context.Context, remuneracao models.Remuneracao) (models.Remuneracao, error) {
+	id_emprego := fmt.Sprint(remuneracao.IDEmprego)
+
+	emprego, err := s.EmpregoRepository.FindByID(ctx, id_emprego)
+	if err != nil {
+		return models.Remuneracao{}, err
+	}
+
+	if emprego.ID == 0 {
+		return models.Remuneracao{}, errors.New(ERROR_EMPREGO_NOT_FOUND)
+	}
+
+	if remuneracao.Moeda == "" {
+		remuneracao.Moeda = emprego.Moeda
+	}
+
+	if remuneracao.IDOcupacao == 0 {
+		historico, err := s.EmpregoOcupacaoRepository.FindByEmprego(ctx, id_emprego)
+		if err != nil {
+			return models.Remuneracao{}, err
+		}
+
+		i := empregoOcupacaoService.Vigente(historico, remuneracao.Data)
+		if i < 0 {
+			return models.Remuneracao{}, errors.New(ERROR_SEM_OCUPACAO)
+		}
+
+		remuneracao.IDOcupacao = historico[i].IDOcupacao
+	}
+
+	ocupacao, err := s.OcupacaoRepository.FindByID(ctx, fmt.Sprint(remuneracao.IDOcupacao))
+	if err != nil {
+		return models.Remuneracao{}, err
+	}
+
+	if ocupacao.ID == 0 {
+		return models.Remuneracao{}, errors.New(ERROR_OCUPACAO_NOT_FOUND)
+	}
+
+	remuneracao.Ocupacao = &ocupacao
+
+	return s.repository.Create(ctx, remuneracao)
+}
+
+func (s *service) FindByEmprego(ctx context.Context, id_emprego string) ([]models.Remuneracao, error) {
+	return s.repository.FindByEmprego(ctx, id_emprego)
+}
+
+func (s *service) Delete(ctx context.Context, id_emprego, id string) error {
+	return s.repository.Delete(ctx, id_emprego, id)
+}
@@ -0,0 +1,55 @@
+package rubrica
+
+import (
+	"tsukuyomi/models"
+)
+
+// Padrao retorna o catálogo padrão de rubricas, com as incidências usuais de
+// INSS, IRRF e FGTS e os termos mais comuns nas descrições dos holerites.
+func Padrao() []models.Rubrica {
+	credito := models.HOLERITE_CREDITO
+	debito := models.HOLERITE_DEBITO
+
+	return []models.Rubrica{
+		nova("SALARIO", "Salário base", credito, models.RUBRICA_SALARIO, true, true, true, "salario;salario base;salario mensal;ordenado;horas normais;dias trabalhados;horas trabalhadas"),
+		nova("HORA_EXTRA", "Horas extras", credito, models.RUBRICA_HORA_EXTRA, true, true, true, "hora extra;horas extras;he;extra 50;extra 100;extras"),
+		nova("DSR", "Descanso semanal remunerado", credito, models.RUBRICA_ADICIONAL, true, true, true, "dsr;repouso remunerado;descanso semanal"),
+		nova("ADICIONAL_NOTURNO", "Adicional noturno", credito, models.RUBRICA_ADICIONAL, true, true, true, "noturno;adicional noturno;adic noturno"),
+		nova("INSALUBRIDADE", "Adicional de insalubridade", credito, models.RUBRICA_ADICIONAL, true, true, true, "insalubridade"),
+		nova("PERICULOSIDADE", "Adicional de periculosidade", credito, models.RUBRICA_ADICIONAL, true, true, true, "periculosidade"),
+		nova("GRATIFICACAO", "Gratificação", credito, models.RUBRICA_ADICIONAL, true, true, true, "gratificacao;gratificacao funcao;bonus"),
+		nova("COMISSAO", "Comissões", credito, models.RUBRICA_COMISSAO, true, true, true, "comissao;comissoes"),
+		nova("FERIAS", "Férias", credito, models.RUBRICA_FERIAS, true, true, true, "ferias;1 3 ferias;terco ferias;terco constitucional"),
+		nova("ABONO_PECUNIARIO", "Abono pecuniário de férias", credito, models.RUBRICA_FERIAS, false, false, false, "abono pecuniario;venda ferias"),
+		nova("DECIMO_TERCEIRO", "13º salário", credito, models.RUBRICA_DECIMO_TERCEIRO, true, true, true, "13o salario;13 salario;decimo terceiro;gratificacao natalina;13o;13o salario parcela"),
+		nova("PLR", "Participação nos lucros ou resultados", credito, models.RUBRICA_PARTICIPACAO_LUCROS, false, true, false, "plr;ppr;participacao lucros;participacao resultados"),
+		nova("SALARIO_FAMILIA", "Salário-família", credito, models.RUBRICA_BENEFICIO, false, false, false, "salario familia"),
+		nova("REEMBOLSO", "Reembolso de despesas", credito, models.RUBRICA_REEMBOLSO, false, false, false, "reembolso;ajuda custo;diaria;diarias"),
+		nova("ADIANTAMENTO", "Adiantamento salarial", credito, models.RUBRICA_ADIANTAMENTO, false, false, false, "adiantamento;adiantamento salarial;vale salarial"),
+		nova("ADIANTAMENTO_13", "Adiantamento do 13º salário", credito, models.RUBRICA_ADIANTAMENTO, false, false, false, "adiantamento 13o;adiantamento 13o salario;adiantamento 13 salario;1a parcela 13o;primeira parcela 13o;13o salario 1a parcela"),
+		nova("DESC_ADIANTAMENTO", "Desconto de adiantamento", debito, models.RUBRICA_ADIANTAMENTO, false, false, false, "adiantamento;adiantamento salarial;desconto adiantamento;vale salarial"),
+		nova("FALTAS", "Faltas e atrasos", debito, models.RUBRICA_FALTA, true, true, true, "falta;faltas;atraso;atrasos;horas faltas"),
+		nova("INSS", "INSS", debito, models.RUBRICA_INSS, false, false, false, "inss;contribuicao previdenciaria;previdencia social"),
+		nova("INSS_13", "INSS sobre 13º salário", debito, models.RUBRICA_INSS_DECIMO_TERCEIRO, false, false, false, "inss 13o;inss 13 salario;inss decimo terceiro;inss gratificacao natalina"),
+		nova("IRRF", "IRRF", debito, models.RUBRICA_IRRF, false, false, false, "irrf;ir;irpf;imposto renda;ir fonte"),
+		nova("IRRF_13", "IRRF sobre 13º salário", debito, models.RUBRICA_IRRF_DECIMO_TERCEIRO, false, false, false, "irrf 13o;ir 13o;irrf 13 salario;irrf decimo terceiro;imposto renda 13o"),
+		nova("IRRF_PLR", "IRRF sobre PLR", debito, models.RUBRICA_IRRF_PARTICIPACAO_LUCROS, false, false, false, "irrf plr;ir plr;irrf ppr;ir ppr;irrf participacao lucros;imposto renda plr"),
+		nova("VALE_TRANSPORTE", "Vale-transporte", debito, models.RUBRICA_VALE_TRANSPORTE, false, false, false, "vale transporte;vt;transporte"),
+		nova("VALE_REFEICAO", "Vale-refeição e alimentação", debito, models.RUBRICA_VALE_REFEICAO, false, false, false, "vale refeicao;vale alimentacao;vr;va;refeicao;alimentacao"),
+		nova("PLANO_SAUDE", "Plano de saúde", debito, models.RUBRICA_PLANO_SAUDE, false, false, false, "plano saude;assistencia medica;convenio medico;plano odontologico;assistencia odontologica;coparticipacao"),
+		nova("PENSAO", "Pensão alimentícia", debito, models.RUBRICA_PENSAO_ALIMENTICIA, false, true, false, "pensao;pensao alimenticia"),
+	}
+}
+
+func nova(codigo, nome, tipo, categoria string, inss, irrf, fgts bool, termos string) models.Rubrica {
+	return models.Rubrica{
+		Codigo:     codigo,
+		Nome:       nome,
+		Tipo:       tipo,
+		Categoria:  categoria,
+		IncideINSS: inss,
+		IncideIRRF: irrf,
+		IncideFGTS: fgts,
+		Termos:     &termos,
+	}
+}
@@ -0,0 +1,157 @@
+package rubrica
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/rubrica"
+	"tsukuyomi/services/texto"
+)
+
+type Service interface {
+	Create(ctx context.Context, rubrica models.Rubrica) (models.Rubrica, error)
+	FindAll(ctx context.Context, search, tipo, categoria string) ([]models.Rubrica, error)
+	FindByID(ctx context.Context, id string) (models.Rubrica, error)
+	Update(ctx context.Context, rubrica models.Rubrica) error
+	Delete(ctx context.Context, id string) error
+	ImportarPadrao(ctx context.Context) (models.ImportacaoRubricas, error)
+}
+
+type service struct {
+	repository rubrica.Repository
+}
+
+func NewService(repository rubrica.Repository) Service {
+	return &service{
+		repository: repository,
+	}
+}
+
+func (s *service) Create(ctx context.Context, rubrica models.Rubrica) (models.Rubrica, error) {
+	rubrica.Codigo = strings.ToUpper(strings.TrimSpace(rubrica.Codigo))
+
+	return s.repository.Create(ctx, rubrica)
+}
+
+func (s *service) FindAll(ctx context.Context, search, tipo, categoria string) ([]models.Rubrica, error) {
+	return s.repository.FindAll(ctx, search, tipo, categoria)
+}
+
+func (s *service) FindByID(ctx context.Context, id string) (models.Rubrica, error) {
+	return s.repository.FindByID(ctx, id)
+}
+
+func (s *service) Update(ctx context.Context, rubrica models.Rubrica) error {
+	rubrica.Codigo = strings.ToUpper(strings.TrimSpace(rubrica.Codigo))
+
+	return s.repository.Update(ctx, rubrica)
+}
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	return s.repository.Delete(ctx, id)
+}
+
+// ImportarPadrao cadastra as rubricas do catálogo padrão que ainda não
+// existem. Rubricas já cadastradas com o mesmo código não são alteradas.
+func (s *service) ImportarPadrao(ctx context.Context) (models.ImportacaoRubricas, error) {
+	rubricas := Padrao()
+	criado := time.Now()
+
+	for i := range rubricas {
+		rubricas[i].Criado = criado
+	}
+
+	inseridas, err := s.repository.Importar(ctx, rubricas)
+	if err != nil {
+		return models.ImportacaoRubricas{}, err
+	}
+
+	return models.ImportacaoRubricas{
+		Importadas: inseridas,
+		Existentes: len(rubricas) - inseridas,
+	}, nil
+}
+
+// Classificar escolhe a rubrica do mesmo tipo da linha cujo nome ou termo
+// tenha todas as palavras presentes na descrição. Vence o termo com mais
+// palavras, de forma que "INSS s/ 13º salário" fique com a rubrica do INSS
+// sobre o 13º e não com a do INSS mensal. Retorna nil se nenhuma rubrica
+// corresponder à descrição.
+func Classificar(rubricas []models.Rubrica, linha models.DetalhamentoHolerite) *models.Rubrica {
+	palavras := make(map[string]bool)
+
+	for _, palavra := range Palavras(linha.Descricao) {
+		palavras[palavra] = true
+	}
+
+	var escolhida *models.Rubrica
+	melhor := 0
+
+	for i, rubrica := range rubricas {
+		if rubrica.Tipo != linha.Tipo {
+			continue
+		}
+
+		termos := []string{rubrica.Nome}
+
+		if rubrica.Termos != nil {
+			termos = append(termos, strings.Split(*rubrica.Termos, ";")...)
+		}
+
+		for _, termo := range termos {
+			termo := Palavras(termo)
+			if len(termo) == 0 {
+				continue
+			}
+
+			encontrado := true
+
+			for _, palavra := range termo {
+				if !palavras[palavra] {
+					encontrado = false
+					break
+				}
+			}
+
+			pontuacao := len(termo)*1000 + len(strings.Join(termo, " "))
+
+			if encontrado && pontuacao > melhor {
+				escolhida = &rubricas[i]
+				melhor = pontuacao
+			}
+		}
+	}
+
+	return escolhida
+}
+
+// Palavras separa o texto normalizado em palavras. Letras isoladas em
+// sequência, como em "I.R.R.F.", são unidas em uma só palavra.
+func Palavras(valor string) []string {
+	var palavras []string
+
+	sigla := ""
+
+	for _, palavra := range strings.Fields(texto.Normalizar(valor)) {
+		if len([]rune(palavra)) == 1 && unicode.IsLetter([]rune(palavra)[0]) {
+			sigla += palavra
+			continue
+		}
+
+		if sigla != "" {
+			palavras = append(palavras, sigla)
+			sigla = ""
+		}
+
+		palavras = append(palavras, palavra)
+	}
+
+	if sigla != "" {
+		palavras = append(palavras, sigla)
+	}
+
+	return palavras
+}
@@ -0,0 +1,185 @@
+package simulacao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"tsukuyomi/models"
+	"tsukuyomi/services/beneficio"
+	empregoService "tsukuyomi/services/emprego"
+	"tsukuyomi/services/tributos"
+)
+
+const (
+	ERROR_EMPREGO_NOT_FOUND   = "emprego não encontrado"
+	ERROR_MOEDA_ESTRANGEIRA   = "a comparação considera apenas salários em reais ou com cotação PTAX importada para a conversão"
+	ERROR_PRO_LABORE_FATURADO = "o pró-labore não pode ser maior que o faturamento"
+)
+
+type Service interface {
+	CompararOfertas(ctx context.Context, comparacao models.ComparacaoOfertas) (models.ResultadoComparacao, error)
+}
+
+type service struct {
+	EmpregoService empregoService.Service
+}
+
+func NewService(empregoService empregoService.Service) Service {
+	return &service{
+		EmpregoService: empregoService,
+	}
+}
+
+// CompararOfertas simula a renda anual de cada oferta com as tabelas de
+// tributos vigentes hoje e, quando informado o emprego atual, compara cada
+// oferta com a compensação vigente dele, já convertida para reais.
+func (s *service) CompararOfertas(ctx context.Context, comparacao models.ComparacaoOfertas) (models.ResultadoComparacao, error) {
+	hoje := time.Now()
+
+	resultado := models.ResultadoComparacao{
+		Ofertas: []models.SimulacaoOferta{},
+	}
+
+	if comparacao.IDEmprego != nil {
+		emprego, err := s.EmpregoService.FindByID(ctx, fmt.Sprint(*comparacao.IDEmprego))
+		if err != nil {
+			return models.ResultadoComparacao{}, err
+		}
+
+		if emprego.ID == 0 {
+			return models.ResultadoComparacao{}, errors.New(ERROR_EMPREGO_NOT_FOUND)
+		}
+
+		if emprego.Compensacao == nil || emprego.Compensacao.Moeda != models.MOEDA_BRL {
+			return models.ResultadoComparacao{}, errors.New(ERROR_MOEDA_ESTRANGEIRA)
+		}
+
+		oferta := models.Oferta{
+			Descricao:    fmt.Sprintf("%s - %s", emprego.Ocupacao, emprego.Empresa.Nome),
+			TipoContrato: models.CONTRATO_PJ,
+			Salario:      emprego.Compensacao.Salario,
+			Beneficios:   emprego.Compensacao.BeneficiosMensal,
+		}
+
+		if beneficio.CLT(emprego.TipoContrato) {
+			oferta.TipoContrato = models.CONTRATO_CLT
+		}
+
+		atual, err := Simular(oferta, comparacao.Dependentes, hoje)
+		if err != nil {
+			return models.ResultadoComparacao{}, err
+		}
+
+		resultado.Atual = &atual
+	}
+
+	for _, oferta := range comparacao.Ofertas {
+		simulacao, err := Simular(oferta, comparacao.Dependentes, hoje)
+		if err != nil {
+			return models.ResultadoComparacao{}, err
+		}
+
+		if resultado.Atual != nil {
+			simulacao.Diferenca = simulacao.LiquidoAnual - resultado.Atual.LiquidoAnual
+
+			if resultado.Atual.LiquidoAnual > 0 {
+				simulacao.DiferencaPercentual = math.Round(simulacao.Diferenca.Razao(resultado.Atual.LiquidoAnual)*10000) / 100
+			}
+		}
+
+		resultado.Ofertas = append(resultado.Ofertas, simulacao)
+	}
+
+	return resultado, nil
+}
+
+// Simular calcula a renda anual da oferta no regime de contratação dela.
+func Simular(oferta models.Oferta, dependentes int, dia time.Time) (models.SimulacaoOferta, error) {
+	simulacao := models.SimulacaoOferta{
+		Descricao:       oferta.Descricao,
+		TipoContrato:    oferta.TipoContrato,
+		BeneficiosAnual: oferta.Beneficios * 12,
+	}
+
+	if oferta.TipoContrato == models.CONTRATO_CLT {
+		simularCLT(&simulacao, oferta, dependentes, dia)
+	} else if err := simularPJ(&simulacao, oferta, dependentes, dia); err != nil {
+		return models.SimulacaoOferta{}, err
+	}
+
+	simulacao.LiquidoMensal = tributos.Arredondar(simulacao.LiquidoAnual.Dividir(12))
+
+	return simulacao, nil
+}
+
+// simularCLT considera onze meses de salário, um mês de férias com o terço
+// constitucional e o 13º salário, cada um com o INSS e o IRRF próprios. O
+// FGTS depositado entra no líquido.
+func simularCLT(simulacao *models.SimulacaoOferta, oferta models.Oferta, dependentes int, dia time.Time) {
+	salario := oferta.Salario
+	terco := tributos.Arredondar(salario.Dividir(3))
+
+	inss := tributos.INSS(salario, dia)
+	irrf := tributos.IRRFMensal(salario, inss, dependentes, dia).Imposto
+
+	inssFerias := tributos.INSS(salario+terco, dia)
+	irrfFerias := tributos.IRRFMensal(salario+terco, inssFerias, dependentes, dia).Imposto
+
+	inssDecimo := tributos.INSS(salario, dia)
+	irrfDecimo := tributos.IRRFDecimoTerceiro(salario, inssDecimo, dependentes, dia).Imposto
+
+	simulacao.DecimoTerceiro = salario
+	simulacao.TercoFerias = terco
+	simulacao.BrutoAnual = salario*13 + terco
+	simulacao.FGTS = tributos.Arredondar(simulacao.BrutoAnual.Multiplicar(tributos.ALIQUOTA_FGTS))
+	simulacao.INSS = inss*11 + inssFerias + inssDecimo
+	simulacao.IRRF = irrf*11 + irrfFerias + irrfDecimo
+
+	simulacao.LiquidoAnual = simulacao.BrutoAnual - simulacao.INSS - simulacao.IRRF + simulacao.FGTS + simulacao.BeneficiosAnual
+}
+
+// simularPJ considera o faturamento constante nos doze meses, com o DAS do
+// Simples Nacional sobre o faturamento e o INSS e o IRRF sobre o pró-labore.
+// O restante é distribuído como lucro, isento.
+func simularPJ(simulacao *models.SimulacaoOferta, oferta models.Oferta, dependentes int, dia time.Time) error {
+	faturamento := oferta.Salario
+
+	proLabore := tributos.Arredondar(faturamento.Multiplicar(tributos.FATOR_R_MINIMO))
+	if tributos.FatorR(proLabore, faturamento) < tributos.FATOR_R_MINIMO {
+		proLabore += models.Centavos(1)
+	}
+
+	if oferta.ProLabore != nil {
+		proLabore = *oferta.ProLabore
+	}
+
+	if proLabore > faturamento {
+		return errors.New(ERROR_PRO_LABORE_FATURADO)
+	}
+
+	simulacao.Anexo = oferta.Anexo
+	if simulacao.Anexo == "" {
+		simulacao.Anexo = tributos.AnexoFatorR(proLabore*12, faturamento*12)
+	}
+
+	das, err := tributos.SimplesNacional(simulacao.Anexo, faturamento*12, faturamento)
+	if err != nil {
+		return err
+	}
+
+	inss := tributos.INSSContribuinteIndividual(proLabore, dia)
+	irrf := tributos.IRRFMensal(proLabore, inss, dependentes, dia).Imposto
+
+	simulacao.BrutoAnual = faturamento * 12
+	simulacao.DAS = das.Imposto * 12
+	simulacao.INSS = inss * 12
+	simulacao.IRRF = irrf * 12
+	simulacao.CustosPJ = oferta.CustosPJ * 12
+
+	simulacao.LiquidoAnual = simulacao.BrutoAnual - simulacao.DAS - simulacao.INSS - simulacao.IRRF - simulacao.CustosPJ + simulacao.BeneficiosAnual
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package sindicato
+
+import (
+	"context"
+	"strings"
+
+	"tsukuyomi/models"
+	"tsukuyomi/repositories/sindicato"
+)
+
+type Service interface {
+	Create(ctx context.Context, sindicato models.Sindicato) (models.Sindicato, error)
+	FindAll(ctx context.Context, search string) ([]models.Sindicato, error)
+	FindByID(ctx context.Context, id string) (models.Sindicato, error)
+	Update(ctx context.Context, sindicato models.Sindicato) error
+	Delete(ctx context.Context, id string) error
+}
+
+type service struct {
+	repository sindicato.Repository
+}
+
+func NewService(repository sindicato.Repository) Service {
+	return &service{
+		repository: repository,
+	}
+}
+
+// Create registra o sindicato com o CNPJ sem a formatação.
+func (s *service) Create(ctx context.Context, sindicato models.Sindicato) (models.Sindicato, error) {
+	sindicato.CNPJ = normalizarCNPJ(sindicato.CNPJ)
+
+	return s.repository.Create(ctx, sindicato)
+}
+
+func (s *service) FindAll(ctx context.Context, search string) ([]models.Sindicato, error) {
+	return s.repository.FindAll(ctx, search)
+}
+
+func (s *service) FindByID(ctx context.Context, id string) (models.Sindicato, error) {
+	return s.repository.FindByID(ctx, id)
+}
+
+func (s *service) Update(ctx context.Context, sindicato models.Sindicato) error {
+	sindicato.CNPJ = normalizarCNPJ(sindicato.CNPJ)
+
+	return s.repository.Update(ctx, sindicato)
+}
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	return s.repository.Delete(ctx, id)
+}
+
+func normalizarCNPJ(cnpj *string) *string {
+	if cnpj == nil {
+		return nil
+	}
+
+	var digitos strings.Builder
+
+	for _, r := range *cnpj {
+		if r >= '0' && r <= '9' {
+			digitos.WriteRune(r)
+		}
+	}
+
+	valor := digitos.String()
+
+	return &valor
+}
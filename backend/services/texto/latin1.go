@@ -0,0 +1,64 @@
+package texto
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"unicode/utf8"
+)
+
+// Latin1ParaUTF8 converte texto em ISO-8859-1, em que cada byte corresponde ao
+// ponto de código Unicode de mesmo valor.
+func Latin1ParaUTF8(dados []byte) []byte {
+	saida := make([]byte, 0, len(dados)*2)
+
+	for _, b := range dados {
+		saida = utf8.AppendRune(saida, rune(b))
+	}
+
+	return saida
+}
+
+// leitorUTF8 entrega o arquivo em UTF-8, convertendo de ISO-8859-1 as linhas
+// que não forem UTF-8 válido. A marca de ordem de bytes do início é removida.
+type leitorUTF8 struct {
+	leitor   *bufio.Reader
+	pendente []byte
+	iniciado bool
+}
+
+// NovoLeitorUTF8 lê arquivos em UTF-8 ou ISO-8859-1 linha a linha, sem
+// carregar o arquivo inteiro em memória.
+func NovoLeitorUTF8(conteudo io.Reader) io.Reader {
+	return &leitorUTF8{leitor: bufio.NewReaderSize(conteudo, 1<<16)}
+}
+
+func (l *leitorUTF8) Read(p []byte) (int, error) {
+	for len(l.pendente) == 0 {
+		linha, err := l.leitor.ReadBytes('\n')
+
+		if !l.iniciado {
+			linha = bytes.TrimPrefix(linha, []byte("\xef\xbb\xbf"))
+			l.iniciado = true
+		}
+
+		if !utf8.Valid(linha) {
+			linha = Latin1ParaUTF8(linha)
+		}
+
+		l.pendente = linha
+
+		if err != nil {
+			if len(linha) == 0 {
+				return 0, err
+			}
+
+			break
+		}
+	}
+
+	n := copy(p, l.pendente)
+	l.pendente = l.pendente[n:]
+
+	return n, nil
+}
@@ -0,0 +1,124 @@
+package texto
+
+import "strings"
+
+// TAMANHO_MINIMO_ERRO é o tamanho mínimo da palavra buscada para tolerar erros
+// de digitação.
+const TAMANHO_MINIMO_ERRO = 4
+
+// Trigramas retorna os trigramas do texto normalizado, com cada palavra
+// cercada por espaços, como no pg_trgm: "rua" gera "  r", " ru", "rua" e
+// "ua ".
+func Trigramas(valor string) map[string]bool {
+	trigramas := map[string]bool{}
+
+	for _, palavra := range strings.Fields(Normalizar(valor)) {
+		runas := []rune("  " + palavra + " ")
+
+		for i := 0; i+3 <= len(runas); i++ {
+			trigramas[string(runas[i:i+3])] = true
+		}
+	}
+
+	return trigramas
+}
+
+// SimilaridadeTrigramas é a proporção de trigramas em comum entre os dois
+// textos, de 0 a 1.
+func SimilaridadeTrigramas(a, b string) float64 {
+	ta, tb := Trigramas(a), Trigramas(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+
+	comuns := 0
+
+	for trigrama := range ta {
+		if tb[trigrama] {
+			comuns++
+		}
+	}
+
+	return float64(comuns) / float64(len(ta)+len(tb)-comuns)
+}
+
+// Levenshtein é a quantidade mínima de inserções, remoções e substituições
+// de caracteres para transformar um texto no outro.
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	anterior := make([]int, len(rb)+1)
+	atual := make([]int, len(rb)+1)
+
+	for j := range anterior {
+		anterior[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		atual[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			custo := 1
+			if ra[i-1] == rb[j-1] {
+				custo = 0
+			}
+
+			atual[j] = min(anterior[j]+1, atual[j-1]+1, anterior[j-1]+custo)
+		}
+
+		anterior, atual = atual, anterior
+	}
+
+	return anterior[len(rb)]
+}
+
+// Similaridade pontua, de 0 a 1, quanto o texto corresponde à busca, sem
+// diferenciar maiúsculas, acentos e pontuação. A busca contida no texto vale
+// 1, ou pouco menos quando não começa uma palavra. Caso contrário, cada
+// palavra da busca é comparada com a palavra mais parecida do texto pela
+// distância de Levenshtein, tolerando erros de digitação nas palavras de ao
+// menos TAMANHO_MINIMO_ERRO letras, e a pontuação é a média entre as
+// palavras, ou a similaridade de trigramas entre os textos, se maior.
+func Similaridade(busca, valor string) float64 {
+	busca, valor = Normalizar(busca), Normalizar(valor)
+	if busca == "" || valor == "" {
+		return 0
+	}
+
+	if i := strings.Index(valor, busca); i >= 0 {
+		if i == 0 || valor[i-1] == ' ' {
+			return 1
+		}
+
+		return 0.9
+	}
+
+	palavras := strings.Fields(valor)
+	total := 0.0
+
+	for _, termo := range strings.Fields(busca) {
+		melhor := 0.0
+
+		for _, palavra := range palavras {
+			// O termo pode ser o início da palavra, como em "tecn" para
+			// "tecnologia".
+			if strings.HasPrefix(palavra, termo) {
+				melhor = 1
+				break
+			}
+
+			// Termos curtos demais não toleram erros, senão quase qualquer
+			// palavra curta corresponderia a eles.
+			if len([]rune(termo)) < TAMANHO_MINIMO_ERRO {
+				continue
+			}
+
+			tamanho := max(len([]rune(termo)), len([]rune(palavra)))
+			melhor = max(melhor, 1-float64(Levenshtein(termo, palavra))/float64(tamanho))
+		}
+
+		total += melhor
+	}
+
+	return max(total/float64(len(strings.Fields(busca))), SimilaridadeTrigramas(busca, valor))
+}
@@ -0,0 +1,73 @@
+package texto
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	casos := []struct {
+		a, b      string
+		distancia int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"ção", "cao", 2},
+		{"tecnolgia", "tecnologia", 1},
+	}
+
+	for _, caso := range casos {
+		if obtido := Levenshtein(caso.a, caso.b); obtido != caso.distancia {
+			t.Errorf("Levenshtein(%q, %q) = %d, esperado %d", caso.a, caso.b, obtido, caso.distancia)
+		}
+	}
+}
+
+func TestSimilaridadeTrigramas(t *testing.T) {
+	casos := []struct {
+		a, b         string
+		similaridade float64
+	}{
+		{"Rua", "rua", 1},
+		{"abc", "abd", 1.0 / 3},
+		{"", "abc", 0},
+		{"xyz", "abc", 0},
+	}
+
+	for _, caso := range casos {
+		if obtido := SimilaridadeTrigramas(caso.a, caso.b); math.Abs(obtido-caso.similaridade) > 1e-9 {
+			t.Errorf("SimilaridadeTrigramas(%q, %q) = %f, esperado %f", caso.a, caso.b, obtido, caso.similaridade)
+		}
+	}
+}
+
+func TestSimilaridade(t *testing.T) {
+	casos := []struct {
+		nome         string
+		busca        string
+		valor        string
+		similaridade float64
+	}{
+		{"busca vazia", "", "ACME Ltda", 0},
+		{"início de palavra", "acme", "ACME Ltda", 1},
+		{"sem acentos", "são joão", "Sao Joao Padaria", 1},
+		{"meio de palavra", "cme", "ACME Ltda", 0.9},
+		{"palavras fora de ordem", "padaria central", "Central Padaria", 1},
+		{"prefixo de palavra", "tecn info", "Tecnologia da Informação", 1},
+		{"erro de digitação", "tecnolgia", "Tecnologia SA", 0.9},
+		{"erro em uma das palavras", "padaria centrl", "Central Padaria", 13.0 / 14},
+		{"termo curto sem tolerância", "abc", "abd", 1.0 / 3},
+		{"palavras diferentes", "joao silva", "maria souza", 0.3},
+		{"sem relação", "xyz", "abc", 0},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			if obtido := Similaridade(caso.busca, caso.valor); math.Abs(obtido-caso.similaridade) > 1e-9 {
+				t.Errorf("Similaridade(%q, %q) = %f, esperado %f", caso.busca, caso.valor, obtido, caso.similaridade)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package texto
+
+import (
+	"strings"
+	"unicode"
+)
+
+var acentos = map[rune]rune{
+	'á': 'a', 'à': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a',
+	'é': 'e', 'è': 'e', 'ê': 'e', 'ë': 'e',
+	'í': 'i', 'ì': 'i', 'î': 'i', 'ï': 'i',
+	'ó': 'o', 'ò': 'o', 'ô': 'o', 'õ': 'o', 'ö': 'o',
+	'ú': 'u', 'ù': 'u', 'û': 'u', 'ü': 'u',
+	'ç': 'c', 'ñ': 'n',
+	'º': 'o', 'ª': 'a',
+}
+
+// Normalizar converte o texto para minúsculas sem acentos, troca pontuação
+// por espaços e remove espaços repetidos, para comparar textos digitados de
+// formas diferentes.
+func Normalizar(valor string) string {
+	var normalizado strings.Builder
+
+	espaco := true
+
+	for _, r := range strings.ToLower(valor) {
+		if sem, ok := acentos[r]; ok {
+			r = sem
+		}
+
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if !espaco {
+				normalizado.WriteRune(' ')
+				espaco = true
+			}
+
+			continue
+		}
+
+		normalizado.WriteRune(r)
+		espaco = false
+	}
+
+	return strings.TrimSpace(normalizado.String())
+}
+
+// Digitos remove do texto tudo que não for dígito, como a formatação de CEP,
+// CNPJ, CPF e CBO.
+func Digitos(valor string) string {
+	var digitos strings.Builder
+
+	for _, r := range valor {
+		if r >= '0' && r <= '9' {
+			digitos.WriteRune(r)
+		}
+	}
+
+	return digitos.String()
+}
@@ -0,0 +1,103 @@
+package tributos
+
+import (
+	"errors"
+	"math"
+
+	"tsukuyomi/models"
+)
+
+// FATOR_R_MINIMO é a razão mínima entre a folha de salários e a receita bruta
+// dos últimos doze meses para a tributação pelo anexo III.
+const FATOR_R_MINIMO = 0.28
+
+const (
+	ERROR_ANEXO_INVALIDO = "anexo do Simples Nacional inválido"
+	ERROR_LIMITE_SIMPLES = "receita bruta dos últimos doze meses acima do limite do Simples Nacional"
+)
+
+// Tabelas dos anexos III e V do Simples Nacional (Lei Complementar nº
+// 155/2016), com a alíquota nominal e a parcela a deduzir de cada faixa de
+// receita bruta em doze meses.
+var tabelasSimples = map[string][]Faixa{
+	models.ANEXO_III: {
+		{reais(180000), 0.06, 0},
+		{reais(360000), 0.112, reais(9360)},
+		{reais(720000), 0.135, reais(17640)},
+		{reais(1800000), 0.16, reais(35640)},
+		{reais(3600000), 0.21, reais(125640)},
+		{reais(4800000), 0.33, reais(648000)},
+	},
+	models.ANEXO_V: {
+		{reais(180000), 0.155, 0},
+		{reais(360000), 0.18, reais(4500)},
+		{reais(720000), 0.195, reais(9900)},
+		{reais(1800000), 0.205, reais(17100)},
+		{reais(3600000), 0.23, reais(62100)},
+		{reais(4800000), 0.305, reais(540000)},
+	},
+}
+
+// CalculoSimples é o resultado do cálculo do DAS. As alíquotas são frações,
+// como 0.06 para 6%.
+type CalculoSimples struct {
+	Faixa           int
+	AliquotaNominal float64
+	ParcelaDeduzir  models.Dinheiro
+	AliquotaEfetiva float64
+	Imposto         models.Dinheiro
+}
+
+// FatorR retorna a razão entre a folha de salários e a receita bruta dos
+// últimos doze meses.
+func FatorR(folha12, rbt12 models.Dinheiro) float64 {
+	return folha12.Razao(rbt12)
+}
+
+// AnexoFatorR retorna o anexo das atividades sujeitas ao Fator R. Sem
+// receita no período, a empresa é tributada pelo anexo III se tiver folha de
+// salários.
+func AnexoFatorR(folha12, rbt12 models.Dinheiro) string {
+	if (rbt12 == 0 && folha12 > 0) || (rbt12 > 0 && FatorR(folha12, rbt12) >= FATOR_R_MINIMO) {
+		return models.ANEXO_III
+	}
+
+	return models.ANEXO_V
+}
+
+// SimplesNacional calcula o DAS sobre a receita do mês. A alíquota efetiva é
+// (RBT12 × alíquota nominal − parcela a deduzir) / RBT12, na faixa da receita
+// bruta dos últimos doze meses; sem receita no período, é a alíquota nominal
+// da primeira faixa.
+func SimplesNacional(anexo string, rbt12, receita models.Dinheiro) (CalculoSimples, error) {
+	tabela, ok := tabelasSimples[anexo]
+	if !ok {
+		return CalculoSimples{}, errors.New(ERROR_ANEXO_INVALIDO)
+	}
+
+	for i, faixa := range tabela {
+		if rbt12 > faixa.Limite {
+			continue
+		}
+
+		calculo := CalculoSimples{
+			Faixa:           i + 1,
+			AliquotaNominal: faixa.Aliquota,
+			ParcelaDeduzir:  faixa.Deducao,
+			AliquotaEfetiva: faixa.Aliquota,
+		}
+
+		if rbt12 > 0 {
+			aliquota := rbt12.Multiplicar(faixa.Aliquota) - faixa.Deducao
+
+			calculo.AliquotaEfetiva = math.Round(aliquota.Razao(rbt12)*1e6) / 1e6
+			calculo.Imposto = Arredondar(receita.Multiplicar(aliquota.Float64()).Dividir(rbt12.Float64()))
+		} else {
+			calculo.Imposto = Arredondar(receita.Multiplicar(faixa.Aliquota))
+		}
+
+		return calculo, nil
+	}
+
+	return CalculoSimples{}, errors.New(ERROR_LIMITE_SIMPLES)
+}
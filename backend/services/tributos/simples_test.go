@@ -0,0 +1,111 @@
+package tributos
+
+import (
+	"testing"
+
+	"tsukuyomi/models"
+)
+
+func TestAnexoFatorR(t *testing.T) {
+	casos := []struct {
+		nome    string
+		folha12 models.Dinheiro
+		rbt12   models.Dinheiro
+		anexo   string
+	}{
+		{"fator R no mínimo", reais(28000.00), reais(100000.00), models.ANEXO_III},
+		{"fator R abaixo do mínimo", reais(27999.99), reais(100000.00), models.ANEXO_V},
+		{"sem folha", 0, reais(100000.00), models.ANEXO_V},
+		{"sem receita e com folha", reais(1000.00), 0, models.ANEXO_III},
+		{"sem receita e sem folha", 0, 0, models.ANEXO_V},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			if obtido := AnexoFatorR(caso.folha12, caso.rbt12); obtido != caso.anexo {
+				t.Errorf("AnexoFatorR(%s, %s) = %s, esperado %s", caso.folha12, caso.rbt12, obtido, caso.anexo)
+			}
+		})
+	}
+}
+
+func TestSimplesNacional(t *testing.T) {
+	casos := []struct {
+		nome     string
+		anexo    string
+		rbt12    models.Dinheiro
+		receita  models.Dinheiro
+		esperado CalculoSimples
+		erro     string
+	}{
+		{
+			nome:     "início de atividade",
+			anexo:    models.ANEXO_III,
+			receita:  reais(10000.00),
+			esperado: CalculoSimples{Faixa: 1, AliquotaNominal: 0.06, AliquotaEfetiva: 0.06, Imposto: reais(600.00)},
+		},
+		{
+			nome:     "limite da primeira faixa",
+			anexo:    models.ANEXO_III,
+			rbt12:    reais(180000.00),
+			receita:  reais(15000.00),
+			esperado: CalculoSimples{Faixa: 1, AliquotaNominal: 0.06, AliquotaEfetiva: 0.06, Imposto: reais(900.00)},
+		},
+		{
+			nome:     "terceira faixa do anexo III",
+			anexo:    models.ANEXO_III,
+			rbt12:    reais(500000.00),
+			receita:  reais(40000.00),
+			esperado: CalculoSimples{Faixa: 3, AliquotaNominal: 0.135, ParcelaDeduzir: reais(17640), AliquotaEfetiva: 0.09972, Imposto: reais(3988.80)},
+		},
+		{
+			nome:     "segunda faixa do anexo V",
+			anexo:    models.ANEXO_V,
+			rbt12:    reais(250000.00),
+			receita:  reais(20000.00),
+			esperado: CalculoSimples{Faixa: 2, AliquotaNominal: 0.18, ParcelaDeduzir: reais(4500), AliquotaEfetiva: 0.162, Imposto: reais(3240.00)},
+		},
+		{
+			nome:     "quarta faixa do anexo V",
+			anexo:    models.ANEXO_V,
+			rbt12:    reais(1000000.00),
+			receita:  reais(100000.00),
+			esperado: CalculoSimples{Faixa: 4, AliquotaNominal: 0.205, ParcelaDeduzir: reais(17100), AliquotaEfetiva: 0.1879, Imposto: reais(18790.00)},
+		},
+		{
+			nome:    "acima do limite",
+			anexo:   models.ANEXO_III,
+			rbt12:   reais(4800000.01),
+			receita: reais(400000.00),
+			erro:    ERROR_LIMITE_SIMPLES,
+		},
+		{
+			nome:    "anexo inválido",
+			anexo:   "I",
+			rbt12:   reais(100000.00),
+			receita: reais(10000.00),
+			erro:    ERROR_ANEXO_INVALIDO,
+		},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			obtido, err := SimplesNacional(caso.anexo, caso.rbt12, caso.receita)
+			if caso.erro != "" {
+				if err == nil || err.Error() != caso.erro {
+					t.Errorf("SimplesNacional retornou erro %v, esperado %q", err, caso.erro)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("SimplesNacional retornou erro: %v", err)
+			}
+
+			if obtido != caso.esperado {
+				t.Errorf("SimplesNacional(%s, %s, %s) = %+v, esperado %+v", caso.anexo, caso.rbt12, caso.receita, obtido, caso.esperado)
+			}
+		})
+	}
+}
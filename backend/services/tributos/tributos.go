@@ -0,0 +1,235 @@
+package tributos
+
+import (
+	"math"
+	"time"
+
+	"tsukuyomi/models"
+)
+
+// Os tributos são calculados com valores exatos e arredondados para centavos
+// com o arredondamento da metade para cima, como nas tabelas da Receita
+// Federal e do INSS.
+const ARREDONDAMENTO = models.ARREDONDAR_MEIO_ACIMA
+
+// ALIQUOTA_FGTS é o percentual do depósito mensal do FGTS sobre a
+// remuneração, inclusive o 13º salário e o terço de férias.
+const ALIQUOTA_FGTS = 0.08
+
+// ALIQUOTA_PRO_LABORE é a alíquota do INSS do contribuinte individual sobre o
+// pró-labore, sem a contrapartida patronal, que no Simples Nacional é
+// recolhida no DAS.
+const ALIQUOTA_PRO_LABORE = 0.11
+
+// semLimite é o limite da última faixa das tabelas progressivas.
+const semLimite = models.Dinheiro(math.MaxInt64)
+
+// Faixa é uma faixa de uma tabela progressiva. Limite é o valor máximo da
+// base na faixa; a última faixa não tem limite.
+type Faixa struct {
+	Limite   models.Dinheiro
+	Aliquota float64
+	Deducao  models.Dinheiro
+}
+
+type tabelaINSS struct {
+	inicio time.Time
+	faixas []Faixa
+}
+
+// tabelaIRRF traz a tabela progressiva mensal, o desconto simplificado, a
+// dedução por dependente e, a partir de 2026, a redução do imposto para
+// rendimentos até reducaoLimite (Lei nº 15.270/2025).
+type tabelaIRRF struct {
+	inicio        time.Time
+	faixas        []Faixa
+	simplificado  models.Dinheiro
+	dependente    models.Dinheiro
+	isencaoTotal  models.Dinheiro
+	reducaoMaxima models.Dinheiro
+	reducaoLimite models.Dinheiro
+	reducaoFixa   models.Dinheiro
+	reducaoFator  float64
+}
+
+// Tabelas de contribuição dos segurados empregados, em ordem de vigência. O
+// INSS é calculado faixa a faixa, até o teto da última faixa.
+var tabelasINSS = []tabelaINSS{
+	{
+		inicio: data(2024, time.January),
+		faixas: []Faixa{{reais(1412.00), 0.075, 0}, {reais(2666.68), 0.09, 0}, {reais(4000.03), 0.12, 0}, {reais(7786.02), 0.14, 0}},
+	},
+	{
+		inicio: data(2025, time.January),
+		faixas: []Faixa{{reais(1518.00), 0.075, 0}, {reais(2793.88), 0.09, 0}, {reais(4190.83), 0.12, 0}, {reais(8157.41), 0.14, 0}},
+	},
+	{
+		inicio: data(2026, time.January),
+		faixas: []Faixa{{reais(1621.00), 0.075, 0}, {reais(2902.84), 0.09, 0}, {reais(4354.27), 0.12, 0}, {reais(8475.55), 0.14, 0}},
+	},
+}
+
+// Tabelas progressivas mensais do IRRF, em ordem de vigência.
+var tabelasIRRF = []tabelaIRRF{
+	{
+		inicio:       data(2024, time.February),
+		faixas:       []Faixa{{reais(2259.20), 0, 0}, {reais(2826.65), 0.075, reais(169.44)}, {reais(3751.05), 0.15, reais(381.44)}, {reais(4664.68), 0.225, reais(662.77)}, {semLimite, 0.275, reais(896.00)}},
+		simplificado: reais(564.80),
+		dependente:   reais(189.59),
+	},
+	{
+		inicio:       data(2025, time.May),
+		faixas:       []Faixa{{reais(2428.80), 0, 0}, {reais(2826.65), 0.075, reais(182.16)}, {reais(3751.05), 0.15, reais(394.16)}, {reais(4664.68), 0.225, reais(675.49)}, {semLimite, 0.275, reais(908.73)}},
+		simplificado: reais(607.20),
+		dependente:   reais(189.59),
+	},
+	{
+		inicio:        data(2026, time.January),
+		faixas:        []Faixa{{reais(2428.80), 0, 0}, {reais(2826.65), 0.075, reais(182.16)}, {reais(3751.05), 0.15, reais(394.16)}, {reais(4664.68), 0.225, reais(675.49)}, {semLimite, 0.275, reais(908.73)}},
+		simplificado:  reais(607.20),
+		dependente:    reais(189.59),
+		isencaoTotal:  reais(5000.00),
+		reducaoMaxima: reais(312.89),
+		reducaoLimite: reais(7350.00),
+		reducaoFixa:   reais(978.62),
+		reducaoFator:  0.133145,
+	},
+}
+
+// Calculo é o resultado do cálculo de um tributo, com as deduções aplicadas à
+// base.
+type Calculo struct {
+	Base     models.Dinheiro
+	Deducoes models.Dinheiro
+	Imposto  models.Dinheiro
+}
+
+// Vigente informa se há tabelas de INSS e de IRRF para a data. Antes da
+// primeira vigência conhecida, os cálculos usam as tabelas mais antigas.
+func Vigente(dia time.Time) bool {
+	return !dia.Before(tabelasINSS[0].inicio) && !dia.Before(tabelasIRRF[0].inicio)
+}
+
+// INSS calcula a contribuição do empregado sobre a base, faixa a faixa, com a
+// tabela vigente na data. As parcelas das faixas são somadas sem arredondar
+// e só a contribuição é arredondada para centavos.
+func INSS(base models.Dinheiro, dia time.Time) models.Dinheiro {
+	tabela := tabelaINSSVigente(dia)
+
+	var contribuicao, anterior models.Dinheiro
+
+	for _, faixa := range tabela.faixas {
+		if base <= anterior {
+			break
+		}
+
+		contribuicao += (min(base, faixa.Limite) - anterior).Multiplicar(faixa.Aliquota)
+		anterior = faixa.Limite
+	}
+
+	return Arredondar(contribuicao)
+}
+
+// INSSContribuinteIndividual calcula a contribuição do sócio sobre o
+// pró-labore, de 11% até o teto da tabela vigente na data.
+func INSSContribuinteIndividual(base models.Dinheiro, dia time.Time) models.Dinheiro {
+	tabela := tabelaINSSVigente(dia)
+
+	teto := tabela.faixas[len(tabela.faixas)-1].Limite
+
+	return Arredondar(min(base, teto).Multiplicar(ALIQUOTA_PRO_LABORE))
+}
+
+// IRRFMensal calcula o imposto sobre os rendimentos tributáveis do mês. A base
+// considera a dedução mais vantajosa entre as legais (INSS, dependentes e
+// pensão alimentícia) e o desconto simplificado mensal.
+func IRRFMensal(rendimentos, deducoes models.Dinheiro, dependentes int, dia time.Time) Calculo {
+	tabela := tabelaIRRFVigente(dia)
+
+	deducoes += tabela.dependente * models.Dinheiro(dependentes)
+	deducoes = max(deducoes, tabela.simplificado)
+
+	resultado := calcularIRRF(tabela, rendimentos, deducoes)
+
+	if tabela.isencaoTotal > 0 {
+		var reducao models.Dinheiro
+
+		switch {
+		case rendimentos <= tabela.isencaoTotal:
+			reducao = tabela.reducaoMaxima
+		case rendimentos <= tabela.reducaoLimite:
+			reducao = tabela.reducaoFixa - rendimentos.Multiplicar(tabela.reducaoFator)
+		}
+
+		resultado.Imposto = Arredondar(max(resultado.Imposto-reducao, 0))
+	}
+
+	return resultado
+}
+
+// IRRFDecimoTerceiro calcula o imposto sobre o 13º salário, de tributação
+// exclusiva, com as deduções legais e sem o desconto simplificado.
+func IRRFDecimoTerceiro(rendimentos, deducoes models.Dinheiro, dependentes int, dia time.Time) Calculo {
+	tabela := tabelaIRRFVigente(dia)
+
+	deducoes += tabela.dependente * models.Dinheiro(dependentes)
+
+	return calcularIRRF(tabela, rendimentos, deducoes)
+}
+
+func calcularIRRF(tabela tabelaIRRF, rendimentos, deducoes models.Dinheiro) Calculo {
+	base := max(rendimentos-deducoes, 0)
+
+	var imposto models.Dinheiro
+
+	for _, faixa := range tabela.faixas {
+		if base <= faixa.Limite {
+			imposto = base.Multiplicar(faixa.Aliquota) - faixa.Deducao
+			break
+		}
+	}
+
+	return Calculo{
+		Base:     Arredondar(base),
+		Deducoes: Arredondar(deducoes),
+		Imposto:  Arredondar(max(imposto, 0)),
+	}
+}
+
+func tabelaINSSVigente(dia time.Time) tabelaINSS {
+	tabela := tabelasINSS[0]
+
+	for _, t := range tabelasINSS {
+		if !dia.Before(t.inicio) {
+			tabela = t
+		}
+	}
+
+	return tabela
+}
+
+func tabelaIRRFVigente(dia time.Time) tabelaIRRF {
+	tabela := tabelasIRRF[0]
+
+	for _, t := range tabelasIRRF {
+		if !dia.Before(t.inicio) {
+			tabela = t
+		}
+	}
+
+	return tabela
+}
+
+// Arredondar arredonda o valor para centavos com o arredondamento dos
+// tributos.
+func Arredondar(valor models.Dinheiro) models.Dinheiro {
+	return valor.Arredondar(ARREDONDAMENTO)
+}
+
+func reais(valor float64) models.Dinheiro {
+	return models.NovoDinheiro(valor)
+}
+
+func data(ano int, mes time.Month) time.Time {
+	return time.Date(ano, mes, 1, 0, 0, 0, 0, time.Local)
+}
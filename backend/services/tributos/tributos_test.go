@@ -0,0 +1,91 @@
+package tributos
+
+import (
+	"testing"
+	"time"
+
+	"tsukuyomi/models"
+)
+
+func TestINSS(t *testing.T) {
+	casos := []struct {
+		nome         string
+		base         models.Dinheiro
+		dia          time.Time
+		contribuicao models.Dinheiro
+	}{
+		{"sem base", 0, data(2026, time.March), 0},
+		{"salário mínimo de 2026", reais(1621.00), data(2026, time.March), reais(121.58)},
+		{"terceira faixa de 2026", reais(3000.00), data(2026, time.March), reais(248.60)},
+		{"acima do teto de 2026", reais(10000.00), data(2026, time.March), reais(988.09)},
+		{"terceira faixa de 2025", reais(3000.00), data(2025, time.December), reais(253.41)},
+		{"salário mínimo de 2024", reais(1412.00), data(2024, time.June), reais(105.90)},
+		{"antes das tabelas conhecidas", reais(1412.00), data(2023, time.June), reais(105.90)},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			if obtido := INSS(caso.base, caso.dia); obtido != caso.contribuicao {
+				t.Errorf("INSS(%s) = %s, esperado %s", caso.base, obtido, caso.contribuicao)
+			}
+		})
+	}
+}
+
+func TestIRRFMensal(t *testing.T) {
+	casos := []struct {
+		nome        string
+		rendimentos models.Dinheiro
+		dia         time.Time
+		dependentes int
+		esperado    Calculo
+	}{
+		{
+			nome:        "abaixo da primeira faixa",
+			rendimentos: reais(2000.00),
+			dia:         data(2026, time.March),
+			esperado:    Calculo{Base: reais(1392.80), Deducoes: reais(607.20), Imposto: 0},
+		},
+		{
+			nome:        "isento pela redução de 2026",
+			rendimentos: reais(5000.00),
+			dia:         data(2026, time.March),
+			esperado:    Calculo{Base: reais(4392.80), Deducoes: reais(607.20), Imposto: 0},
+		},
+		{
+			nome:        "mesmo salário antes da redução",
+			rendimentos: reais(5000.00),
+			dia:         data(2025, time.December),
+			esperado:    Calculo{Base: reais(4392.80), Deducoes: reais(607.20), Imposto: reais(312.89)},
+		},
+		{
+			nome:        "redução parcial de 2026",
+			rendimentos: reais(6000.00),
+			dia:         data(2026, time.March),
+			esperado:    Calculo{Base: reais(5358.49), Deducoes: reais(641.51), Imposto: reais(385.10)},
+		},
+		{
+			nome:        "redução parcial com dependentes",
+			rendimentos: reais(6000.00),
+			dia:         data(2026, time.March),
+			dependentes: 2,
+			esperado:    Calculo{Base: reais(4979.31), Deducoes: reais(1020.69), Imposto: reais(280.83)},
+		},
+		{
+			nome:        "acima do limite da redução",
+			rendimentos: reais(8000.00),
+			dia:         data(2026, time.March),
+			esperado:    Calculo{Base: reais(7078.49), Deducoes: reais(921.51), Imposto: reais(1037.85)},
+		},
+	}
+
+	for _, caso := range casos {
+		t.Run(caso.nome, func(t *testing.T) {
+			inss := INSS(caso.rendimentos, caso.dia)
+
+			if obtido := IRRFMensal(caso.rendimentos, inss, caso.dependentes, caso.dia); obtido != caso.esperado {
+				t.Errorf("IRRFMensal(%s, %s, %d) = %+v, esperado %+v", caso.rendimentos, inss, caso.dependentes, obtido, caso.esperado)
+			}
+		})
+	}
+}